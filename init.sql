@@ -1,17 +1,106 @@
 -- Create tables for Cleany API
 
+-- Properties (hotels or buildings)
+CREATE TABLE IF NOT EXISTS properties (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    address VARCHAR(255),
+    version INTEGER NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Default property
+INSERT INTO properties (name) SELECT 'Main' WHERE NOT EXISTS (SELECT 1 FROM properties);
+
+-- Users authorized for a property
+CREATE TABLE IF NOT EXISTS property_users (
+    property_id INTEGER NOT NULL REFERENCES properties(id) ON DELETE CASCADE,
+    user_name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (property_id, user_name)
+);
+
+-- Buildings of a property
+CREATE TABLE IF NOT EXISTS buildings (
+    id SERIAL PRIMARY KEY,
+    property_id INTEGER NOT NULL REFERENCES properties(id) ON DELETE RESTRICT,
+    name VARCHAR(100) NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Floors of a building
+CREATE TABLE IF NOT EXISTS floors (
+    id SERIAL PRIMARY KEY,
+    building_id INTEGER NOT NULL REFERENCES buildings(id) ON DELETE RESTRICT,
+    level INTEGER NOT NULL,
+    name VARCHAR(100),
+    version INTEGER NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (building_id, level)
+);
+
+-- Cleaning zones of a floor
+CREATE TABLE IF NOT EXISTS zones (
+    id SERIAL PRIMARY KEY,
+    floor_id INTEGER NOT NULL REFERENCES floors(id) ON DELETE RESTRICT,
+    name VARCHAR(100) NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
 -- Cleaners
 CREATE TABLE IF NOT EXISTS cleaners (
     id SERIAL PRIMARY KEY,
     name VARCHAR(255) NOT NULL,
-    surname VARCHAR(255) NOT NULL
+    surname VARCHAR(255) NOT NULL,
+    property_id INTEGER NOT NULL REFERENCES properties(id) ON DELETE RESTRICT,
+    home_zone_id INTEGER REFERENCES zones(id) ON DELETE SET NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+-- Room types
+CREATE TABLE IF NOT EXISTS room_types (
+    id SERIAL PRIMARY KEY,
+    property_id INTEGER NOT NULL REFERENCES properties(id) ON DELETE RESTRICT,
+    name VARCHAR(100) NOT NULL,
+    capacity INTEGER NOT NULL,
+    area NUMERIC(7, 2) NOT NULL DEFAULT 0,
+    beds INTEGER NOT NULL,
+    amenities TEXT[] NOT NULL DEFAULT '{}',
+    periodic_cost INTEGER,
+    general_cost INTEGER,
+    version INTEGER NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (property_id, name)
 );
 
 -- Rooms
 CREATE TABLE IF NOT EXISTS rooms (
     id SERIAL PRIMARY KEY,
     floor INTEGER NOT NULL,
-    "desc" VARCHAR(255)
+    "desc" VARCHAR(255),
+    room_type_id INTEGER REFERENCES room_types(id) ON DELETE RESTRICT,
+    property_id INTEGER NOT NULL REFERENCES properties(id) ON DELETE RESTRICT,
+    zone_id INTEGER REFERENCES zones(id) ON DELETE RESTRICT,
+    status VARCHAR(32) NOT NULL DEFAULT 'clean',
+    version INTEGER NOT NULL DEFAULT 1,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
+
+-- Out-of-order and out-of-service periods of rooms
+CREATE TABLE IF NOT EXISTS room_blocks (
+    id SERIAL PRIMARY KEY,
+    room_id INTEGER NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    kind VARCHAR(32) NOT NULL,
+    reason VARCHAR(255) NOT NULL,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
 );
 
 -- Bookings
@@ -20,18 +109,68 @@ CREATE TABLE IF NOT EXISTS bookings (
     room_id INTEGER NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
     check_in_ts TIMESTAMP,
     check_out_ts TIMESTAMP,
-    guests INTEGER
+    guests INTEGER,
+    version INTEGER NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP,
+    cancelled_at TIMESTAMP,
+    cancel_reason VARCHAR(255),
+    checked_in_at TIMESTAMP,
+    checked_out_at TIMESTAMP,
+    cleaning_preference VARCHAR(16) NOT NULL DEFAULT 'daily',
+    service_window_start VARCHAR(5),
+    service_window_end VARCHAR(5),
+    dnd_date DATE,
+    guest_name VARCHAR(255),
+    guest_email VARCHAR(255),
+    guest_phone VARCHAR(64),
+    guest_language VARCHAR(16),
+    guest_notes TEXT,
+    guest_vip BOOLEAN NOT NULL DEFAULT FALSE,
+    external_ref VARCHAR(255),
+    anonymized_at TIMESTAMP
+);
+
+-- Recurring cleanings of rooms or zones without a booking, rrule is an
+-- RFC 5545 recurrence rule from starts_at
+CREATE TABLE IF NOT EXISTS recurrence_rules (
+    id SERIAL PRIMARY KEY,
+    room_id INTEGER REFERENCES rooms(id) ON DELETE CASCADE,
+    zone_id INTEGER REFERENCES zones(id) ON DELETE CASCADE,
+    cleaning_type VARCHAR(100) NOT NULL,
+    rrule VARCHAR(255) NOT NULL,
+    starts_at TIMESTAMP NOT NULL,
+    cost INTEGER,
+    notes TEXT,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    -- orders have been generated up to this time
+    generated_until TIMESTAMP,
+    version INTEGER NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
 );
 
--- Cleaning Orders
+-- Cleaning Orders, for a booking or for a room or zone without one
 CREATE TABLE IF NOT EXISTS cleaning_orders (
     id SERIAL PRIMARY KEY,
-    booking_id INTEGER NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    booking_id INTEGER REFERENCES bookings(id) ON DELETE CASCADE,
+    room_id INTEGER REFERENCES rooms(id) ON DELETE CASCADE,
+    zone_id INTEGER REFERENCES zones(id) ON DELETE CASCADE,
+    -- recurrence rule the order was generated from
+    rule_id INTEGER REFERENCES recurrence_rules(id) ON DELETE SET NULL,
     cleaning_type VARCHAR(100),
     cleaning_ts TIMESTAMP,
     notes TEXT,
     cost INTEGER NOT NULL,
-    done BOOLEAN DEFAULT FALSE
+    done BOOLEAN DEFAULT FALSE,
+    version INTEGER NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    cancelled_at TIMESTAMP,
+    cancel_reason VARCHAR(255),
+    -- manual priority, overrides the computed one
+    priority_override VARCHAR(16),
+    -- when the order was marked done
+    done_at TIMESTAMP,
+    UNIQUE(rule_id, cleaning_ts)
 );
 
 -- Cleaner Orders junction table
@@ -40,4 +179,165 @@ CREATE TABLE IF NOT EXISTS cleaner_orders (
     order_id INTEGER NOT NULL REFERENCES cleaning_orders(id) ON DELETE CASCADE,
     cleaner_id INTEGER NOT NULL REFERENCES cleaners(id) ON DELETE CASCADE,
     UNIQUE(order_id, cleaner_id)
-); 
\ No newline at end of file
+);
+
+-- Scheduled working time of cleaners
+CREATE TABLE IF NOT EXISTS shifts (
+    id SERIAL PRIMARY KEY,
+    cleaner_id INTEGER NOT NULL REFERENCES cleaners(id) ON DELETE CASCADE,
+    start_ts TIMESTAMP NOT NULL,
+    end_ts TIMESTAMP NOT NULL,
+    notes TEXT,
+    version INTEGER NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Inspection checklist items
+CREATE TABLE IF NOT EXISTS inspection_items (
+    id SERIAL PRIMARY KEY,
+    property_id INTEGER NOT NULL REFERENCES properties(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    weight INTEGER NOT NULL DEFAULT 1,
+    active BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+-- Inspections of done cleaning orders
+CREATE TABLE IF NOT EXISTS inspections (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL REFERENCES cleaning_orders(id) ON DELETE CASCADE,
+    inspector VARCHAR(255) NOT NULL,
+    comments TEXT,
+    passed BOOLEAN NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    reclean_order_id INTEGER REFERENCES cleaning_orders(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Inspection results per checklist item
+CREATE TABLE IF NOT EXISTS inspection_results (
+    inspection_id INTEGER NOT NULL REFERENCES inspections(id) ON DELETE CASCADE,
+    item_id INTEGER NOT NULL REFERENCES inspection_items(id) ON DELETE CASCADE,
+    passed BOOLEAN NOT NULL,
+    comment TEXT,
+    PRIMARY KEY(inspection_id, item_id)
+);
+
+-- Checklist templates per cleaning type
+CREATE TABLE IF NOT EXISTS checklist_templates (
+    id SERIAL PRIMARY KEY,
+    property_id INTEGER NOT NULL REFERENCES properties(id) ON DELETE CASCADE,
+    cleaning_type VARCHAR(100) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT TRUE,
+    position INTEGER NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE
+);
+
+-- Checklists of cleaning orders
+CREATE TABLE IF NOT EXISTS order_checklist_items (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL REFERENCES cleaning_orders(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    required BOOLEAN NOT NULL,
+    position INTEGER NOT NULL,
+    checked_at TIMESTAMP,
+    checked_by INTEGER REFERENCES cleaners(id) ON DELETE SET NULL
+);
+
+-- Files attached to cleaning orders, stored in the blob store under a key
+-- derived from the id
+CREATE TABLE IF NOT EXISTS attachments (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL REFERENCES cleaning_orders(id) ON DELETE CASCADE,
+    filename VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    description TEXT,
+    uploaded_by INTEGER REFERENCES cleaners(id) ON DELETE SET NULL,
+    has_thumbnail BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Maintenance tickets of rooms
+CREATE TABLE IF NOT EXISTS maintenance_tickets (
+    id SERIAL PRIMARY KEY,
+    room_id INTEGER NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    order_id INTEGER REFERENCES cleaning_orders(id) ON DELETE SET NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT,
+    priority VARCHAR(32) NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    assignee VARCHAR(255),
+    reported_by INTEGER REFERENCES cleaners(id) ON DELETE SET NULL,
+    out_of_order BOOLEAN NOT NULL DEFAULT FALSE,
+    room_block_id INTEGER REFERENCES room_blocks(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMP,
+    version INTEGER NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Lost and found items
+CREATE TABLE IF NOT EXISTS lost_items (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER REFERENCES cleaning_orders(id) ON DELETE SET NULL,
+    room_id INTEGER NOT NULL REFERENCES rooms(id) ON DELETE CASCADE,
+    booking_id INTEGER REFERENCES bookings(id) ON DELETE SET NULL,
+    description TEXT NOT NULL,
+    storage_location VARCHAR(255) NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    found_at TIMESTAMP NOT NULL,
+    found_by INTEGER REFERENCES cleaners(id) ON DELETE SET NULL,
+    dispose_after TIMESTAMP NOT NULL,
+    returned_to VARCHAR(255),
+    notes TEXT,
+    closed_at TIMESTAMP,
+    version INTEGER NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Linen and consumables
+CREATE TABLE IF NOT EXISTS inventory_items (
+    id SERIAL PRIMARY KEY,
+    property_id INTEGER NOT NULL REFERENCES properties(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    unit VARCHAR(32) NOT NULL,
+    min_stock INTEGER NOT NULL DEFAULT 0,
+    default_location VARCHAR(255) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    version INTEGER NOT NULL DEFAULT 1,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Stock levels per storage location
+CREATE TABLE IF NOT EXISTS inventory_stock (
+    item_id INTEGER NOT NULL REFERENCES inventory_items(id) ON DELETE CASCADE,
+    location VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (item_id, location)
+);
+
+-- Deliveries, corrections and consumptions
+CREATE TABLE IF NOT EXISTS inventory_movements (
+    id SERIAL PRIMARY KEY,
+    item_id INTEGER NOT NULL REFERENCES inventory_items(id) ON DELETE CASCADE,
+    location VARCHAR(255) NOT NULL,
+    quantity INTEGER NOT NULL,
+    kind VARCHAR(32) NOT NULL,
+    order_id INTEGER REFERENCES cleaning_orders(id) ON DELETE SET NULL,
+    note TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- Default consumption of a cleaning type
+CREATE TABLE IF NOT EXISTS consumption_defaults (
+    id SERIAL PRIMARY KEY,
+    property_id INTEGER NOT NULL REFERENCES properties(id) ON DELETE CASCADE,
+    cleaning_type VARCHAR(100) NOT NULL,
+    item_id INTEGER NOT NULL REFERENCES inventory_items(id) ON DELETE CASCADE,
+    quantity INTEGER NOT NULL,
+    UNIQUE (property_id, cleaning_type, item_id)
+);
@@ -0,0 +1,660 @@
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/db"
+	"gopkg.in/yaml.v3"
+)
+
+// Config holds the whole application configuration.
+//
+// Values are resolved in the following order, later sources overriding
+// earlier ones:
+//
+//  1. built-in defaults (see Default)
+//  2. YAML file given by the -config flag or the CLEANY_CONFIG variable
+//  3. environment variables (see applyEnv)
+//  4. command line flags
+type Config struct {
+	Server      ServerConfig      `yaml:"server"`
+	Database    DatabaseConfig    `yaml:"database"`
+	Hotel       HotelConfig       `yaml:"hotel"`
+	Auth        AuthConfig        `yaml:"auth"`
+	Schedule    ScheduleConfig    `yaml:"schedule"`
+	Log         LogConfig         `yaml:"log"`
+	Metrics     MetricsConfig     `yaml:"metrics"`
+	Tracing     TracingConfig     `yaml:"tracing"`
+	Retention   RetentionConfig   `yaml:"retention"`
+	Attachments AttachmentsConfig `yaml:"attachments"`
+}
+
+// ServerConfig holds HTTP server configuration
+type ServerConfig struct {
+	ListenAddr string    `yaml:"listen_addr"`
+	TLS        TLSConfig `yaml:"tls"`
+}
+
+// TLSConfig holds TLS certificate configuration. TLS is enabled when both
+// files are set.
+type TLSConfig struct {
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+}
+
+// Enabled reports whether the server should serve HTTPS
+func (t TLSConfig) Enabled() bool {
+	return t.CertFile != "" && t.KeyFile != ""
+}
+
+// DatabaseConfig holds database connection and pool configuration.
+// When DSN is set it takes precedence over the individual connection fields.
+type DatabaseConfig struct {
+	DSN             string        `yaml:"dsn"`
+	Host            string        `yaml:"host"`
+	Port            int           `yaml:"port"`
+	User            string        `yaml:"user"`
+	Password        string        `yaml:"password"`
+	Name            string        `yaml:"name"`
+	SSLMode         string        `yaml:"sslmode"`
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+}
+
+// HotelConfig holds hotel-wide settings
+type HotelConfig struct {
+	TimeZone string `yaml:"time_zone"`
+	// DefaultProperty is the property of requests that select none with
+	// the X-Property-Id header
+	DefaultProperty int `yaml:"default_property"`
+}
+
+// AuthConfig holds property authorization settings
+type AuthConfig struct {
+	// UserHeader is the header an authenticating proxy sets to the name of
+	// the user, authorization is disabled when empty
+	UserHeader string `yaml:"user_header"`
+	// Admins may access every property and manage properties
+	Admins []string `yaml:"admins"`
+}
+
+// Enabled reports whether requests are authorized
+func (a AuthConfig) Enabled() bool {
+	return a.UserHeader != ""
+}
+
+// ScheduleConfig holds defaults used when generating cleaning orders for bookings
+type ScheduleConfig struct {
+	// PeriodicCleaningTime is the local time of day of periodic cleanings, "HH:MM"
+	PeriodicCleaningTime string `yaml:"periodic_cleaning_time"`
+	// GeneralCleaningDelay is the delay after check-out of the general cleaning
+	GeneralCleaningDelay time.Duration `yaml:"general_cleaning_delay"`
+	PeriodicCost         int           `yaml:"periodic_cost"`
+	GeneralCost          int           `yaml:"general_cost"`
+	// DayStart is the local time of day cleaners start working, "HH:MM"
+	DayStart string `yaml:"day_start"`
+	// PeriodicDuration and GeneralDuration are the estimated durations of
+	// the cleanings, used to plan the routes of cleaners
+	PeriodicDuration time.Duration `yaml:"periodic_duration"`
+	GeneralDuration  time.Duration `yaml:"general_duration"`
+	// FloorChangeTime is the time it takes a cleaner to move to another floor
+	FloorChangeTime time.Duration `yaml:"floor_change_time"`
+	// UrgentAlertLead is how long before the next check-in an urgent
+	// departure cleaning without a cleaner is reported
+	UrgentAlertLead time.Duration `yaml:"urgent_alert_lead"`
+	// StaffingTolerance is the fraction of the forecast workload the shift
+	// capacity of a day may exceed it by before the day is overstaffed
+	StaffingTolerance float64 `yaml:"staffing_tolerance"`
+	// RecurrenceHorizon is how far ahead the orders of recurrence rules are
+	// generated
+	RecurrenceHorizon time.Duration `yaml:"recurrence_horizon"`
+}
+
+// LogConfig holds logging configuration
+type LogConfig struct {
+	Level  string `yaml:"level"`
+	Format string `yaml:"format"`
+	// SlowQueryThreshold is the duration above which queries are logged as
+	// warnings, zero disables the warnings
+	SlowQueryThreshold time.Duration `yaml:"slow_query_threshold"`
+}
+
+// MetricsConfig holds Prometheus metrics configuration
+type MetricsConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Path    string `yaml:"path"`
+}
+
+// TracingConfig holds OpenTelemetry tracing configuration
+type TracingConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Endpoint is the OTLP/HTTP collector, host:port or URL. When empty the
+	// standard OTEL_EXPORTER_OTLP_* variables are used.
+	Endpoint    string  `yaml:"endpoint"`
+	Insecure    bool    `yaml:"insecure"`
+	SampleRatio float64 `yaml:"sample_ratio"`
+	ServiceName string  `yaml:"service_name"`
+}
+
+// RetentionConfig holds data retention settings
+type RetentionConfig struct {
+	// Deleted is how long deleted rooms, cleaners and bookings are kept
+	// before "cleany purge" removes them
+	Deleted time.Duration `yaml:"deleted"`
+	// LostItems is how long found items are stored before they are due
+	// for disposal
+	LostItems time.Duration `yaml:"lost_items"`
+	// GuestData is how long the personal data of guests is kept after
+	// they left before "cleany anonymize" erases it
+	GuestData time.Duration `yaml:"guest_data"`
+}
+
+// AttachmentsConfig holds file attachment settings
+type AttachmentsConfig struct {
+	// MaxSize is the largest accepted file in bytes
+	MaxSize int `yaml:"max_size"`
+	// AllowedTypes are the accepted media types, detected from the content
+	AllowedTypes []string `yaml:"allowed_types"`
+	// ThumbnailSize is the longest side of image thumbnails in pixels, zero
+	// disables thumbnails
+	ThumbnailSize int           `yaml:"thumbnail_size"`
+	Storage       StorageConfig `yaml:"storage"`
+}
+
+// StorageConfig holds blob store configuration
+type StorageConfig struct {
+	// Backend is "local" or "s3"
+	Backend string `yaml:"backend"`
+	// Dir is the directory of the local backend
+	Dir string   `yaml:"dir"`
+	S3  S3Config `yaml:"s3"`
+}
+
+// S3Config holds the settings of an S3-compatible backend
+type S3Config struct {
+	// Endpoint is the base URL of the service, e.g. http://minio:9000
+	Endpoint  string `yaml:"endpoint"`
+	Region    string `yaml:"region"`
+	Bucket    string `yaml:"bucket"`
+	AccessKey string `yaml:"access_key"`
+	SecretKey string `yaml:"secret_key"`
+}
+
+// Default returns the built-in configuration
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			ListenAddr: "0.0.0.0:8080",
+		},
+		Database: DatabaseConfig{
+			Host:            "localhost",
+			Port:            5432,
+			User:            "postgres",
+			Password:        "password",
+			Name:            "cleany",
+			SSLMode:         "disable",
+			MaxOpenConns:    10,
+			MaxIdleConns:    5,
+			ConnMaxLifetime: 30 * time.Minute,
+		},
+		Hotel: HotelConfig{
+			TimeZone:        "UTC",
+			DefaultProperty: 1,
+		},
+		Schedule: ScheduleConfig{
+			PeriodicCleaningTime: "13:00",
+			GeneralCleaningDelay: time.Hour,
+			PeriodicCost:         100,
+			GeneralCost:          200,
+			DayStart:             "08:00",
+			PeriodicDuration:     30 * time.Minute,
+			GeneralDuration:      time.Hour,
+			FloorChangeTime:      5 * time.Minute,
+			UrgentAlertLead:      2 * time.Hour,
+			StaffingTolerance:    0.2,
+			RecurrenceHorizon:    28 * 24 * time.Hour,
+		},
+		Log: LogConfig{
+			Level:              "info",
+			Format:             "json",
+			SlowQueryThreshold: 200 * time.Millisecond,
+		},
+		Metrics: MetricsConfig{
+			Enabled: true,
+			Path:    "/metrics",
+		},
+		Tracing: TracingConfig{
+			SampleRatio: 1,
+			ServiceName: "cleany",
+		},
+		Retention: RetentionConfig{
+			Deleted:   90 * 24 * time.Hour,
+			LostItems: 90 * 24 * time.Hour,
+			GuestData: 365 * 24 * time.Hour,
+		},
+		Attachments: AttachmentsConfig{
+			MaxSize:       10 << 20,
+			AllowedTypes:  []string{"image/jpeg", "image/png", "image/gif", "image/webp", "application/pdf"},
+			ThumbnailSize: 256,
+			Storage: StorageConfig{
+				Backend: "local",
+				Dir:     "data/attachments",
+				S3: S3Config{
+					Region: "us-east-1",
+				},
+			},
+		},
+	}
+}
+
+// Load builds the configuration from defaults, the config file, environment
+// variables and the given command line arguments, and validates the result.
+func Load(name string, args []string) (*Config, error) {
+	return LoadFlags(flag.NewFlagSet(name, flag.ContinueOnError), args)
+}
+
+// LoadFlags is like Load but parses the arguments with fs, which may already
+// define command specific flags
+func LoadFlags(fs *flag.FlagSet, args []string) (*Config, error) {
+	configPath := fs.String("config", os.Getenv("CLEANY_CONFIG"), "Path to YAML config file")
+	listen := fs.String("listen", "", "HTTP listen address, host:port")
+	port := fs.String("port", "", "HTTP listen port (overrides the port of -listen)")
+	dsn := fs.String("db-dsn", "", "PostgreSQL connection string")
+	tz := fs.String("tz", "", "Hotel time zone, IANA name")
+	logLevel := fs.String("log-level", "", "Log level: debug, info, warn, error")
+	logFormat := fs.String("log-format", "", "Log format: json, text")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	cfg := Default()
+
+	if *configPath != "" {
+		if err := cfg.loadFile(*configPath); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := cfg.applyEnv(); err != nil {
+		return nil, err
+	}
+
+	// Only flags given explicitly override the previous sources
+	portSet := false
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "listen":
+			cfg.Server.ListenAddr = *listen
+		case "db-dsn":
+			cfg.Database.DSN = *dsn
+		case "tz":
+			cfg.Hotel.TimeZone = *tz
+		case "log-level":
+			cfg.Log.Level = *logLevel
+		case "log-format":
+			cfg.Log.Format = *logFormat
+		case "port":
+			portSet = true
+		}
+	})
+	// -port is applied after -listen so that both can be combined
+	if portSet {
+		if err := cfg.setPort(*port); err != nil {
+			return nil, fmt.Errorf("invalid -port: %w", err)
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// loadFile overlays values from a YAML file
+func (c *Config) loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	defer f.Close()
+
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// applyEnv overlays values from environment variables
+func (c *Config) applyEnv() error {
+	var errs []error
+
+	setString := func(key string, dst *string) {
+		if value := os.Getenv(key); value != "" {
+			*dst = value
+		}
+	}
+	setInt := func(key string, dst *int) {
+		if value := os.Getenv(key); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %q is not an integer", key, value))
+				return
+			}
+			*dst = n
+		}
+	}
+	setBool := func(key string, dst *bool) {
+		if value := os.Getenv(key); value != "" {
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %q is not a boolean", key, value))
+				return
+			}
+			*dst = b
+		}
+	}
+	setFloat := func(key string, dst *float64) {
+		if value := os.Getenv(key); value != "" {
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %q is not a number", key, value))
+				return
+			}
+			*dst = f
+		}
+	}
+	setDuration := func(key string, dst *time.Duration) {
+		if value := os.Getenv(key); value != "" {
+			d, err := time.ParseDuration(value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %q is not a duration", key, value))
+				return
+			}
+			*dst = d
+		}
+	}
+
+	setString("CLEANY_LISTEN_ADDR", &c.Server.ListenAddr)
+	if value := os.Getenv("PORT"); value != "" {
+		if err := c.setPort(value); err != nil {
+			errs = append(errs, fmt.Errorf("PORT: %w", err))
+		}
+	}
+	setString("CLEANY_TLS_CERT_FILE", &c.Server.TLS.CertFile)
+	setString("CLEANY_TLS_KEY_FILE", &c.Server.TLS.KeyFile)
+
+	setString("DB_DSN", &c.Database.DSN)
+	setString("DB_HOST", &c.Database.Host)
+	setInt("DB_PORT", &c.Database.Port)
+	setString("DB_USER", &c.Database.User)
+	setString("DB_PASSWORD", &c.Database.Password)
+	setString("DB_NAME", &c.Database.Name)
+	setString("DB_SSLMODE", &c.Database.SSLMode)
+	setInt("DB_MAX_OPEN_CONNS", &c.Database.MaxOpenConns)
+	setInt("DB_MAX_IDLE_CONNS", &c.Database.MaxIdleConns)
+	setDuration("DB_CONN_MAX_LIFETIME", &c.Database.ConnMaxLifetime)
+
+	setString("CLEANY_TIMEZONE", &c.Hotel.TimeZone)
+	setInt("CLEANY_DEFAULT_PROPERTY", &c.Hotel.DefaultProperty)
+
+	setString("CLEANY_AUTH_USER_HEADER", &c.Auth.UserHeader)
+	if value := os.Getenv("CLEANY_AUTH_ADMINS"); value != "" {
+		c.Auth.Admins = strings.Split(value, ",")
+	}
+
+	setString("CLEANY_PERIODIC_CLEANING_TIME", &c.Schedule.PeriodicCleaningTime)
+	setDuration("CLEANY_GENERAL_CLEANING_DELAY", &c.Schedule.GeneralCleaningDelay)
+	setInt("CLEANY_PERIODIC_COST", &c.Schedule.PeriodicCost)
+	setInt("CLEANY_GENERAL_COST", &c.Schedule.GeneralCost)
+	setString("CLEANY_DAY_START", &c.Schedule.DayStart)
+	setDuration("CLEANY_PERIODIC_DURATION", &c.Schedule.PeriodicDuration)
+	setDuration("CLEANY_GENERAL_DURATION", &c.Schedule.GeneralDuration)
+	setDuration("CLEANY_FLOOR_CHANGE_TIME", &c.Schedule.FloorChangeTime)
+	setDuration("CLEANY_URGENT_ALERT_LEAD", &c.Schedule.UrgentAlertLead)
+	setFloat("CLEANY_STAFFING_TOLERANCE", &c.Schedule.StaffingTolerance)
+	setDuration("CLEANY_RECURRENCE_HORIZON", &c.Schedule.RecurrenceHorizon)
+
+	setString("CLEANY_LOG_LEVEL", &c.Log.Level)
+	setString("CLEANY_LOG_FORMAT", &c.Log.Format)
+	setDuration("CLEANY_LOG_SLOW_QUERY_THRESHOLD", &c.Log.SlowQueryThreshold)
+
+	setBool("CLEANY_METRICS_ENABLED", &c.Metrics.Enabled)
+	setString("CLEANY_METRICS_PATH", &c.Metrics.Path)
+
+	setBool("CLEANY_TRACING_ENABLED", &c.Tracing.Enabled)
+	setString("OTEL_EXPORTER_OTLP_ENDPOINT", &c.Tracing.Endpoint)
+	setBool("CLEANY_TRACING_INSECURE", &c.Tracing.Insecure)
+	setFloat("CLEANY_TRACING_SAMPLE_RATIO", &c.Tracing.SampleRatio)
+	setString("OTEL_SERVICE_NAME", &c.Tracing.ServiceName)
+
+	setDuration("CLEANY_RETENTION_DELETED", &c.Retention.Deleted)
+	setDuration("CLEANY_RETENTION_LOST_ITEMS", &c.Retention.LostItems)
+	setDuration("CLEANY_RETENTION_GUEST_DATA", &c.Retention.GuestData)
+
+	setInt("CLEANY_ATTACHMENTS_MAX_SIZE", &c.Attachments.MaxSize)
+	if value := os.Getenv("CLEANY_ATTACHMENTS_ALLOWED_TYPES"); value != "" {
+		c.Attachments.AllowedTypes = strings.Split(value, ",")
+	}
+	setInt("CLEANY_ATTACHMENTS_THUMBNAIL_SIZE", &c.Attachments.ThumbnailSize)
+	setString("CLEANY_STORAGE_BACKEND", &c.Attachments.Storage.Backend)
+	setString("CLEANY_STORAGE_DIR", &c.Attachments.Storage.Dir)
+	setString("CLEANY_S3_ENDPOINT", &c.Attachments.Storage.S3.Endpoint)
+	setString("CLEANY_S3_REGION", &c.Attachments.Storage.S3.Region)
+	setString("CLEANY_S3_BUCKET", &c.Attachments.Storage.S3.Bucket)
+	setString("AWS_ACCESS_KEY_ID", &c.Attachments.Storage.S3.AccessKey)
+	setString("AWS_SECRET_ACCESS_KEY", &c.Attachments.Storage.S3.SecretKey)
+
+	return errors.Join(errs...)
+}
+
+// setPort replaces the port of the listen address
+func (c *Config) setPort(port string) error {
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return fmt.Errorf("%q is not a valid port", port)
+	}
+	host, _, err := net.SplitHostPort(c.Server.ListenAddr)
+	if err != nil {
+		host = "0.0.0.0"
+	}
+	c.Server.ListenAddr = net.JoinHostPort(host, port)
+	return nil
+}
+
+// Validate checks the configuration and reports every problem found
+func (c *Config) Validate() error {
+	var errs []error
+
+	if _, port, err := net.SplitHostPort(c.Server.ListenAddr); err != nil {
+		errs = append(errs, fmt.Errorf("server.listen_addr: %w", err))
+	} else if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		errs = append(errs, fmt.Errorf("server.listen_addr: %q is not a valid port", port))
+	}
+	if (c.Server.TLS.CertFile == "") != (c.Server.TLS.KeyFile == "") {
+		errs = append(errs, fmt.Errorf("server.tls: cert_file and key_file must be set together"))
+	}
+
+	if c.Database.DSN == "" {
+		if c.Database.Host == "" {
+			errs = append(errs, fmt.Errorf("database.host is required when database.dsn is not set"))
+		}
+		if c.Database.Port <= 0 || c.Database.Port > 65535 {
+			errs = append(errs, fmt.Errorf("database.port: %d is out of range", c.Database.Port))
+		}
+		if c.Database.Name == "" {
+			errs = append(errs, fmt.Errorf("database.name is required when database.dsn is not set"))
+		}
+	}
+	if c.Database.MaxOpenConns < 0 {
+		errs = append(errs, fmt.Errorf("database.max_open_conns must be non-negative"))
+	}
+	if c.Database.MaxIdleConns < 0 {
+		errs = append(errs, fmt.Errorf("database.max_idle_conns must be non-negative"))
+	}
+	if c.Database.ConnMaxLifetime < 0 {
+		errs = append(errs, fmt.Errorf("database.conn_max_lifetime must be non-negative"))
+	}
+
+	if _, err := time.LoadLocation(c.Hotel.TimeZone); err != nil {
+		errs = append(errs, fmt.Errorf("hotel.time_zone: %w", err))
+	}
+	if c.Hotel.DefaultProperty <= 0 {
+		errs = append(errs, fmt.Errorf("hotel.default_property must be positive"))
+	}
+
+	if len(c.Auth.Admins) > 0 && !c.Auth.Enabled() {
+		errs = append(errs, fmt.Errorf("auth.admins requires auth.user_header"))
+	}
+
+	if _, err := c.Schedule.PeriodicCleaningOffset(); err != nil {
+		errs = append(errs, fmt.Errorf("schedule.periodic_cleaning_time: %w", err))
+	}
+	if c.Schedule.GeneralCleaningDelay < 0 {
+		errs = append(errs, fmt.Errorf("schedule.general_cleaning_delay must be non-negative"))
+	}
+	if c.Schedule.PeriodicCost < 0 {
+		errs = append(errs, fmt.Errorf("schedule.periodic_cost must be non-negative"))
+	}
+	if c.Schedule.GeneralCost < 0 {
+		errs = append(errs, fmt.Errorf("schedule.general_cost must be non-negative"))
+	}
+	if _, err := c.Schedule.DayStartOffset(); err != nil {
+		errs = append(errs, fmt.Errorf("schedule.day_start: %w", err))
+	}
+	if c.Schedule.PeriodicDuration <= 0 {
+		errs = append(errs, fmt.Errorf("schedule.periodic_duration must be positive"))
+	}
+	if c.Schedule.GeneralDuration <= 0 {
+		errs = append(errs, fmt.Errorf("schedule.general_duration must be positive"))
+	}
+	if c.Schedule.FloorChangeTime < 0 {
+		errs = append(errs, fmt.Errorf("schedule.floor_change_time must be non-negative"))
+	}
+	if c.Schedule.UrgentAlertLead <= 0 {
+		errs = append(errs, fmt.Errorf("schedule.urgent_alert_lead must be positive"))
+	}
+	if c.Schedule.StaffingTolerance < 0 {
+		errs = append(errs, fmt.Errorf("schedule.staffing_tolerance must be non-negative"))
+	}
+	if c.Schedule.RecurrenceHorizon <= 0 {
+		errs = append(errs, fmt.Errorf("schedule.recurrence_horizon must be positive"))
+	}
+
+	switch c.Log.Level {
+	case "debug", "info", "warn", "error":
+	default:
+		errs = append(errs, fmt.Errorf("log.level: unknown level %q", c.Log.Level))
+	}
+	switch c.Log.Format {
+	case "json", "text":
+	default:
+		errs = append(errs, fmt.Errorf("log.format: unknown format %q", c.Log.Format))
+	}
+	if c.Log.SlowQueryThreshold < 0 {
+		errs = append(errs, fmt.Errorf("log.slow_query_threshold must be non-negative"))
+	}
+
+	if c.Metrics.Enabled && !strings.HasPrefix(c.Metrics.Path, "/") {
+		errs = append(errs, fmt.Errorf("metrics.path: %q must start with /", c.Metrics.Path))
+	}
+
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, fmt.Errorf("tracing.sample_ratio: %v is not between 0 and 1", c.Tracing.SampleRatio))
+	}
+	if c.Tracing.Enabled && c.Tracing.ServiceName == "" {
+		errs = append(errs, fmt.Errorf("tracing.service_name is required when tracing is enabled"))
+	}
+
+	if c.Retention.Deleted < 0 {
+		errs = append(errs, fmt.Errorf("retention.deleted must be non-negative"))
+	}
+	if c.Retention.LostItems <= 0 {
+		errs = append(errs, fmt.Errorf("retention.lost_items must be positive"))
+	}
+	if c.Retention.GuestData < 0 {
+		errs = append(errs, fmt.Errorf("retention.guest_data must be non-negative"))
+	}
+
+	if c.Attachments.MaxSize <= 0 {
+		errs = append(errs, fmt.Errorf("attachments.max_size must be positive"))
+	}
+	if len(c.Attachments.AllowedTypes) == 0 {
+		errs = append(errs, fmt.Errorf("attachments.allowed_types must not be empty"))
+	}
+	if c.Attachments.ThumbnailSize < 0 {
+		errs = append(errs, fmt.Errorf("attachments.thumbnail_size must be non-negative"))
+	}
+	switch c.Attachments.Storage.Backend {
+	case "local":
+		if c.Attachments.Storage.Dir == "" {
+			errs = append(errs, fmt.Errorf("attachments.storage.dir is required for the local backend"))
+		}
+	case "s3":
+		if c.Attachments.Storage.S3.Endpoint == "" || c.Attachments.Storage.S3.Bucket == "" {
+			errs = append(errs, fmt.Errorf("attachments.storage.s3: endpoint and bucket are required for the s3 backend"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("attachments.storage.backend: unknown backend %q", c.Attachments.Storage.Backend))
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid configuration:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+// Location returns the hotel time zone
+func (h HotelConfig) Location() *time.Location {
+	loc, err := time.LoadLocation(h.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// PeriodicCleaningOffset returns the periodic cleaning time as an offset from midnight
+func (s ScheduleConfig) PeriodicCleaningOffset() (time.Duration, error) {
+	return clockOffset(s.PeriodicCleaningTime)
+}
+
+// DayStartOffset returns the start of the working day as an offset from midnight
+func (s ScheduleConfig) DayStartOffset() (time.Duration, error) {
+	return clockOffset(s.DayStart)
+}
+
+// clockOffset parses a "HH:MM" time of day as an offset from midnight
+func clockOffset(clock string) (time.Duration, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a HH:MM time", clock)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// DB returns the configuration for the db package
+func (d DatabaseConfig) DB() *db.Config {
+	return &db.Config{
+		DSN:             d.DSN,
+		Host:            d.Host,
+		Port:            d.Port,
+		User:            d.User,
+		Password:        d.Password,
+		DBName:          d.Name,
+		SSLMode:         d.SSLMode,
+		MaxOpenConns:    d.MaxOpenConns,
+		MaxIdleConns:    d.MaxIdleConns,
+		ConnMaxLifetime: d.ConnMaxLifetime,
+	}
+}
@@ -0,0 +1,255 @@
+package config
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadPrecedence(t *testing.T) {
+	file := `
+server:
+  listen_addr: 127.0.0.1:9000
+hotel:
+  time_zone: Europe/Berlin
+log:
+  level: debug
+schedule:
+  periodic_cost: 150
+`
+
+	tests := []struct {
+		name   string
+		file   string
+		env    map[string]string
+		args   []string
+		listen string
+		tz     string
+		level  string
+		cost   int
+	}{
+		{
+			name:   "defaults",
+			listen: "0.0.0.0:8080",
+			tz:     "UTC",
+			level:  "info",
+			cost:   100,
+		},
+		{
+			name:   "file overrides defaults",
+			file:   file,
+			listen: "127.0.0.1:9000",
+			tz:     "Europe/Berlin",
+			level:  "debug",
+			cost:   150,
+		},
+		{
+			name:   "environment overrides file",
+			file:   file,
+			env:    map[string]string{"CLEANY_LOG_LEVEL": "warn", "PORT": "9100"},
+			listen: "127.0.0.1:9100",
+			tz:     "Europe/Berlin",
+			level:  "warn",
+			cost:   150,
+		},
+		{
+			name:   "flags override environment",
+			file:   file,
+			env:    map[string]string{"CLEANY_LOG_LEVEL": "warn", "CLEANY_TIMEZONE": "Europe/Paris"},
+			args:   []string{"-log-level", "error", "-tz", "UTC"},
+			listen: "127.0.0.1:9000",
+			tz:     "UTC",
+			level:  "error",
+			cost:   150,
+		},
+		{
+			name:   "port applied after listen",
+			env:    map[string]string{"PORT": "9100"},
+			args:   []string{"-port", "9200", "-listen", "localhost:9300"},
+			listen: "localhost:9200",
+			tz:     "UTC",
+			level:  "info",
+			cost:   100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"CLEANY_CONFIG", "CLEANY_LISTEN_ADDR", "PORT", "CLEANY_TIMEZONE", "CLEANY_LOG_LEVEL", "CLEANY_PERIODIC_COST"} {
+				t.Setenv(key, "")
+			}
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+			args := tt.args
+			if tt.file != "" {
+				path := filepath.Join(t.TempDir(), "cleany.yaml")
+				if err := os.WriteFile(path, []byte(tt.file), 0o600); err != nil {
+					t.Fatal(err)
+				}
+				args = append([]string{"-config", path}, args...)
+			}
+
+			cfg, err := Load("test", args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cfg.Server.ListenAddr != tt.listen {
+				t.Errorf("server.listen_addr = %q, want %q", cfg.Server.ListenAddr, tt.listen)
+			}
+			if cfg.Hotel.TimeZone != tt.tz {
+				t.Errorf("hotel.time_zone = %q, want %q", cfg.Hotel.TimeZone, tt.tz)
+			}
+			if cfg.Log.Level != tt.level {
+				t.Errorf("log.level = %q, want %q", cfg.Log.Level, tt.level)
+			}
+			if cfg.Schedule.PeriodicCost != tt.cost {
+				t.Errorf("schedule.periodic_cost = %d, want %d", cfg.Schedule.PeriodicCost, tt.cost)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(c *Config)
+		want   []string
+	}{
+		{
+			name:   "defaults",
+			modify: func(c *Config) {},
+		},
+		{
+			name: "every problem reported",
+			modify: func(c *Config) {
+				c.Server.ListenAddr = "localhost"
+				c.Database.Port = 0
+				c.Hotel.TimeZone = "Mars/Olympus"
+				c.Schedule.PeriodicCleaningTime = "1pm"
+				c.Log.Level = "verbose"
+				c.Attachments.Storage.Backend = "ftp"
+			},
+			want: []string{
+				"server.listen_addr",
+				"database.port: 0 is out of range",
+				"hotel.time_zone",
+				"schedule.periodic_cleaning_time",
+				`log.level: unknown level "verbose"`,
+				`attachments.storage.backend: unknown backend "ftp"`,
+			},
+		},
+		{
+			name: "dsn replaces the connection fields",
+			modify: func(c *Config) {
+				c.Database.DSN = "postgres://localhost/cleany"
+				c.Database.Host = ""
+				c.Database.Name = ""
+			},
+		},
+		{
+			name: "tls files set together",
+			modify: func(c *Config) {
+				c.Server.TLS.CertFile = "cert.pem"
+			},
+			want: []string{"server.tls: cert_file and key_file must be set together"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			tt.modify(cfg)
+
+			err := cfg.Validate()
+			if len(tt.want) == 0 {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Validate() error = nil")
+			}
+			// One line per problem after the heading
+			lines := strings.Split(err.Error(), "\n")[1:]
+			if len(lines) != len(tt.want) {
+				t.Fatalf("Validate() reported %d problems, want %d:\n%v", len(lines), len(tt.want), err)
+			}
+			for i, want := range tt.want {
+				if !strings.HasPrefix(lines[i], want) {
+					t.Errorf("problem %d = %q, want %q", i, lines[i], want)
+				}
+			}
+		})
+	}
+}
+
+func TestPrintMasksSecrets(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(c *Config)
+		secrets []string
+		want    []string
+	}{
+		{
+			name: "password and s3 secret key",
+			modify: func(c *Config) {
+				c.Database.Password = "hunter2"
+				c.Attachments.Storage.S3.AccessKey = "AKIAEXAMPLE"
+				c.Attachments.Storage.S3.SecretKey = "s3cr3t"
+			},
+			secrets: []string{"hunter2", "s3cr3t"},
+			want:    []string{"password: xxxxx", "access_key: AKIAEXAMPLE", "secret_key: xxxxx"},
+		},
+		{
+			name: "url dsn",
+			modify: func(c *Config) {
+				c.Database.DSN = "postgres://cleany:hunter2@db:5432/cleany?sslmode=disable"
+			},
+			secrets: []string{"hunter2"},
+			want:    []string{"dsn: postgres://cleany:xxxxx@db:5432/cleany?sslmode=disable"},
+		},
+		{
+			name: "key value dsn",
+			modify: func(c *Config) {
+				c.Database.DSN = "host=db user=cleany password='hunter 2' dbname=cleany"
+			},
+			secrets: []string{"hunter"},
+			want:    []string{"dsn: host=db user=cleany password=xxxxx dbname=cleany"},
+		},
+		{
+			name:    "empty secrets left empty",
+			modify:  func(c *Config) { c.Database.Password = "" },
+			secrets: []string{secretMask},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			tt.modify(cfg)
+			original := *cfg
+
+			var out bytes.Buffer
+			if err := cfg.Print(&out); err != nil {
+				t.Fatal(err)
+			}
+			for _, secret := range tt.secrets {
+				if strings.Contains(out.String(), secret) {
+					t.Errorf("printed configuration contains %q:\n%s", secret, out.String())
+				}
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("printed configuration does not contain %q:\n%s", want, out.String())
+				}
+			}
+			if cfg.Database != original.Database || cfg.Attachments.Storage.S3 != original.Attachments.Storage.S3 {
+				t.Error("Print() changed the configuration")
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+
+	"gopkg.in/yaml.v3"
+)
+
+// secretMask matches the mask used by url.URL.Redacted
+const secretMask = "xxxxx"
+
+// dsnPasswordRe matches the password of a key=value style DSN
+var dsnPasswordRe = regexp.MustCompile(`(password=)('[^']*'|\S+)`)
+
+// Masked returns a copy of the configuration with secrets replaced
+func (c *Config) Masked() *Config {
+	masked := *c
+	if masked.Database.Password != "" {
+		masked.Database.Password = secretMask
+	}
+	masked.Database.DSN = maskDSN(masked.Database.DSN)
+	if masked.Attachments.Storage.S3.SecretKey != "" {
+		masked.Attachments.Storage.S3.SecretKey = secretMask
+	}
+	return &masked
+}
+
+// Print writes the configuration as YAML with secrets masked
+func (c *Config) Print(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(c.Masked()); err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+	return enc.Close()
+}
+
+// maskDSN hides the password of both URL and key=value style DSNs
+func maskDSN(dsn string) string {
+	if dsn == "" {
+		return dsn
+	}
+	if u, err := url.Parse(dsn); err == nil && u.Scheme != "" {
+		return u.Redacted()
+	}
+	return dsnPasswordRe.ReplaceAllString(dsn, "${1}"+secretMask)
+}
@@ -4,21 +4,26 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"log"
-	"os"
-	"strconv"
+	"log/slog"
+	"time"
 
 	_ "github.com/lib/pq"
 )
 
 // Config holds database configuration
 type Config struct {
+	// DSN, when set, is used as is instead of the individual connection fields
+	DSN      string
 	Host     string
 	Port     int
 	User     string
 	Password string
 	DBName   string
 	SSLMode  string
+
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
 }
 
 // DB interface for database operations
@@ -34,20 +39,27 @@ type postgresDB struct {
 
 // NewPostgresDB creates a new PostgreSQL database connection
 func NewPostgresDB(config *Config) (DB, error) {
-	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
-		config.Host, config.Port, config.User, config.Password, config.DBName, config.SSLMode)
+	dsn := config.DSN
+	if dsn == "" {
+		dsn = fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
+			config.Host, config.Port, config.User, config.Password, config.DBName, config.SSLMode)
+	}
 
 	db, err := sql.Open("postgres", dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to open database: %w", err)
 	}
 
+	db.SetMaxOpenConns(config.MaxOpenConns)
+	db.SetMaxIdleConns(config.MaxIdleConns)
+	db.SetConnMaxLifetime(config.ConnMaxLifetime)
+
 	// Test the connection
 	if err := db.Ping(); err != nil {
 		return nil, fmt.Errorf("failed to ping database: %w", err)
 	}
 
-	log.Println("Successfully connected to PostgreSQL database")
+	slog.Info("connected to PostgreSQL database")
 
 	return &postgresDB{db: db}, nil
 }
@@ -71,32 +83,9 @@ func DefaultConfig() *Config {
 		Password: "password",
 		DBName:   "cleany",
 		SSLMode:  "disable",
-	}
-}
-
-// ConfigFromEnv returns database configuration from environment variables
-func ConfigFromEnv() *Config {
-	port := 5432
-	if portStr := os.Getenv("DB_PORT"); portStr != "" {
-		if p, err := strconv.Atoi(portStr); err == nil {
-			port = p
-		}
-	}
-
-	return &Config{
-		Host:     getEnvOrDefault("DB_HOST", "localhost"),
-		Port:     port,
-		User:     getEnvOrDefault("DB_USER", "postgres"),
-		Password: getEnvOrDefault("DB_PASSWORD", "password"),
-		DBName:   getEnvOrDefault("DB_NAME", "cleany"),
-		SSLMode:  getEnvOrDefault("DB_SSLMODE", "disable"),
-	}
-}
 
-// getEnvOrDefault returns environment variable value or default if not set
-func getEnvOrDefault(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
-		return value
+		MaxOpenConns:    10,
+		MaxIdleConns:    5,
+		ConnMaxLifetime: 30 * time.Minute,
 	}
-	return defaultValue
 }
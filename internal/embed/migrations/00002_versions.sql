@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Версии строк для оптимистичной блокировки
+ALTER TABLE "rooms"
+ADD COLUMN "version" INTEGER NOT NULL DEFAULT 1,
+ADD COLUMN "updated_at" TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE "cleaners"
+ADD COLUMN "version" INTEGER NOT NULL DEFAULT 1,
+ADD COLUMN "updated_at" TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE "bookings"
+ADD COLUMN "version" INTEGER NOT NULL DEFAULT 1,
+ADD COLUMN "updated_at" TIMESTAMP NOT NULL DEFAULT NOW();
+ALTER TABLE "cleaning_orders"
+ADD COLUMN "version" INTEGER NOT NULL DEFAULT 1,
+ADD COLUMN "updated_at" TIMESTAMP NOT NULL DEFAULT NOW();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "rooms" DROP COLUMN "version", DROP COLUMN "updated_at";
+ALTER TABLE "cleaners" DROP COLUMN "version", DROP COLUMN "updated_at";
+ALTER TABLE "bookings" DROP COLUMN "version", DROP COLUMN "updated_at";
+ALTER TABLE "cleaning_orders" DROP COLUMN "version", DROP COLUMN "updated_at";
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Мягкое удаление номеров, уборщиков и бронирований
+ALTER TABLE "rooms" ADD COLUMN "deleted_at" TIMESTAMP;
+ALTER TABLE "cleaners" ADD COLUMN "deleted_at" TIMESTAMP;
+ALTER TABLE "bookings" ADD COLUMN "deleted_at" TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "rooms" DROP COLUMN "deleted_at";
+ALTER TABLE "cleaners" DROP COLUMN "deleted_at";
+ALTER TABLE "bookings" DROP COLUMN "deleted_at";
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Отмена бронирований и заказов на уборку
+ALTER TABLE "bookings"
+ADD COLUMN "cancelled_at" TIMESTAMP,
+ADD COLUMN "cancel_reason" VARCHAR(255);
+ALTER TABLE "cleaning_orders"
+ADD COLUMN "cancelled_at" TIMESTAMP,
+ADD COLUMN "cancel_reason" VARCHAR(255);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "bookings" DROP COLUMN "cancelled_at", DROP COLUMN "cancel_reason";
+ALTER TABLE "cleaning_orders" DROP COLUMN "cancelled_at", DROP COLUMN "cancel_reason";
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Фактические заезд и выезд
+ALTER TABLE "bookings"
+ADD COLUMN "checked_in_at" TIMESTAMP,
+ADD COLUMN "checked_out_at" TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "bookings" DROP COLUMN "checked_in_at", DROP COLUMN "checked_out_at";
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Статус уборки номера
+ALTER TABLE "rooms" ADD COLUMN "status" VARCHAR(32) NOT NULL DEFAULT 'clean';
+
+-- Периоды, когда номер не работает или не продаётся
+CREATE TABLE "room_blocks" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"room_id" INTEGER NOT NULL,
+	"kind" VARCHAR(32) NOT NULL,
+	"reason" VARCHAR(255) NOT NULL,
+	"starts_at" TIMESTAMP NOT NULL,
+	"ends_at" TIMESTAMP,
+	"created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("id")
+);
+
+ALTER TABLE "room_blocks"
+ADD FOREIGN KEY("room_id") REFERENCES "rooms"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "room_blocks";
+ALTER TABLE "rooms" DROP COLUMN "status";
+-- +goose StatementEnd
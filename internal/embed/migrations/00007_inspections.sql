@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Пункты чек-листа проверки уборки
+CREATE TABLE "inspection_items" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"name" VARCHAR(255) NOT NULL,
+	"description" TEXT,
+	"weight" INTEGER NOT NULL DEFAULT 1,
+	"active" BOOLEAN NOT NULL DEFAULT TRUE,
+	PRIMARY KEY("id")
+);
+
+-- Проверки выполненных уборок
+CREATE TABLE "inspections" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"order_id" INTEGER NOT NULL,
+	"inspector" VARCHAR(255) NOT NULL,
+	"comments" TEXT,
+	"passed" BOOLEAN NOT NULL,
+	"score" DOUBLE PRECISION NOT NULL,
+	"action" VARCHAR(32) NOT NULL,
+	"reclean_order_id" INTEGER,
+	"created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("id")
+);
+
+-- Результаты проверки по пунктам чек-листа
+CREATE TABLE "inspection_results" (
+	"inspection_id" INTEGER NOT NULL,
+	"item_id" INTEGER NOT NULL,
+	"passed" BOOLEAN NOT NULL,
+	"comment" TEXT,
+	PRIMARY KEY("inspection_id", "item_id")
+);
+
+ALTER TABLE "inspections"
+ADD FOREIGN KEY("order_id") REFERENCES "cleaning_orders"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE "inspections"
+ADD FOREIGN KEY("reclean_order_id") REFERENCES "cleaning_orders"("id")
+ON UPDATE CASCADE ON DELETE SET NULL;
+ALTER TABLE "inspection_results"
+ADD FOREIGN KEY("inspection_id") REFERENCES "inspections"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE "inspection_results"
+ADD FOREIGN KEY("item_id") REFERENCES "inspection_items"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "inspection_results";
+DROP TABLE IF EXISTS "inspections";
+DROP TABLE IF EXISTS "inspection_items";
+-- +goose StatementEnd
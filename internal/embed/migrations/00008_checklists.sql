@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Шаблоны чек-листов по типам уборки
+CREATE TABLE "checklist_templates" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"cleaning_type" VARCHAR(100) NOT NULL,
+	"name" VARCHAR(255) NOT NULL,
+	"required" BOOLEAN NOT NULL DEFAULT TRUE,
+	"position" INTEGER NOT NULL,
+	"active" BOOLEAN NOT NULL DEFAULT TRUE,
+	PRIMARY KEY("id")
+);
+
+-- Чек-листы заказов на уборку
+CREATE TABLE "order_checklist_items" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"order_id" INTEGER NOT NULL,
+	"name" VARCHAR(255) NOT NULL,
+	"required" BOOLEAN NOT NULL,
+	"position" INTEGER NOT NULL,
+	"checked_at" TIMESTAMP,
+	"checked_by" INTEGER,
+	PRIMARY KEY("id")
+);
+
+ALTER TABLE "order_checklist_items"
+ADD FOREIGN KEY("order_id") REFERENCES "cleaning_orders"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE "order_checklist_items"
+ADD FOREIGN KEY("checked_by") REFERENCES "cleaners"("id")
+ON UPDATE CASCADE ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "order_checklist_items";
+DROP TABLE IF EXISTS "checklist_templates";
+-- +goose StatementEnd
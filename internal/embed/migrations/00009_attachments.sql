@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Файлы, прикреплённые к заказам на уборку. Содержимое хранится в хранилище
+-- файлов по ключу, вычисляемому из id
+CREATE TABLE "attachments" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"order_id" INTEGER NOT NULL,
+	"filename" VARCHAR(255) NOT NULL,
+	"content_type" VARCHAR(255) NOT NULL,
+	"size" BIGINT NOT NULL,
+	"description" TEXT,
+	"uploaded_by" INTEGER,
+	"has_thumbnail" BOOLEAN NOT NULL DEFAULT FALSE,
+	"created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("id")
+);
+
+ALTER TABLE "attachments"
+ADD FOREIGN KEY("order_id") REFERENCES "cleaning_orders"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE "attachments"
+ADD FOREIGN KEY("uploaded_by") REFERENCES "cleaners"("id")
+ON UPDATE CASCADE ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "attachments";
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Заявки на ремонт номеров
+CREATE TABLE "maintenance_tickets" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"room_id" INTEGER NOT NULL,
+	"order_id" INTEGER,
+	"title" VARCHAR(255) NOT NULL,
+	"description" TEXT,
+	"priority" VARCHAR(32) NOT NULL,
+	"status" VARCHAR(32) NOT NULL,
+	"assignee" VARCHAR(255),
+	"reported_by" INTEGER,
+	"out_of_order" BOOLEAN NOT NULL DEFAULT FALSE,
+	"room_block_id" INTEGER,
+	"created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	"resolved_at" TIMESTAMP,
+	"version" INTEGER NOT NULL DEFAULT 1,
+	"updated_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("id")
+);
+
+ALTER TABLE "maintenance_tickets"
+ADD FOREIGN KEY("room_id") REFERENCES "rooms"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE "maintenance_tickets"
+ADD FOREIGN KEY("order_id") REFERENCES "cleaning_orders"("id")
+ON UPDATE CASCADE ON DELETE SET NULL;
+ALTER TABLE "maintenance_tickets"
+ADD FOREIGN KEY("reported_by") REFERENCES "cleaners"("id")
+ON UPDATE CASCADE ON DELETE SET NULL;
+ALTER TABLE "maintenance_tickets"
+ADD FOREIGN KEY("room_block_id") REFERENCES "room_blocks"("id")
+ON UPDATE CASCADE ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "maintenance_tickets";
+-- +goose StatementEnd
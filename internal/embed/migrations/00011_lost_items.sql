@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Забытые гостями вещи
+CREATE TABLE "lost_items" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"order_id" INTEGER,
+	"room_id" INTEGER NOT NULL,
+	"booking_id" INTEGER,
+	"description" TEXT NOT NULL,
+	"storage_location" VARCHAR(255) NOT NULL,
+	"status" VARCHAR(32) NOT NULL,
+	"found_at" TIMESTAMP NOT NULL,
+	"found_by" INTEGER,
+	"dispose_after" TIMESTAMP NOT NULL,
+	"returned_to" VARCHAR(255),
+	"notes" TEXT,
+	"closed_at" TIMESTAMP,
+	"version" INTEGER NOT NULL DEFAULT 1,
+	"updated_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("id")
+);
+
+ALTER TABLE "lost_items"
+ADD FOREIGN KEY("order_id") REFERENCES "cleaning_orders"("id")
+ON UPDATE CASCADE ON DELETE SET NULL;
+ALTER TABLE "lost_items"
+ADD FOREIGN KEY("room_id") REFERENCES "rooms"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE "lost_items"
+ADD FOREIGN KEY("booking_id") REFERENCES "bookings"("id")
+ON UPDATE CASCADE ON DELETE SET NULL;
+ALTER TABLE "lost_items"
+ADD FOREIGN KEY("found_by") REFERENCES "cleaners"("id")
+ON UPDATE CASCADE ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "lost_items";
+-- +goose StatementEnd
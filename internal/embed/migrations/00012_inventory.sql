@@ -0,0 +1,68 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Бельё и расходные материалы
+CREATE TABLE "inventory_items" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"name" VARCHAR(255) NOT NULL,
+	"unit" VARCHAR(32) NOT NULL,
+	"min_stock" INTEGER NOT NULL DEFAULT 0,
+	"default_location" VARCHAR(255) NOT NULL,
+	"active" BOOLEAN NOT NULL DEFAULT TRUE,
+	"version" INTEGER NOT NULL DEFAULT 1,
+	"updated_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("id")
+);
+
+-- Остатки по местам хранения
+CREATE TABLE "inventory_stock" (
+	"item_id" INTEGER NOT NULL,
+	"location" VARCHAR(255) NOT NULL,
+	"quantity" INTEGER NOT NULL DEFAULT 0,
+	"updated_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("item_id", "location")
+);
+
+-- Поступления, корректировки и расход
+CREATE TABLE "inventory_movements" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"item_id" INTEGER NOT NULL,
+	"location" VARCHAR(255) NOT NULL,
+	"quantity" INTEGER NOT NULL,
+	"kind" VARCHAR(32) NOT NULL,
+	"order_id" INTEGER,
+	"note" TEXT,
+	"created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("id")
+);
+
+-- Расход по умолчанию для типа уборки
+CREATE TABLE "consumption_defaults" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"cleaning_type" VARCHAR(100) NOT NULL,
+	"item_id" INTEGER NOT NULL,
+	"quantity" INTEGER NOT NULL,
+	PRIMARY KEY("id"),
+	UNIQUE("cleaning_type", "item_id")
+);
+
+ALTER TABLE "inventory_stock"
+ADD FOREIGN KEY("item_id") REFERENCES "inventory_items"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE "inventory_movements"
+ADD FOREIGN KEY("item_id") REFERENCES "inventory_items"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE "inventory_movements"
+ADD FOREIGN KEY("order_id") REFERENCES "cleaning_orders"("id")
+ON UPDATE CASCADE ON DELETE SET NULL;
+ALTER TABLE "consumption_defaults"
+ADD FOREIGN KEY("item_id") REFERENCES "inventory_items"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "consumption_defaults";
+DROP TABLE IF EXISTS "inventory_movements";
+DROP TABLE IF EXISTS "inventory_stock";
+DROP TABLE IF EXISTS "inventory_items";
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Пожелания гостя к уборке
+ALTER TABLE "bookings" ADD COLUMN "cleaning_preference" VARCHAR(16) NOT NULL DEFAULT 'daily';
+ALTER TABLE "bookings" ADD COLUMN "service_window_start" VARCHAR(5);
+ALTER TABLE "bookings" ADD COLUMN "service_window_end" VARCHAR(5);
+ALTER TABLE "bookings" ADD COLUMN "dnd_date" DATE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "bookings" DROP COLUMN "dnd_date";
+ALTER TABLE "bookings" DROP COLUMN "service_window_end";
+ALTER TABLE "bookings" DROP COLUMN "service_window_start";
+ALTER TABLE "bookings" DROP COLUMN "cleaning_preference";
+-- +goose StatementEnd
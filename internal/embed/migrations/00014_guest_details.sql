@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Данные гостя, удаляются при анонимизации
+ALTER TABLE "bookings" ADD COLUMN "guest_name" VARCHAR(255);
+ALTER TABLE "bookings" ADD COLUMN "guest_email" VARCHAR(255);
+ALTER TABLE "bookings" ADD COLUMN "guest_phone" VARCHAR(64);
+ALTER TABLE "bookings" ADD COLUMN "guest_language" VARCHAR(16);
+ALTER TABLE "bookings" ADD COLUMN "guest_notes" TEXT;
+ALTER TABLE "bookings" ADD COLUMN "guest_vip" BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE "bookings" ADD COLUMN "external_ref" VARCHAR(255);
+ALTER TABLE "bookings" ADD COLUMN "anonymized_at" TIMESTAMP;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "bookings" DROP COLUMN "anonymized_at";
+ALTER TABLE "bookings" DROP COLUMN "external_ref";
+ALTER TABLE "bookings" DROP COLUMN "guest_vip";
+ALTER TABLE "bookings" DROP COLUMN "guest_notes";
+ALTER TABLE "bookings" DROP COLUMN "guest_language";
+ALTER TABLE "bookings" DROP COLUMN "guest_phone";
+ALTER TABLE "bookings" DROP COLUMN "guest_email";
+ALTER TABLE "bookings" DROP COLUMN "guest_name";
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Типы номеров
+CREATE TABLE "room_types" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"name" VARCHAR(100) NOT NULL UNIQUE,
+	"capacity" INTEGER NOT NULL,
+	"area" NUMERIC(7, 2) NOT NULL DEFAULT 0,
+	"beds" INTEGER NOT NULL,
+	"amenities" TEXT[] NOT NULL DEFAULT '{}',
+	"periodic_cost" INTEGER,
+	"general_cost" INTEGER,
+	"version" INTEGER NOT NULL DEFAULT 1,
+	"updated_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("id")
+);
+
+ALTER TABLE "rooms" ADD COLUMN "room_type_id" INTEGER;
+
+ALTER TABLE "rooms"
+ADD FOREIGN KEY("room_type_id") REFERENCES "room_types"("id")
+ON UPDATE CASCADE ON DELETE RESTRICT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "rooms" DROP COLUMN "room_type_id";
+DROP TABLE IF EXISTS "room_types";
+-- +goose StatementEnd
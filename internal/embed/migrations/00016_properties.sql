@@ -0,0 +1,71 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Объекты (отели, корпуса)
+CREATE TABLE "properties" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"name" VARCHAR(255) NOT NULL,
+	"address" VARCHAR(255),
+	"version" INTEGER NOT NULL DEFAULT 1,
+	"updated_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("id")
+);
+
+-- Пользователи с доступом к объекту
+CREATE TABLE "property_users" (
+	"property_id" INTEGER NOT NULL,
+	"user_name" VARCHAR(255) NOT NULL,
+	"created_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("property_id", "user_name")
+);
+
+-- Существующие данные относятся к объекту по умолчанию
+INSERT INTO "properties" ("id", "name") VALUES (1, 'Main');
+SELECT setval(pg_get_serial_sequence('properties', 'id'), 1);
+
+ALTER TABLE "rooms" ADD COLUMN "property_id" INTEGER;
+UPDATE "rooms" SET "property_id" = 1;
+ALTER TABLE "rooms" ALTER COLUMN "property_id" SET NOT NULL;
+
+ALTER TABLE "cleaners" ADD COLUMN "property_id" INTEGER;
+UPDATE "cleaners" SET "property_id" = 1;
+ALTER TABLE "cleaners" ALTER COLUMN "property_id" SET NOT NULL;
+
+ALTER TABLE "checklist_templates" ADD COLUMN "property_id" INTEGER;
+UPDATE "checklist_templates" SET "property_id" = 1;
+ALTER TABLE "checklist_templates" ALTER COLUMN "property_id" SET NOT NULL;
+
+ALTER TABLE "consumption_defaults" ADD COLUMN "property_id" INTEGER;
+UPDATE "consumption_defaults" SET "property_id" = 1;
+ALTER TABLE "consumption_defaults" ALTER COLUMN "property_id" SET NOT NULL;
+ALTER TABLE "consumption_defaults" DROP CONSTRAINT "consumption_defaults_cleaning_type_item_id_key";
+ALTER TABLE "consumption_defaults" ADD UNIQUE("property_id", "cleaning_type", "item_id");
+
+ALTER TABLE "property_users"
+ADD FOREIGN KEY("property_id") REFERENCES "properties"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE "rooms"
+ADD FOREIGN KEY("property_id") REFERENCES "properties"("id")
+ON UPDATE CASCADE ON DELETE RESTRICT;
+ALTER TABLE "cleaners"
+ADD FOREIGN KEY("property_id") REFERENCES "properties"("id")
+ON UPDATE CASCADE ON DELETE RESTRICT;
+ALTER TABLE "checklist_templates"
+ADD FOREIGN KEY("property_id") REFERENCES "properties"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE "consumption_defaults"
+ADD FOREIGN KEY("property_id") REFERENCES "properties"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "consumption_defaults" DROP COLUMN "property_id";
+DELETE FROM "consumption_defaults" a USING "consumption_defaults" b
+WHERE a.cleaning_type = b.cleaning_type AND a.item_id = b.item_id AND a.id > b.id;
+ALTER TABLE "consumption_defaults" ADD UNIQUE("cleaning_type", "item_id");
+ALTER TABLE "checklist_templates" DROP COLUMN "property_id";
+ALTER TABLE "cleaners" DROP COLUMN "property_id";
+ALTER TABLE "rooms" DROP COLUMN "property_id";
+DROP TABLE IF EXISTS "property_users";
+DROP TABLE IF EXISTS "properties";
+-- +goose StatementEnd
@@ -0,0 +1,62 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Корпуса объекта
+CREATE TABLE "buildings" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"property_id" INTEGER NOT NULL,
+	"name" VARCHAR(100) NOT NULL,
+	"version" INTEGER NOT NULL DEFAULT 1,
+	"updated_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("id")
+);
+
+-- Этажи корпусов
+CREATE TABLE "floors" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"building_id" INTEGER NOT NULL,
+	"level" INTEGER NOT NULL,
+	"name" VARCHAR(100),
+	"version" INTEGER NOT NULL DEFAULT 1,
+	"updated_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("id"),
+	UNIQUE("building_id", "level")
+);
+
+-- Зоны уборки на этажах
+CREATE TABLE "zones" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"floor_id" INTEGER NOT NULL,
+	"name" VARCHAR(100) NOT NULL,
+	"version" INTEGER NOT NULL DEFAULT 1,
+	"updated_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("id")
+);
+
+ALTER TABLE "rooms" ADD COLUMN "zone_id" INTEGER;
+ALTER TABLE "cleaners" ADD COLUMN "home_zone_id" INTEGER;
+
+ALTER TABLE "buildings"
+ADD FOREIGN KEY("property_id") REFERENCES "properties"("id")
+ON UPDATE CASCADE ON DELETE RESTRICT;
+ALTER TABLE "floors"
+ADD FOREIGN KEY("building_id") REFERENCES "buildings"("id")
+ON UPDATE CASCADE ON DELETE RESTRICT;
+ALTER TABLE "zones"
+ADD FOREIGN KEY("floor_id") REFERENCES "floors"("id")
+ON UPDATE CASCADE ON DELETE RESTRICT;
+ALTER TABLE "rooms"
+ADD FOREIGN KEY("zone_id") REFERENCES "zones"("id")
+ON UPDATE CASCADE ON DELETE RESTRICT;
+ALTER TABLE "cleaners"
+ADD FOREIGN KEY("home_zone_id") REFERENCES "zones"("id")
+ON UPDATE CASCADE ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "cleaners" DROP COLUMN "home_zone_id";
+ALTER TABLE "rooms" DROP COLUMN "zone_id";
+DROP TABLE IF EXISTS "zones";
+DROP TABLE IF EXISTS "floors";
+DROP TABLE IF EXISTS "buildings";
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Ручной приоритет заказа на уборку
+ALTER TABLE "cleaning_orders" ADD COLUMN "priority_override" VARCHAR(16);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "cleaning_orders" DROP COLUMN "priority_override";
+-- +goose StatementEnd
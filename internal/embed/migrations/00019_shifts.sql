@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Смены уборщиков
+CREATE TABLE "shifts" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"cleaner_id" INTEGER NOT NULL,
+	"start_ts" TIMESTAMP NOT NULL,
+	"end_ts" TIMESTAMP NOT NULL,
+	"notes" TEXT,
+	"version" INTEGER NOT NULL DEFAULT 1,
+	"updated_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("id")
+);
+
+ALTER TABLE "shifts"
+ADD FOREIGN KEY("cleaner_id") REFERENCES "cleaners"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "shifts";
+-- +goose StatementEnd
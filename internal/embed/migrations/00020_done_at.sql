@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Время выполнения заказа на уборку
+ALTER TABLE "cleaning_orders" ADD COLUMN "done_at" TIMESTAMP;
+UPDATE "cleaning_orders" SET "done_at" = "updated_at" WHERE "done" IS TRUE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "cleaning_orders" DROP COLUMN "done_at";
+-- +goose StatementEnd
@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Правила повторяющихся уборок помещений и зон без бронирования
+CREATE TABLE "recurrence_rules" (
+	"id" INTEGER NOT NULL UNIQUE GENERATED BY DEFAULT AS IDENTITY,
+	"room_id" INTEGER,
+	"zone_id" INTEGER,
+	"cleaning_type" VARCHAR(100) NOT NULL,
+	"rrule" VARCHAR(255) NOT NULL,
+	"starts_at" TIMESTAMP NOT NULL,
+	"cost" INTEGER,
+	"notes" TEXT,
+	"active" BOOLEAN NOT NULL DEFAULT TRUE,
+	"generated_until" TIMESTAMP,
+	"version" INTEGER NOT NULL DEFAULT 1,
+	"updated_at" TIMESTAMP NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("id")
+);
+
+ALTER TABLE "recurrence_rules"
+ADD FOREIGN KEY("room_id") REFERENCES "rooms"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE "recurrence_rules"
+ADD FOREIGN KEY("zone_id") REFERENCES "zones"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+
+-- Заказы на уборку помещения или зоны без бронирования
+ALTER TABLE "cleaning_orders" ALTER COLUMN "booking_id" DROP NOT NULL;
+ALTER TABLE "cleaning_orders" ADD COLUMN "room_id" INTEGER;
+ALTER TABLE "cleaning_orders" ADD COLUMN "zone_id" INTEGER;
+ALTER TABLE "cleaning_orders" ADD COLUMN "rule_id" INTEGER;
+ALTER TABLE "cleaning_orders" ADD UNIQUE ("rule_id", "cleaning_ts");
+
+ALTER TABLE "cleaning_orders"
+ADD FOREIGN KEY("room_id") REFERENCES "rooms"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE "cleaning_orders"
+ADD FOREIGN KEY("zone_id") REFERENCES "zones"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE "cleaning_orders"
+ADD FOREIGN KEY("rule_id") REFERENCES "recurrence_rules"("id")
+ON UPDATE CASCADE ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM "cleaning_orders" WHERE "booking_id" IS NULL;
+ALTER TABLE "cleaning_orders" DROP COLUMN "rule_id";
+ALTER TABLE "cleaning_orders" DROP COLUMN "zone_id";
+ALTER TABLE "cleaning_orders" DROP COLUMN "room_id";
+ALTER TABLE "cleaning_orders" ALTER COLUMN "booking_id" SET NOT NULL;
+DROP TABLE IF EXISTS "recurrence_rules";
+-- +goose StatementEnd
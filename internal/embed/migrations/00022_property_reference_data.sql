@@ -0,0 +1,63 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Типы номеров относятся к объекту, тип, номера которого есть в нескольких
+-- объектах, копируется в каждый из них
+ALTER TABLE "room_types" ADD COLUMN "property_id" INTEGER;
+UPDATE "room_types" SET "property_id" = COALESCE(
+	(SELECT MIN("rooms"."property_id") FROM "rooms" WHERE "rooms"."room_type_id" = "room_types"."id"), 1);
+
+INSERT INTO "room_types" ("name", "capacity", "area", "beds", "amenities", "periodic_cost", "general_cost", "property_id")
+SELECT DISTINCT "room_types"."name", "room_types"."capacity", "room_types"."area", "room_types"."beds",
+	"room_types"."amenities", "room_types"."periodic_cost", "room_types"."general_cost", "rooms"."property_id"
+FROM "rooms" JOIN "room_types" ON "room_types"."id" = "rooms"."room_type_id"
+WHERE "rooms"."property_id" <> "room_types"."property_id";
+
+UPDATE "rooms" SET "room_type_id" = "copy"."id"
+FROM "room_types" "original", "room_types" "copy"
+WHERE "rooms"."room_type_id" = "original"."id" AND "rooms"."property_id" <> "original"."property_id"
+AND "copy"."property_id" = "rooms"."property_id" AND "copy"."name" = "original"."name";
+
+ALTER TABLE "room_types" ALTER COLUMN "property_id" SET NOT NULL;
+ALTER TABLE "room_types" DROP CONSTRAINT "room_types_name_key";
+ALTER TABLE "room_types" ADD UNIQUE("property_id", "name");
+
+-- Расходные материалы относятся к объекту, в котором задан их расход по
+-- умолчанию, остальные к объекту по умолчанию. Остатки и движения
+-- относятся к объекту материала.
+ALTER TABLE "inventory_items" ADD COLUMN "property_id" INTEGER;
+UPDATE "inventory_items" SET "property_id" = COALESCE(
+	(SELECT MIN("consumption_defaults"."property_id") FROM "consumption_defaults"
+	WHERE "consumption_defaults"."item_id" = "inventory_items"."id"), 1);
+ALTER TABLE "inventory_items" ALTER COLUMN "property_id" SET NOT NULL;
+
+-- Пункты проверки относятся к объекту по умолчанию
+ALTER TABLE "inspection_items" ADD COLUMN "property_id" INTEGER;
+UPDATE "inspection_items" SET "property_id" = 1;
+ALTER TABLE "inspection_items" ALTER COLUMN "property_id" SET NOT NULL;
+
+ALTER TABLE "room_types"
+ADD FOREIGN KEY("property_id") REFERENCES "properties"("id")
+ON UPDATE CASCADE ON DELETE RESTRICT;
+ALTER TABLE "inventory_items"
+ADD FOREIGN KEY("property_id") REFERENCES "properties"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+ALTER TABLE "inspection_items"
+ADD FOREIGN KEY("property_id") REFERENCES "properties"("id")
+ON UPDATE CASCADE ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "inspection_items" DROP COLUMN "property_id";
+ALTER TABLE "inventory_items" DROP COLUMN "property_id";
+
+-- Номера переходят на первый тип с тем же названием, копии удаляются
+UPDATE "rooms" SET "room_type_id" = "first"."id"
+FROM "room_types" "current", "room_types" "first"
+WHERE "rooms"."room_type_id" = "current"."id" AND "first"."name" = "current"."name"
+AND "first"."id" = (SELECT MIN("id") FROM "room_types" WHERE "name" = "current"."name");
+DELETE FROM "room_types" a USING "room_types" b
+WHERE a."name" = b."name" AND a."id" > b."id";
+ALTER TABLE "room_types" DROP COLUMN "property_id";
+ALTER TABLE "room_types" ADD UNIQUE("name");
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Время создания номера, у номеров, созданных до миграции, оно неизвестно
+-- и остаётся пустым
+ALTER TABLE "rooms" ADD COLUMN "created_at" TIMESTAMP;
+ALTER TABLE "rooms" ALTER COLUMN "created_at" SET DEFAULT NOW();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "rooms" DROP COLUMN "created_at";
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/repository"
+)
+
+// QueryHook logs every repository query at debug level and queries slower
+// than slowThreshold at warn level. A zero threshold disables the warnings.
+func QueryHook(slowThreshold time.Duration) repository.QueryHook {
+	return func(ctx context.Context, method, query string) (context.Context, func(err error)) {
+		start := time.Now()
+		return ctx, func(err error) {
+			elapsed := time.Since(start)
+
+			attrs := []slog.Attr{
+				slog.String("method", method),
+				slog.Duration("duration", elapsed),
+			}
+			if err != nil {
+				attrs = append(attrs, slog.String("error", err.Error()))
+			}
+
+			if slowThreshold > 0 && elapsed >= slowThreshold {
+				attrs = append(attrs, slog.String("query", strings.Join(strings.Fields(query), " ")))
+				slog.LogAttrs(ctx, slog.LevelWarn, "slow query", attrs...)
+				return
+			}
+			slog.LogAttrs(ctx, slog.LevelDebug, "query", attrs...)
+		}
+	}
+}
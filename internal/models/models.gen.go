@@ -5,44 +5,430 @@ package models
 
 import (
 	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Defines values for CleaningOrderBulkOperationOp.
+const (
+	CleaningOrderBulkOperationOpAssign CleaningOrderBulkOperationOp = "assign"
+	CleaningOrderBulkOperationOpCreate CleaningOrderBulkOperationOp = "create"
+	CleaningOrderBulkOperationOpDelete CleaningOrderBulkOperationOp = "delete"
+	CleaningOrderBulkOperationOpUpdate CleaningOrderBulkOperationOp = "update"
+)
+
+// Defines values for CleaningOrderBulkRequestMode.
+const (
+	CleaningOrderBulkRequestModeAtomic     CleaningOrderBulkRequestMode = "atomic"
+	CleaningOrderBulkRequestModeBestEffort CleaningOrderBulkRequestMode = "best_effort"
+)
+
+// Defines values for CleaningOrderBulkResultStatus.
+const (
+	CleaningOrderBulkResultStatusFailed  CleaningOrderBulkResultStatus = "failed"
+	CleaningOrderBulkResultStatusOk      CleaningOrderBulkResultStatus = "ok"
+	CleaningOrderBulkResultStatusSkipped CleaningOrderBulkResultStatus = "skipped"
+)
+
+// Defines values for CleaningOrderPriority.
+const (
+	CleaningOrderPriorityHigh   CleaningOrderPriority = "high"
+	CleaningOrderPriorityLow    CleaningOrderPriority = "low"
+	CleaningOrderPriorityNormal CleaningOrderPriority = "normal"
+	CleaningOrderPriorityUrgent CleaningOrderPriority = "urgent"
+)
+
+// Defines values for CleaningOrderPriorityRequestPriority.
+const (
+	CleaningOrderPriorityRequestPriorityHigh   CleaningOrderPriorityRequestPriority = "high"
+	CleaningOrderPriorityRequestPriorityLow    CleaningOrderPriorityRequestPriority = "low"
+	CleaningOrderPriorityRequestPriorityNormal CleaningOrderPriorityRequestPriority = "normal"
+	CleaningOrderPriorityRequestPriorityUrgent CleaningOrderPriorityRequestPriority = "urgent"
+)
+
+// Defines values for ForecastDayStaffing.
+const (
+	ForecastDayStaffingOk    ForecastDayStaffing = "ok"
+	ForecastDayStaffingOver  ForecastDayStaffing = "over"
+	ForecastDayStaffingUnder ForecastDayStaffing = "under"
+)
+
+// Defines values for InspectionAction.
+const (
+	InspectionActionNone    InspectionAction = "none"
+	InspectionActionReclean InspectionAction = "reclean"
+	InspectionActionReopen  InspectionAction = "reopen"
+)
+
+// Defines values for InspectionCreateRequestOnFail.
+const (
+	InspectionCreateRequestOnFailReclean InspectionCreateRequestOnFail = "reclean"
+	InspectionCreateRequestOnFailReopen  InspectionCreateRequestOnFail = "reopen"
+)
+
+// Defines values for LostItemStatus.
+const (
+	LostItemStatusDisposed LostItemStatus = "disposed"
+	LostItemStatusReturned LostItemStatus = "returned"
+	LostItemStatusStored   LostItemStatus = "stored"
+)
+
+// Defines values for MaintenanceTicketPriority.
+const (
+	MaintenanceTicketPriorityHigh   MaintenanceTicketPriority = "high"
+	MaintenanceTicketPriorityLow    MaintenanceTicketPriority = "low"
+	MaintenanceTicketPriorityNormal MaintenanceTicketPriority = "normal"
+	MaintenanceTicketPriorityUrgent MaintenanceTicketPriority = "urgent"
+)
+
+// Defines values for MaintenanceTicketStatus.
+const (
+	MaintenanceTicketStatusInProgress MaintenanceTicketStatus = "in_progress"
+	MaintenanceTicketStatusOpen       MaintenanceTicketStatus = "open"
+	MaintenanceTicketStatusResolved   MaintenanceTicketStatus = "resolved"
+)
+
+// Defines values for MaintenanceTicketCreateRequestPriority.
+const (
+	MaintenanceTicketCreateRequestPriorityHigh   MaintenanceTicketCreateRequestPriority = "high"
+	MaintenanceTicketCreateRequestPriorityLow    MaintenanceTicketCreateRequestPriority = "low"
+	MaintenanceTicketCreateRequestPriorityNormal MaintenanceTicketCreateRequestPriority = "normal"
+	MaintenanceTicketCreateRequestPriorityUrgent MaintenanceTicketCreateRequestPriority = "urgent"
+)
+
+// Defines values for RoomStatus.
+const (
+	RoomStatusClean        RoomStatus = "clean"
+	RoomStatusDirty        RoomStatus = "dirty"
+	RoomStatusInspected    RoomStatus = "inspected"
+	RoomStatusOutOfOrder   RoomStatus = "out_of_order"
+	RoomStatusOutOfService RoomStatus = "out_of_service"
+)
+
+// Defines values for RoomBlockKind.
+const (
+	RoomBlockKindOutOfOrder   RoomBlockKind = "out_of_order"
+	RoomBlockKindOutOfService RoomBlockKind = "out_of_service"
+)
+
+// Defines values for RoomBlockCreateRequestKind.
+const (
+	RoomBlockCreateRequestKindOutOfOrder   RoomBlockCreateRequestKind = "out_of_order"
+	RoomBlockCreateRequestKindOutOfService RoomBlockCreateRequestKind = "out_of_service"
+)
+
+// Defines values for RoomStatusUpdateRequestStatus.
+const (
+	RoomStatusUpdateRequestStatusClean     RoomStatusUpdateRequestStatus = "clean"
+	RoomStatusUpdateRequestStatusDirty     RoomStatusUpdateRequestStatus = "dirty"
+	RoomStatusUpdateRequestStatusInspected RoomStatusUpdateRequestStatus = "inspected"
+)
+
+// Defines values for RouteStopReason.
+const (
+	RouteStopReasonArrival   RouteStopReason = "arrival"
+	RouteStopReasonDeparture RouteStopReason = "departure"
+	RouteStopReasonStay      RouteStopReason = "stay"
+	RouteStopReasonTask      RouteStopReason = "task"
+)
+
+// Defines values for ServicePreferencesCleaning.
+const (
+	ServicePreferencesCleaningDaily ServicePreferencesCleaning = "daily"
+	ServicePreferencesCleaningEco   ServicePreferencesCleaning = "eco"
+	ServicePreferencesCleaningNone  ServicePreferencesCleaning = "none"
+)
+
+// Defines values for StockMovementKind.
+const (
+	StockMovementKindAdjustment  StockMovementKind = "adjustment"
+	StockMovementKindConsumption StockMovementKind = "consumption"
+	StockMovementKindRestock     StockMovementKind = "restock"
+)
+
+// Defines values for StockMovementCreateRequestKind.
+const (
+	StockMovementCreateRequestKindAdjustment  StockMovementCreateRequestKind = "adjustment"
+	StockMovementCreateRequestKindConsumption StockMovementCreateRequestKind = "consumption"
+	StockMovementCreateRequestKindRestock     StockMovementCreateRequestKind = "restock"
+)
+
+// Defines values for GetCleanersIdCleaningOrdersParamsSort.
+const (
+	GetCleanersIdCleaningOrdersParamsSortCleaningTs GetCleanersIdCleaningOrdersParamsSort = "cleaning_ts"
+	GetCleanersIdCleaningOrdersParamsSortId         GetCleanersIdCleaningOrdersParamsSort = "id"
+	GetCleanersIdCleaningOrdersParamsSortPriority   GetCleanersIdCleaningOrdersParamsSort = "priority"
+)
+
+// Defines values for GetCleaningOrdersParamsSort.
+const (
+	GetCleaningOrdersParamsSortCleaningTs GetCleaningOrdersParamsSort = "cleaning_ts"
+	GetCleaningOrdersParamsSortId         GetCleaningOrdersParamsSort = "id"
+	GetCleaningOrdersParamsSortPriority   GetCleaningOrdersParamsSort = "priority"
+)
+
+// Defines values for GetLostItemsParamsStatus.
+const (
+	GetLostItemsParamsStatusDisposed GetLostItemsParamsStatus = "disposed"
+	GetLostItemsParamsStatusReturned GetLostItemsParamsStatus = "returned"
+	GetLostItemsParamsStatusStored   GetLostItemsParamsStatus = "stored"
+)
+
+// Defines values for GetMaintenanceTicketsParamsStatus.
+const (
+	GetMaintenanceTicketsParamsStatusInProgress GetMaintenanceTicketsParamsStatus = "in_progress"
+	GetMaintenanceTicketsParamsStatusOpen       GetMaintenanceTicketsParamsStatus = "open"
+	GetMaintenanceTicketsParamsStatusResolved   GetMaintenanceTicketsParamsStatus = "resolved"
+)
+
+// Defines values for GetReportsBoardParamsGroupBy.
+const (
+	GetReportsBoardParamsGroupByFloor GetReportsBoardParamsGroupBy = "floor"
+	GetReportsBoardParamsGroupByZone  GetReportsBoardParamsGroupBy = "zone"
+)
+
+// Defines values for GetReportsCleanerWorkloadParamsGroupBy.
+const (
+	GetReportsCleanerWorkloadParamsGroupByCleaner GetReportsCleanerWorkloadParamsGroupBy = "cleaner"
+	GetReportsCleanerWorkloadParamsGroupByDay     GetReportsCleanerWorkloadParamsGroupBy = "day"
+	GetReportsCleanerWorkloadParamsGroupByMonth   GetReportsCleanerWorkloadParamsGroupBy = "month"
+	GetReportsCleanerWorkloadParamsGroupByWeek    GetReportsCleanerWorkloadParamsGroupBy = "week"
+)
+
+// Defines values for GetReportsCleaningTimeParamsGroupBy.
+const (
+	GetReportsCleaningTimeParamsGroupByCleaningType GetReportsCleaningTimeParamsGroupBy = "cleaning_type"
+	GetReportsCleaningTimeParamsGroupByDay          GetReportsCleaningTimeParamsGroupBy = "day"
+	GetReportsCleaningTimeParamsGroupByMonth        GetReportsCleaningTimeParamsGroupBy = "month"
+	GetReportsCleaningTimeParamsGroupByRoomType     GetReportsCleaningTimeParamsGroupBy = "room_type"
+	GetReportsCleaningTimeParamsGroupByWeek         GetReportsCleaningTimeParamsGroupBy = "week"
+)
+
+// Defines values for GetReportsCostPerNightParamsGroupBy.
+const (
+	GetReportsCostPerNightParamsGroupByDay      GetReportsCostPerNightParamsGroupBy = "day"
+	GetReportsCostPerNightParamsGroupByMonth    GetReportsCostPerNightParamsGroupBy = "month"
+	GetReportsCostPerNightParamsGroupByRoomType GetReportsCostPerNightParamsGroupBy = "room_type"
+	GetReportsCostPerNightParamsGroupByWeek     GetReportsCostPerNightParamsGroupBy = "week"
+)
+
+// Defines values for GetReportsOccupancyParamsGroupBy.
+const (
+	GetReportsOccupancyParamsGroupByDay      GetReportsOccupancyParamsGroupBy = "day"
+	GetReportsOccupancyParamsGroupByMonth    GetReportsOccupancyParamsGroupBy = "month"
+	GetReportsOccupancyParamsGroupByRoomType GetReportsOccupancyParamsGroupBy = "room_type"
+	GetReportsOccupancyParamsGroupByWeek     GetReportsOccupancyParamsGroupBy = "week"
+)
+
+// Defines values for GetReportsOnTimeParamsGroupBy.
+const (
+	GetReportsOnTimeParamsGroupByCleaner      GetReportsOnTimeParamsGroupBy = "cleaner"
+	GetReportsOnTimeParamsGroupByCleaningType GetReportsOnTimeParamsGroupBy = "cleaning_type"
+	GetReportsOnTimeParamsGroupByDay          GetReportsOnTimeParamsGroupBy = "day"
+	GetReportsOnTimeParamsGroupByMonth        GetReportsOnTimeParamsGroupBy = "month"
+	GetReportsOnTimeParamsGroupByRoomType     GetReportsOnTimeParamsGroupBy = "room_type"
+	GetReportsOnTimeParamsGroupByWeek         GetReportsOnTimeParamsGroupBy = "week"
 )
 
+// Attachment defines model for Attachment.
+type Attachment struct {
+	ContentType  string    `json:"content_type"`
+	CreatedAt    time.Time `json:"created_at"`
+	Description  *string   `json:"description,omitempty"`
+	Filename     string    `json:"filename"`
+	HasThumbnail bool      `json:"has_thumbnail"`
+	Id           int       `json:"id"`
+	OrderId      int       `json:"order_id"`
+	Size         int64     `json:"size"`
+
+	// UploadedBy Cleaner who uploaded the file
+	UploadedBy *int `json:"uploaded_by,omitempty"`
+}
+
+// BoardRow defines model for BoardRow.
+type BoardRow struct {
+	BuildingId *int `json:"building_id,omitempty"`
+	Cost       int  `json:"cost"`
+	Done       int  `json:"done"`
+	FloorId    *int `json:"floor_id,omitempty"`
+	Level      *int `json:"level,omitempty"`
+
+	// Name Name of the zone, or of the floor when grouped by floor
+	Name *string `json:"name,omitempty"`
+
+	// Orders Orders that are not cancelled
+	Orders int `json:"orders"`
+
+	// Unassigned Orders not done without a cleaner
+	Unassigned int `json:"unassigned"`
+
+	// ZoneId Omitted when grouped by floor
+	ZoneId *int `json:"zone_id,omitempty"`
+}
+
 // Booking defines model for Booking.
 type Booking struct {
-	CheckInTs  *time.Time `json:"check_in_ts,omitempty"`
-	CheckOutTs *time.Time `json:"check_out_ts,omitempty"`
-	Guests     *int       `json:"guests,omitempty"`
-	Id         int        `json:"id"`
-	RoomId     int        `json:"room_id"`
+	// AnonymizedAt Set when the personal data of the guest has been erased
+	AnonymizedAt *time.Time `json:"anonymized_at,omitempty"`
+	CancelReason *string    `json:"cancel_reason,omitempty"`
+
+	// CancelledAt Set when the booking is cancelled
+	CancelledAt *time.Time `json:"cancelled_at,omitempty"`
+	CheckInTs   *time.Time `json:"check_in_ts,omitempty"`
+	CheckOutTs  *time.Time `json:"check_out_ts,omitempty"`
+
+	// CheckedInAt Actual check-in time
+	CheckedInAt *time.Time `json:"checked_in_at,omitempty"`
+
+	// CheckedOutAt Actual check-out time
+	CheckedOutAt *time.Time `json:"checked_out_at,omitempty"`
+
+	// DeletedAt Set when the booking is deleted
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
+	// Guest Personal data of the guest, only returned by booking endpoints
+	Guest              *Guest              `json:"guest,omitempty"`
+	Guests             *int                `json:"guests,omitempty"`
+	Id                 int                 `json:"id"`
+	RoomId             int                 `json:"room_id"`
+	ServicePreferences *ServicePreferences `json:"service_preferences,omitempty"`
+	UpdatedAt          *time.Time          `json:"updated_at,omitempty"`
+
+	// Version Incremented on every change, used as ETag
+	Version *int `json:"version,omitempty"`
+}
+
+// BookingCancelRequest defines model for BookingCancelRequest.
+type BookingCancelRequest struct {
+	// DepartureCleaning Schedule a general cleaning after departure if the guest has checked in
+	DepartureCleaning *bool  `json:"departure_cleaning,omitempty"`
+	Reason            string `json:"reason"`
+}
+
+// BookingCancelResponse defines model for BookingCancelResponse.
+type BookingCancelResponse struct {
+	Booking Booking `json:"booking"`
+
+	// CancelledOrders Number of cancelled cleaning orders
+	CancelledOrders int `json:"cancelled_orders"`
+
+	// DepartureCleaning An order is for a booking, for a room without booking, e.g. a deep
+	// cleaning of a vacant room, or for a zone as a whole, e.g. a lobby
+	DepartureCleaning *CleaningOrder `json:"departure_cleaning,omitempty"`
+}
+
+// BookingCheckOutResponse defines model for BookingCheckOutResponse.
+type BookingCheckOutResponse struct {
+	Booking Booking `json:"booking"`
+
+	// CancelledOrders Number of cancelled periodic cleaning orders
+	CancelledOrders int `json:"cancelled_orders"`
+
+	// DepartureCleaning An order is for a booking, for a room without booking, e.g. a deep
+	// cleaning of a vacant room, or for a zone as a whole, e.g. a lobby
+	DepartureCleaning *CleaningOrder `json:"departure_cleaning,omitempty"`
 }
 
 // BookingCreateRequest defines model for BookingCreateRequest.
 type BookingCreateRequest struct {
 	CheckInTs  time.Time `json:"check_in_ts"`
 	CheckOutTs time.Time `json:"check_out_ts"`
-	Guests     *int      `json:"guests,omitempty"`
-	RoomId     int       `json:"room_id"`
+
+	// Guest Personal data of the guest, only returned by booking endpoints
+	Guest              *Guest              `json:"guest,omitempty"`
+	Guests             *int                `json:"guests,omitempty"`
+	RoomId             int                 `json:"room_id"`
+	ServicePreferences *ServicePreferences `json:"service_preferences,omitempty"`
+}
+
+// BookingEventRequest defines model for BookingEventRequest.
+type BookingEventRequest struct {
+	// At Time of the event, now if omitted
+	At *time.Time `json:"at,omitempty"`
 }
 
 // BookingUpdateRequest defines model for BookingUpdateRequest.
 type BookingUpdateRequest struct {
 	CheckInTs  time.Time `json:"check_in_ts"`
 	CheckOutTs time.Time `json:"check_out_ts"`
-	Guests     *int      `json:"guests,omitempty"`
-	RoomId     int       `json:"room_id"`
+
+	// Guest Personal data of the guest, only returned by booking endpoints
+	Guest  *Guest `json:"guest,omitempty"`
+	Guests *int   `json:"guests,omitempty"`
+	RoomId int    `json:"room_id"`
+}
+
+// Building defines model for Building.
+type Building struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+
+	// PropertyId Property of the building, the one of the request it was created in
+	PropertyId *int       `json:"property_id,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+
+	// Version Incremented on every change, used as ETag
+	Version *int `json:"version,omitempty"`
+}
+
+// BuildingCreateRequest defines model for BuildingCreateRequest.
+type BuildingCreateRequest struct {
+	Name string `json:"name"`
+}
+
+// ChecklistItemCheckRequest defines model for ChecklistItemCheckRequest.
+type ChecklistItemCheckRequest struct {
+	Checked bool `json:"checked"`
+
+	// CleanerId Cleaner who checked the item
+	CleanerId *int `json:"cleaner_id,omitempty"`
+}
+
+// ChecklistTemplateItem defines model for ChecklistTemplateItem.
+type ChecklistTemplateItem struct {
+	CleaningType string `json:"cleaning_type"`
+	Id           int    `json:"id"`
+	Name         string `json:"name"`
+	Position     int    `json:"position"`
+
+	// Required The order cannot be completed until the item is checked
+	Required bool `json:"required"`
+}
+
+// ChecklistTemplateItemCreateRequest defines model for ChecklistTemplateItemCreateRequest.
+type ChecklistTemplateItemCreateRequest struct {
+	CleaningType string `json:"cleaning_type"`
+	Name         string `json:"name"`
+
+	// Position Order of the item in the checklist, appended by default
+	Position *int  `json:"position,omitempty"`
+	Required *bool `json:"required,omitempty"`
 }
 
 // Cleaner defines model for Cleaner.
 type Cleaner struct {
-	Id      int    `json:"id"`
-	Name    string `json:"name"`
-	Surname string `json:"surname"`
+	// DeletedAt Set when the cleaner is deleted
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
+	// HomeZoneId Zone the cleaner usually works in
+	HomeZoneId *int   `json:"home_zone_id,omitempty"`
+	Id         int    `json:"id"`
+	Name       string `json:"name"`
+
+	// PropertyId Property the cleaner works at, the one of the request they were created in
+	PropertyId *int       `json:"property_id,omitempty"`
+	Surname    string     `json:"surname"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+
+	// Version Incremented on every change, used as ETag
+	Version *int `json:"version,omitempty"`
 }
 
 // CleanerCreateRequest defines model for CleanerCreateRequest.
 type CleanerCreateRequest struct {
-	Name    string `json:"name"`
-	Surname string `json:"surname"`
+	HomeZoneId *int   `json:"home_zone_id,omitempty"`
+	Name       string `json:"name"`
+	Surname    string `json:"surname"`
 }
 
 // CleanerOrder defines model for CleanerOrder.
@@ -57,85 +443,1541 @@ type CleanerOrderCreateRequest struct {
 	CleanerId int `json:"cleaner_id"`
 }
 
+// CleanerQuality defines model for CleanerQuality.
+type CleanerQuality struct {
+	AverageScore float64 `json:"average_score"`
+	CleanerId    int     `json:"cleaner_id"`
+	Failed       int     `json:"failed"`
+	Inspections  int     `json:"inspections"`
+	Name         string  `json:"name"`
+	Passed       int     `json:"passed"`
+	Surname      string  `json:"surname"`
+}
+
+// CleanerRoute defines model for CleanerRoute.
+type CleanerRoute struct {
+	CleanerId int                `json:"cleaner_id"`
+	Date      openapi_types.Date `json:"date"`
+
+	// Finish Estimated end of the last order, omitted without orders
+	Finish       *time.Time  `json:"finish,omitempty"`
+	FloorChanges int         `json:"floor_changes"`
+	Stops        []RouteStop `json:"stops"`
+}
+
 // CleanerUpdateRequest defines model for CleanerUpdateRequest.
 type CleanerUpdateRequest struct {
-	Name    *string `json:"name,omitempty"`
-	Surname *string `json:"surname,omitempty"`
+	HomeZoneId *int    `json:"home_zone_id,omitempty"`
+	Name       *string `json:"name,omitempty"`
+	Surname    *string `json:"surname,omitempty"`
+}
+
+// CleanerWorkloadRow defines model for CleanerWorkloadRow.
+type CleanerWorkloadRow struct {
+	// CleanerDays Days the cleaners had orders, summed up over the cleaners
+	CleanerDays  int     `json:"cleaner_days"`
+	CleanerId    *int    `json:"cleaner_id,omitempty"`
+	Cleaners     int     `json:"cleaners"`
+	CleaningType *string `json:"cleaning_type,omitempty"`
+	Done         int     `json:"done"`
+
+	// Orders Assignments of orders to cleaners
+	Orders              int     `json:"orders"`
+	OrdersPerCleanerDay float32 `json:"orders_per_cleaner_day"`
+
+	// Period The day, or the first day of the week or month
+	Period *openapi_types.Date `json:"period,omitempty"`
+
+	// RoomTypeId Omitted for rooms without type too
+	RoomTypeId *int `json:"room_type_id,omitempty"`
 }
 
-// CleaningOrder defines model for CleaningOrder.
+// CleaningOrder An order is for a booking, for a room without booking, e.g. a deep
+// cleaning of a vacant room, or for a zone as a whole, e.g. a lobby
 type CleaningOrder struct {
-	BookingId    int        `json:"booking_id"`
+	BookingId    *int    `json:"booking_id,omitempty"`
+	CancelReason *string `json:"cancel_reason,omitempty"`
+
+	// CancelledAt Set when the order is cancelled
+	CancelledAt  *time.Time `json:"cancelled_at,omitempty"`
 	CleaningTs   *time.Time `json:"cleaning_ts,omitempty"`
 	CleaningType *string    `json:"cleaning_type,omitempty"`
 	Cost         int        `json:"cost"`
 	Done         *bool      `json:"done,omitempty"`
-	Id           int        `json:"id"`
+
+	// DoneAt Set when the order is marked done
+	DoneAt *time.Time `json:"done_at,omitempty"`
+	Id     int        `json:"id"`
+
+	// NextArrival Check-in of the next booking of the room, for departure cleanings
+	NextArrival *time.Time `json:"next_arrival,omitempty"`
+	Notes       *string    `json:"notes,omitempty"`
+
+	// Priority Priority of a cleaning order, urgent first
+	Priority *CleaningOrderPriority `json:"priority,omitempty"`
+
+	// PriorityOverride Priority of a cleaning order, urgent first
+	PriorityOverride *CleaningOrderPriority `json:"priority_override,omitempty"`
+
+	// RoomId Room to clean, the room of the booking for booking orders
+	RoomId *int `json:"room_id,omitempty"`
+
+	// RuleId Recurrence rule the order was generated from
+	RuleId    *int       `json:"rule_id,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// Version Incremented on every change, used as ETag
+	Version *int `json:"version,omitempty"`
+
+	// Vip The guest of a stay or the arriving guest of a departure cleaning is a VIP
+	Vip *bool `json:"vip,omitempty"`
+
+	// ZoneId Zone to clean, for orders without room
+	ZoneId *int `json:"zone_id,omitempty"`
+}
+
+// CleaningOrderBulkOperation defines model for CleaningOrderBulkOperation.
+type CleaningOrderBulkOperation struct {
+	// CleanerId Cleaner to assign
+	CleanerId *int `json:"cleaner_id,omitempty"`
+
+	// Create Exactly one of booking_id, room_id and zone_id is given, room_id is
+	// ignored with a booking
+	Create *CleaningOrderCreateRequest `json:"create,omitempty"`
+
+	// Id Target cleaning order of update, delete and assign
+	Id *int                         `json:"id,omitempty"`
+	Op CleaningOrderBulkOperationOp `json:"op"`
+
+	// Update Fields to change, omitted fields keep their value
+	Update *CleaningOrderBulkUpdate `json:"update,omitempty"`
+}
+
+// CleaningOrderBulkOperationOp defines model for CleaningOrderBulkOperation.Op.
+type CleaningOrderBulkOperationOp string
+
+// CleaningOrderBulkRequest defines model for CleaningOrderBulkRequest.
+type CleaningOrderBulkRequest struct {
+	Mode       *CleaningOrderBulkRequestMode `json:"mode,omitempty"`
+	Operations []CleaningOrderBulkOperation  `json:"operations"`
+}
+
+// CleaningOrderBulkRequestMode defines model for CleaningOrderBulkRequest.Mode.
+type CleaningOrderBulkRequestMode string
+
+// CleaningOrderBulkResponse defines model for CleaningOrderBulkResponse.
+type CleaningOrderBulkResponse struct {
+	Failed    int                       `json:"failed"`
+	Mode      string                    `json:"mode"`
+	Results   []CleaningOrderBulkResult `json:"results"`
+	Succeeded int                       `json:"succeeded"`
+}
+
+// CleaningOrderBulkResult defines model for CleaningOrderBulkResult.
+type CleaningOrderBulkResult struct {
+	Error *string `json:"error,omitempty"`
+
+	// Id Affected cleaning order
+	Id *int `json:"id,omitempty"`
+
+	// Index Position of the operation in the request
+	Index  int                           `json:"index"`
+	Op     string                        `json:"op"`
+	Status CleaningOrderBulkResultStatus `json:"status"`
+}
+
+// CleaningOrderBulkResultStatus defines model for CleaningOrderBulkResult.Status.
+type CleaningOrderBulkResultStatus string
+
+// CleaningOrderBulkUpdate Fields to change, omitted fields keep their value
+type CleaningOrderBulkUpdate struct {
+	BookingId    *int       `json:"booking_id,omitempty"`
+	CleaningTs   *time.Time `json:"cleaning_ts,omitempty"`
+	CleaningType *string    `json:"cleaning_type,omitempty"`
+	Cost         *int       `json:"cost,omitempty"`
+	Done         *bool      `json:"done,omitempty"`
 	Notes        *string    `json:"notes,omitempty"`
 }
 
-// CleaningOrderCreateRequest defines model for CleaningOrderCreateRequest.
+// CleaningOrderCreateRequest Exactly one of booking_id, room_id and zone_id is given, room_id is
+// ignored with a booking
 type CleaningOrderCreateRequest struct {
-	BookingId    int       `json:"booking_id"`
+	BookingId    *int      `json:"booking_id,omitempty"`
 	CleaningTs   time.Time `json:"cleaning_ts"`
 	CleaningType *string   `json:"cleaning_type,omitempty"`
 	Cost         int       `json:"cost"`
 	Done         *bool     `json:"done,omitempty"`
 	Notes        *string   `json:"notes,omitempty"`
+	RoomId       *int      `json:"room_id,omitempty"`
+	ZoneId       *int      `json:"zone_id,omitempty"`
+}
+
+// CleaningOrderPriority Priority of a cleaning order, urgent first
+type CleaningOrderPriority string
+
+// CleaningOrderPriorityRequest defines model for CleaningOrderPriorityRequest.
+type CleaningOrderPriorityRequest struct {
+	// Priority Manual priority, null or omitted to use the computed one
+	Priority *CleaningOrderPriorityRequestPriority `json:"priority"`
 }
 
-// CleaningOrderUpdateRequest defines model for CleaningOrderUpdateRequest.
+// CleaningOrderPriorityRequestPriority Manual priority, null or omitted to use the computed one
+type CleaningOrderPriorityRequestPriority string
+
+// CleaningOrderUpdateRequest Exactly one of booking_id, room_id and zone_id is given, room_id is
+// ignored with a booking
 type CleaningOrderUpdateRequest struct {
-	BookingId    int       `json:"booking_id"`
+	BookingId    *int      `json:"booking_id,omitempty"`
 	CleaningTs   time.Time `json:"cleaning_ts"`
 	CleaningType *string   `json:"cleaning_type,omitempty"`
 	Cost         int       `json:"cost"`
-	Done         *bool     `json:"done,omitempty"`
-	Notes        *string   `json:"notes,omitempty"`
+
+	// Done Omitted keeps the stored value
+	Done   *bool   `json:"done,omitempty"`
+	Notes  *string `json:"notes,omitempty"`
+	RoomId *int    `json:"room_id,omitempty"`
+	ZoneId *int    `json:"zone_id,omitempty"`
 }
 
-// Room defines model for Room.
-type Room struct {
-	Desc  *string `json:"desc,omitempty"`
-	Floor int     `json:"floor"`
-	Id    int     `json:"id"`
+// CleaningTimeRow defines model for CleaningTimeRow.
+type CleaningTimeRow struct {
+	AverageMinutes float32 `json:"average_minutes"`
+	CleanerId      *int    `json:"cleaner_id,omitempty"`
+	CleaningType   *string `json:"cleaning_type,omitempty"`
+
+	// Orders Measured done orders
+	Orders int `json:"orders"`
+
+	// Period The day, or the first day of the week or month
+	Period *openapi_types.Date `json:"period,omitempty"`
+
+	// RoomTypeId Omitted for rooms without type too
+	RoomTypeId *int `json:"room_type_id,omitempty"`
 }
 
-// RoomCreateRequest defines model for RoomCreateRequest.
-type RoomCreateRequest struct {
-	Desc  *string `json:"desc,omitempty"`
-	Floor int     `json:"floor"`
+// ConsumptionDefault defines model for ConsumptionDefault.
+type ConsumptionDefault struct {
+	CleaningType string `json:"cleaning_type"`
+	Id           int    `json:"id"`
+	ItemId       int    `json:"item_id"`
+
+	// Quantity Consumed by every completed order of the cleaning type
+	Quantity int `json:"quantity"`
 }
 
-// RoomUpdateRequest defines model for RoomUpdateRequest.
-type RoomUpdateRequest struct {
-	Desc  *string `json:"desc,omitempty"`
-	Floor *int    `json:"floor,omitempty"`
+// ConsumptionDefaultCreateRequest defines model for ConsumptionDefaultCreateRequest.
+type ConsumptionDefaultCreateRequest struct {
+	CleaningType string `json:"cleaning_type"`
+	ItemId       int    `json:"item_id"`
+	Quantity     int    `json:"quantity"`
 }
 
-// PostBookingsJSONRequestBody defines body for PostBookings for application/json ContentType.
-type PostBookingsJSONRequestBody = BookingCreateRequest
+// ConsumptionReportRow defines model for ConsumptionReportRow.
+type ConsumptionReportRow struct {
+	ItemId int    `json:"item_id"`
+	Name   string `json:"name"`
 
-// PutBookingsIdJSONRequestBody defines body for PutBookingsId for application/json ContentType.
-type PutBookingsIdJSONRequestBody = BookingUpdateRequest
+	// Orders Cleaning orders that consumed the item
+	Orders int `json:"orders"`
 
-// PostCleanersJSONRequestBody defines body for PostCleaners for application/json ContentType.
-type PostCleanersJSONRequestBody = CleanerCreateRequest
+	// Quantity Consumed quantity, positive
+	Quantity int    `json:"quantity"`
+	Unit     string `json:"unit"`
+}
 
-// PutCleanersIdJSONRequestBody defines body for PutCleanersId for application/json ContentType.
-type PutCleanersIdJSONRequestBody = CleanerUpdateRequest
+// ConsumptionRequest defines model for ConsumptionRequest.
+type ConsumptionRequest struct {
+	ItemId int `json:"item_id"`
 
-// PostCleaningOrdersJSONRequestBody defines body for PostCleaningOrders for application/json ContentType.
-type PostCleaningOrdersJSONRequestBody = CleaningOrderCreateRequest
+	// Location Defaults to the default location of the item
+	Location *string `json:"location,omitempty"`
 
-// PutCleaningOrdersIdJSONRequestBody defines body for PutCleaningOrdersId for application/json ContentType.
-type PutCleaningOrdersIdJSONRequestBody = CleaningOrderUpdateRequest
+	// Quantity Consumed quantity, positive
+	Quantity int `json:"quantity"`
+}
 
-// PostCleaningOrdersIdCleanersJSONRequestBody defines body for PostCleaningOrdersIdCleaners for application/json ContentType.
-type PostCleaningOrdersIdCleanersJSONRequestBody = CleanerOrderCreateRequest
+// CostPerNightRow defines model for CostPerNightRow.
+type CostPerNightRow struct {
+	CleanerId    *int    `json:"cleaner_id,omitempty"`
+	CleaningType *string `json:"cleaning_type,omitempty"`
 
-// PostRoomsJSONRequestBody defines body for PostRooms for application/json ContentType.
-type PostRoomsJSONRequestBody = RoomCreateRequest
+	// Cost Cost of the done orders
+	Cost int `json:"cost"`
 
-// PutRoomsIdJSONRequestBody defines body for PutRoomsId for application/json ContentType.
-type PutRoomsIdJSONRequestBody = RoomUpdateRequest
+	// CostPerNight Omitted without occupied nights
+	CostPerNight   *float32 `json:"cost_per_night,omitempty"`
+	OccupiedNights int      `json:"occupied_nights"`
+
+	// Period The day, or the first day of the week or month
+	Period *openapi_types.Date `json:"period,omitempty"`
+
+	// RoomTypeId Omitted for rooms without type too
+	RoomTypeId *int `json:"room_type_id,omitempty"`
+}
+
+// DndSkipRequest defines model for DndSkipRequest.
+type DndSkipRequest struct {
+	// CleanerId Cleaner who found the sign
+	CleanerId *int `json:"cleaner_id,omitempty"`
+
+	// RescheduleTo Schedule the cleaning again at this time
+	RescheduleTo *time.Time `json:"reschedule_to,omitempty"`
+}
+
+// DndSkipResponse defines model for DndSkipResponse.
+type DndSkipResponse struct {
+	// Rescheduled An order is for a booking, for a room without booking, e.g. a deep
+	// cleaning of a vacant room, or for a zone as a whole, e.g. a lobby
+	Rescheduled *CleaningOrder `json:"rescheduled,omitempty"`
+
+	// Skipped An order is for a booking, for a room without booking, e.g. a deep
+	// cleaning of a vacant room, or for a zone as a whole, e.g. a lobby
+	Skipped CleaningOrder `json:"skipped"`
+}
+
+// Floor defines model for Floor.
+type Floor struct {
+	BuildingId int `json:"building_id"`
+	Id         int `json:"id"`
+
+	// Level Floor number, unique within the building
+	Level     int        `json:"level"`
+	Name      *string    `json:"name,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// Version Incremented on every change, used as ETag
+	Version *int `json:"version,omitempty"`
+}
+
+// FloorCreateRequest defines model for FloorCreateRequest.
+type FloorCreateRequest struct {
+	BuildingId int     `json:"building_id"`
+	Level      int     `json:"level"`
+	Name       *string `json:"name,omitempty"`
+}
+
+// ForecastDay defines model for ForecastDay.
+type ForecastDay struct {
+	// BalanceMinutes Capacity minus required minutes, negative when understaffed
+	BalanceMinutes int `json:"balance_minutes"`
+
+	// CapacityMinutes Minutes of the scheduled shifts
+	CapacityMinutes int `json:"capacity_minutes"`
+
+	// Cleaners Cleaners with a shift that day
+	Cleaners int                `json:"cleaners"`
+	Date     openapi_types.Date `json:"date"`
+
+	// Orders Projected cleaning orders
+	Orders int `json:"orders"`
+
+	// RequiredMinutes Estimated duration of the projected orders
+	RequiredMinutes int `json:"required_minutes"`
+
+	// Staffing under if the shifts do not cover the workload, over if they
+	// exceed it by more than schedule.staffing_tolerance
+	Staffing ForecastDayStaffing `json:"staffing"`
+}
+
+// ForecastDayStaffing under if the shifts do not cover the workload, over if they
+// exceed it by more than schedule.staffing_tolerance
+type ForecastDayStaffing string
+
+// Guest Personal data of the guest, only returned by booking endpoints
+type Guest struct {
+	Email *string `json:"email,omitempty"`
+
+	// ExternalRef Reservation reference in the booking channel or PMS
+	ExternalRef *string `json:"external_ref,omitempty"`
+
+	// Language Preferred language, e.g. en or ru
+	Language *string `json:"language,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Notes    *string `json:"notes,omitempty"`
+	Phone    *string `json:"phone,omitempty"`
+	Vip      *bool   `json:"vip,omitempty"`
+}
+
+// Inspection defines model for Inspection.
+type Inspection struct {
+	// Action What was done with the order after the inspection
+	Action         InspectionAction   `json:"action"`
+	Comments       *string            `json:"comments,omitempty"`
+	CreatedAt      time.Time          `json:"created_at"`
+	Id             int                `json:"id"`
+	Inspector      string             `json:"inspector"`
+	OrderId        int                `json:"order_id"`
+	Passed         bool               `json:"passed"`
+	RecleanOrderId *int               `json:"reclean_order_id,omitempty"`
+	Results        []InspectionResult `json:"results"`
+
+	// Score Weighted share of passed items, 0 to 100
+	Score float64 `json:"score"`
+}
+
+// InspectionAction What was done with the order after the inspection
+type InspectionAction string
+
+// InspectionCreateRequest defines model for InspectionCreateRequest.
+type InspectionCreateRequest struct {
+	Comments  *string `json:"comments,omitempty"`
+	Inspector string  `json:"inspector"`
+
+	// OnFail What to do with the order if the inspection fails
+	OnFail  *InspectionCreateRequestOnFail `json:"on_fail,omitempty"`
+	Results []InspectionResult             `json:"results"`
+}
+
+// InspectionCreateRequestOnFail What to do with the order if the inspection fails
+type InspectionCreateRequestOnFail string
+
+// InspectionItem defines model for InspectionItem.
+type InspectionItem struct {
+	Active      bool    `json:"active"`
+	Description *string `json:"description,omitempty"`
+	Id          int     `json:"id"`
+	Name        string  `json:"name"`
+
+	// PropertyId Property the item is inspected at, the one of the request it was created in
+	PropertyId *int `json:"property_id,omitempty"`
+
+	// Weight Weight of the item in the score
+	Weight int `json:"weight"`
+}
+
+// InspectionItemCreateRequest defines model for InspectionItemCreateRequest.
+type InspectionItemCreateRequest struct {
+	Description *string `json:"description,omitempty"`
+	Name        string  `json:"name"`
+	Weight      *int    `json:"weight,omitempty"`
+}
+
+// InspectionResult defines model for InspectionResult.
+type InspectionResult struct {
+	Comment  *string `json:"comment,omitempty"`
+	ItemId   int     `json:"item_id"`
+	ItemName *string `json:"item_name,omitempty"`
+	Passed   bool    `json:"passed"`
+}
+
+// InventoryItem defines model for InventoryItem.
+type InventoryItem struct {
+	Active bool `json:"active"`
+
+	// DefaultLocation Storage location consumptions of cleaning orders are taken from
+	DefaultLocation string `json:"default_location"`
+	Id              int    `json:"id"`
+
+	// MinStock The item is low on stock when the total of all locations is below this
+	MinStock int    `json:"min_stock"`
+	Name     string `json:"name"`
+
+	// PropertyId Property the item is stocked at, the one of the request it was created in
+	PropertyId *int `json:"property_id,omitempty"`
+
+	// Unit Unit the quantities are counted in, e.g. pcs
+	Unit      string     `json:"unit"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// Version Incremented on every change, used as ETag
+	Version *int `json:"version,omitempty"`
+}
+
+// InventoryItemCreateRequest defines model for InventoryItemCreateRequest.
+type InventoryItemCreateRequest struct {
+	DefaultLocation string  `json:"default_location"`
+	MinStock        *int    `json:"min_stock,omitempty"`
+	Name            string  `json:"name"`
+	Unit            *string `json:"unit,omitempty"`
+}
+
+// LostItem defines model for LostItem.
+type LostItem struct {
+	// BookingId Booking of the guest who probably lost the item
+	BookingId *int `json:"booking_id,omitempty"`
+
+	// ClosedAt Set when the item is returned or disposed
+	ClosedAt    *time.Time `json:"closed_at,omitempty"`
+	Description string     `json:"description"`
+
+	// DisposeAfter A stored item is due for disposal after this time
+	DisposeAfter time.Time `json:"dispose_after"`
+	FoundAt      time.Time `json:"found_at"`
+
+	// FoundBy Cleaner who found the item
+	FoundBy *int    `json:"found_by,omitempty"`
+	Id      int     `json:"id"`
+	Notes   *string `json:"notes,omitempty"`
+
+	// OrderId Cleaning order during which the item was found
+	OrderId *int `json:"order_id,omitempty"`
+
+	// ReturnedTo Who the item was handed to
+	ReturnedTo      *string        `json:"returned_to,omitempty"`
+	RoomId          int            `json:"room_id"`
+	Status          LostItemStatus `json:"status"`
+	StorageLocation string         `json:"storage_location"`
+	UpdatedAt       *time.Time     `json:"updated_at,omitempty"`
+
+	// Version Incremented on every change, used as ETag
+	Version *int `json:"version,omitempty"`
+}
+
+// LostItemStatus defines model for LostItem.Status.
+type LostItemStatus string
+
+// LostItemCreateRequest defines model for LostItemCreateRequest.
+type LostItemCreateRequest struct {
+	BookingId   *int   `json:"booking_id,omitempty"`
+	Description string `json:"description"`
+
+	// FoundAt Defaults to now
+	FoundAt         *time.Time `json:"found_at,omitempty"`
+	FoundBy         *int       `json:"found_by,omitempty"`
+	Notes           *string    `json:"notes,omitempty"`
+	OrderId         *int       `json:"order_id,omitempty"`
+	RoomId          *int       `json:"room_id,omitempty"`
+	StorageLocation string     `json:"storage_location"`
+}
+
+// LowStockItem defines model for LowStockItem.
+type LowStockItem struct {
+	ItemId   int    `json:"item_id"`
+	MinStock int    `json:"min_stock"`
+	Name     string `json:"name"`
+
+	// Quantity Total stock of all locations
+	Quantity int    `json:"quantity"`
+	Unit     string `json:"unit"`
+}
+
+// MaintenanceTicket defines model for MaintenanceTicket.
+type MaintenanceTicket struct {
+	// Assignee Maintenance worker handling the ticket
+	Assignee    *string   `json:"assignee,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	Description *string   `json:"description,omitempty"`
+	Id          int       `json:"id"`
+
+	// OrderId Cleaning order during which the issue was found
+	OrderId *int `json:"order_id,omitempty"`
+
+	// OutOfOrder Keep the room out of order until the ticket is resolved
+	OutOfOrder bool                      `json:"out_of_order"`
+	Priority   MaintenanceTicketPriority `json:"priority"`
+
+	// ReportedBy Cleaner who reported the issue
+	ReportedBy *int       `json:"reported_by,omitempty"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+
+	// RoomBlockId Out-of-order period of the room created for the ticket
+	RoomBlockId *int                    `json:"room_block_id,omitempty"`
+	RoomId      int                     `json:"room_id"`
+	Status      MaintenanceTicketStatus `json:"status"`
+	Title       string                  `json:"title"`
+	UpdatedAt   *time.Time              `json:"updated_at,omitempty"`
+
+	// Version Incremented on every change, used as ETag
+	Version *int `json:"version,omitempty"`
+}
+
+// MaintenanceTicketPriority defines model for MaintenanceTicket.Priority.
+type MaintenanceTicketPriority string
+
+// MaintenanceTicketStatus defines model for MaintenanceTicket.Status.
+type MaintenanceTicketStatus string
+
+// MaintenanceTicketCreateRequest defines model for MaintenanceTicketCreateRequest.
+type MaintenanceTicketCreateRequest struct {
+	Assignee    *string                                 `json:"assignee,omitempty"`
+	Description *string                                 `json:"description,omitempty"`
+	OrderId     *int                                    `json:"order_id,omitempty"`
+	OutOfOrder  *bool                                   `json:"out_of_order,omitempty"`
+	Priority    *MaintenanceTicketCreateRequestPriority `json:"priority,omitempty"`
+
+	// ReportedBy Cleaner who reported the issue
+	ReportedBy *int `json:"reported_by,omitempty"`
+
+	// RoomId Defaults to the room of the cleaning order
+	RoomId *int   `json:"room_id,omitempty"`
+	Title  string `json:"title"`
+}
+
+// MaintenanceTicketCreateRequestPriority defines model for MaintenanceTicketCreateRequest.Priority.
+type MaintenanceTicketCreateRequestPriority string
+
+// OccupancyRow defines model for OccupancyRow.
+type OccupancyRow struct {
+	CleanerId      *int    `json:"cleaner_id,omitempty"`
+	CleaningType   *string `json:"cleaning_type,omitempty"`
+	OccupiedNights int     `json:"occupied_nights"`
+
+	// Period The day, or the first day of the week or month
+	Period *openapi_types.Date `json:"period,omitempty"`
+
+	// Rate Occupied share of the room nights
+	Rate float32 `json:"rate"`
+
+	// RoomNights Nights of the rooms in the group
+	RoomNights int `json:"room_nights"`
+
+	// RoomTypeId Omitted for rooms without type too
+	RoomTypeId *int `json:"room_type_id,omitempty"`
+}
+
+// OnTimeRow defines model for OnTimeRow.
+type OnTimeRow struct {
+	CleanerId    *int    `json:"cleaner_id,omitempty"`
+	CleaningType *string `json:"cleaning_type,omitempty"`
+
+	// OnTime Orders done before they were due
+	OnTime int `json:"on_time"`
+
+	// Orders Orders done or due
+	Orders int `json:"orders"`
+
+	// Period The day, or the first day of the week or month
+	Period *openapi_types.Date `json:"period,omitempty"`
+	Rate   float32             `json:"rate"`
+
+	// RoomTypeId Omitted for rooms without type too
+	RoomTypeId *int `json:"room_type_id,omitempty"`
+}
+
+// OrderChecklistItem defines model for OrderChecklistItem.
+type OrderChecklistItem struct {
+	Checked   bool       `json:"checked"`
+	CheckedAt *time.Time `json:"checked_at,omitempty"`
+
+	// CheckedBy Cleaner who checked the item
+	CheckedBy *int   `json:"checked_by,omitempty"`
+	Id        int    `json:"id"`
+	Name      string `json:"name"`
+	OrderId   int    `json:"order_id"`
+	Position  int    `json:"position"`
+	Required  bool   `json:"required"`
+}
+
+// Property defines model for Property.
+type Property struct {
+	Address   *string    `json:"address,omitempty"`
+	Id        int        `json:"id"`
+	Name      string     `json:"name"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// Version Incremented on every change, used as ETag
+	Version *int `json:"version,omitempty"`
+}
+
+// PropertyCreateRequest defines model for PropertyCreateRequest.
+type PropertyCreateRequest struct {
+	Address *string `json:"address,omitempty"`
+	Name    string  `json:"name"`
+}
+
+// PropertyUser defines model for PropertyUser.
+type PropertyUser struct {
+	CreatedAt  time.Time `json:"created_at"`
+	PropertyId int       `json:"property_id"`
+	User       string    `json:"user"`
+}
+
+// RecurrenceRule A recurring cleaning of a room or a zone without booking
+type RecurrenceRule struct {
+	// Active Inactive rules generate no orders
+	Active       bool   `json:"active"`
+	CleaningType string `json:"cleaning_type"`
+
+	// Cost Cost of the orders, the default of the cleaning type and room if omitted
+	Cost *int `json:"cost,omitempty"`
+
+	// GeneratedUntil Orders have been generated up to this time
+	GeneratedUntil *time.Time `json:"generated_until,omitempty"`
+	Id             int        `json:"id"`
+	Notes          *string    `json:"notes,omitempty"`
+	RoomId         *int       `json:"room_id,omitempty"`
+
+	// Rrule RFC 5545 recurrence rule with FREQ (DAILY, WEEKLY, MONTHLY or
+	// YEARLY), INTERVAL, BYDAY, BYMONTHDAY, BYMONTH and COUNT or UNTIL,
+	// e.g. FREQ=WEEKLY;BYDAY=MO,TH or FREQ=MONTHLY;INTERVAL=3
+	Rrule string `json:"rrule"`
+
+	// StartsAt First possible occurrence, its time of day in the hotel time zone is that of all occurrences
+	StartsAt  time.Time  `json:"starts_at"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// Version Incremented on every change, used as ETag
+	Version *int `json:"version,omitempty"`
+
+	// ZoneId Zone cleaned as a whole, e.g. a lobby, for rules without room
+	ZoneId *int `json:"zone_id,omitempty"`
+}
+
+// RecurrenceRuleCreateRequest Exactly one of room_id and zone_id is given
+type RecurrenceRuleCreateRequest struct {
+	Active       *bool     `json:"active,omitempty"`
+	CleaningType string    `json:"cleaning_type"`
+	Cost         *int      `json:"cost,omitempty"`
+	Notes        *string   `json:"notes,omitempty"`
+	RoomId       *int      `json:"room_id,omitempty"`
+	Rrule        string    `json:"rrule"`
+	StartsAt     time.Time `json:"starts_at"`
+	ZoneId       *int      `json:"zone_id,omitempty"`
+}
+
+// ReportKey The group of a report row, only the field of the group_by dimension is set
+type ReportKey struct {
+	CleanerId    *int    `json:"cleaner_id,omitempty"`
+	CleaningType *string `json:"cleaning_type,omitempty"`
+
+	// Period The day, or the first day of the week or month
+	Period *openapi_types.Date `json:"period,omitempty"`
+
+	// RoomTypeId Omitted for rooms without type too
+	RoomTypeId *int `json:"room_type_id,omitempty"`
+}
+
+// Room defines model for Room.
+type Room struct {
+	// DeletedAt Set when the room is deleted
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+	Desc      *string    `json:"desc,omitempty"`
+	Floor     int        `json:"floor"`
+	Id        int        `json:"id"`
+
+	// PropertyId Property the room belongs to, the one of the request it was created in
+	PropertyId *int `json:"property_id,omitempty"`
+	RoomTypeId *int `json:"room_type_id,omitempty"`
+
+	// Status Housekeeping status, or the kind of the out-of-order or
+	// out-of-service period the room is currently in
+	Status    *RoomStatus `json:"status,omitempty"`
+	UpdatedAt *time.Time  `json:"updated_at,omitempty"`
+
+	// Version Incremented on every change, used as ETag
+	Version *int `json:"version,omitempty"`
+
+	// ZoneId Zone the room is placed in, its floor is then the level of the zone's floor
+	ZoneId *int `json:"zone_id,omitempty"`
+}
+
+// RoomStatus Housekeeping status, or the kind of the out-of-order or
+// out-of-service period the room is currently in
+type RoomStatus string
+
+// RoomBlock defines model for RoomBlock.
+type RoomBlock struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// EndsAt End of the period, open-ended if omitted
+	EndsAt   *time.Time    `json:"ends_at,omitempty"`
+	Id       int           `json:"id"`
+	Kind     RoomBlockKind `json:"kind"`
+	Reason   string        `json:"reason"`
+	RoomId   int           `json:"room_id"`
+	StartsAt time.Time     `json:"starts_at"`
+}
+
+// RoomBlockKind defines model for RoomBlock.Kind.
+type RoomBlockKind string
+
+// RoomBlockCreateRequest defines model for RoomBlockCreateRequest.
+type RoomBlockCreateRequest struct {
+	// EndsAt End of the period, open-ended if omitted
+	EndsAt *time.Time                 `json:"ends_at,omitempty"`
+	Kind   RoomBlockCreateRequestKind `json:"kind"`
+	Reason string                     `json:"reason"`
+
+	// StartsAt Start of the period, now if omitted
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+}
+
+// RoomBlockCreateRequestKind defines model for RoomBlockCreateRequest.Kind.
+type RoomBlockCreateRequestKind string
+
+// RoomCreateRequest defines model for RoomCreateRequest.
+type RoomCreateRequest struct {
+	Desc       *string `json:"desc,omitempty"`
+	Floor      int     `json:"floor"`
+	RoomTypeId *int    `json:"room_type_id,omitempty"`
+	ZoneId     *int    `json:"zone_id,omitempty"`
+}
+
+// RoomStatusUpdateRequest defines model for RoomStatusUpdateRequest.
+type RoomStatusUpdateRequest struct {
+	Status RoomStatusUpdateRequestStatus `json:"status"`
+}
+
+// RoomStatusUpdateRequestStatus defines model for RoomStatusUpdateRequest.Status.
+type RoomStatusUpdateRequestStatus string
+
+// RoomType defines model for RoomType.
+type RoomType struct {
+	// Amenities e.g. bathtub, balcony, kitchenette
+	Amenities []string `json:"amenities"`
+
+	// Area Floor area in square metres
+	Area float64 `json:"area"`
+	Beds int     `json:"beds"`
+
+	// Capacity Most guests a booking of a room of this type may have
+	Capacity int `json:"capacity"`
+
+	// GeneralCost Cost of a general cleaning, the configured default if omitted
+	GeneralCost *int `json:"general_cost,omitempty"`
+	Id          int  `json:"id"`
+
+	// Name Name unique in the property, e.g. single, double or suite
+	Name string `json:"name"`
+
+	// PeriodicCost Cost of a periodic cleaning, the configured default if omitted
+	PeriodicCost *int `json:"periodic_cost,omitempty"`
+
+	// PropertyId Property the room type belongs to, the one of the request it was created in
+	PropertyId *int       `json:"property_id,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+
+	// Version Incremented on every change, used as ETag
+	Version *int `json:"version,omitempty"`
+}
+
+// RoomTypeCreateRequest defines model for RoomTypeCreateRequest.
+type RoomTypeCreateRequest struct {
+	Amenities    *[]string `json:"amenities,omitempty"`
+	Area         *float64  `json:"area,omitempty"`
+	Beds         int       `json:"beds"`
+	Capacity     int       `json:"capacity"`
+	GeneralCost  *int      `json:"general_cost,omitempty"`
+	Name         string    `json:"name"`
+	PeriodicCost *int      `json:"periodic_cost,omitempty"`
+}
+
+// RoomUpdateRequest defines model for RoomUpdateRequest.
+type RoomUpdateRequest struct {
+	Desc       *string `json:"desc,omitempty"`
+	Floor      *int    `json:"floor,omitempty"`
+	RoomTypeId *int    `json:"room_type_id,omitempty"`
+	ZoneId     *int    `json:"zone_id,omitempty"`
+}
+
+// RouteStop defines model for RouteStop.
+type RouteStop struct {
+	CleaningType string    `json:"cleaning_type"`
+	Finish       time.Time `json:"finish"`
+	Floor        int       `json:"floor"`
+
+	// Late The order is estimated to finish after the next arrival or the end of the preferred time
+	Late bool `json:"late"`
+
+	// NextArrival Check-in of the next booking of the room that day
+	NextArrival *time.Time `json:"next_arrival,omitempty"`
+	OrderId     int        `json:"order_id"`
+
+	// Reason arrival for departures of rooms with an arrival the same day,
+	// departure for other departures, stay for periodic cleanings,
+	// task for orders without booking
+	Reason RouteStopReason `json:"reason"`
+	RoomId *int            `json:"room_id,omitempty"`
+	Start  time.Time       `json:"start"`
+
+	// WindowEnd End of the preferred time of the guest, HH:MM
+	WindowEnd *string `json:"window_end,omitempty"`
+
+	// WindowStart Start of the preferred time of the guest, HH:MM
+	WindowStart *string `json:"window_start,omitempty"`
+	ZoneId      *int    `json:"zone_id,omitempty"`
+}
+
+// RouteStopReason arrival for departures of rooms with an arrival the same day,
+// departure for other departures, stay for periodic cleanings,
+// task for orders without booking
+type RouteStopReason string
+
+// ServicePreferences defines model for ServicePreferences.
+type ServicePreferences struct {
+	// Cleaning Periodic cleanings every day, every third day of the stay or none,
+	// the cleaning after departure is always scheduled
+	Cleaning ServicePreferencesCleaning `json:"cleaning"`
+
+	// DndDate Day on which the guest does not want to be disturbed
+	DndDate *openapi_types.Date `json:"dnd_date,omitempty"`
+
+	// WindowEnd End of the preferred time of periodic cleanings, HH:MM in the hotel time zone
+	WindowEnd *string `json:"window_end,omitempty"`
+
+	// WindowStart Start of the preferred time of periodic cleanings, HH:MM in the hotel time zone
+	WindowStart *string `json:"window_start,omitempty"`
+}
+
+// ServicePreferencesCleaning Periodic cleanings every day, every third day of the stay or none,
+// the cleaning after departure is always scheduled
+type ServicePreferencesCleaning string
+
+// Shift defines model for Shift.
+type Shift struct {
+	CleanerId int        `json:"cleaner_id"`
+	EndTs     time.Time  `json:"end_ts"`
+	Id        int        `json:"id"`
+	Notes     *string    `json:"notes,omitempty"`
+	StartTs   time.Time  `json:"start_ts"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// Version Incremented on every change, used as ETag
+	Version *int `json:"version,omitempty"`
+}
+
+// ShiftCreateRequest defines model for ShiftCreateRequest.
+type ShiftCreateRequest struct {
+	CleanerId int       `json:"cleaner_id"`
+	EndTs     time.Time `json:"end_ts"`
+	Notes     *string   `json:"notes,omitempty"`
+	StartTs   time.Time `json:"start_ts"`
+}
+
+// StockLevel defines model for StockLevel.
+type StockLevel struct {
+	ItemId    int       `json:"item_id"`
+	Location  string    `json:"location"`
+	Quantity  int       `json:"quantity"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// StockMovement defines model for StockMovement.
+type StockMovement struct {
+	CreatedAt time.Time         `json:"created_at"`
+	Id        int               `json:"id"`
+	ItemId    int               `json:"item_id"`
+	Kind      StockMovementKind `json:"kind"`
+	Location  string            `json:"location"`
+	Note      *string           `json:"note,omitempty"`
+
+	// OrderId Cleaning order of a consumption
+	OrderId *int `json:"order_id,omitempty"`
+
+	// Quantity Change of the stock, negative for consumptions
+	Quantity int `json:"quantity"`
+}
+
+// StockMovementKind defines model for StockMovement.Kind.
+type StockMovementKind string
+
+// StockMovementCreateRequest defines model for StockMovementCreateRequest.
+type StockMovementCreateRequest struct {
+	ItemId int                            `json:"item_id"`
+	Kind   StockMovementCreateRequestKind `json:"kind"`
+
+	// Location Defaults to the default location of the item
+	Location *string `json:"location,omitempty"`
+	Note     *string `json:"note,omitempty"`
+	Quantity int     `json:"quantity"`
+}
+
+// StockMovementCreateRequestKind defines model for StockMovementCreateRequest.Kind.
+type StockMovementCreateRequestKind string
+
+// Zone defines model for Zone.
+type Zone struct {
+	FloorId   int        `json:"floor_id"`
+	Id        int        `json:"id"`
+	Name      string     `json:"name"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// Version Incremented on every change, used as ETag
+	Version *int `json:"version,omitempty"`
+}
+
+// ZoneCreateRequest defines model for ZoneCreateRequest.
+type ZoneCreateRequest struct {
+	FloorId int    `json:"floor_id"`
+	Name    string `json:"name"`
+}
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// IncludeDeleted defines model for IncludeDeleted.
+type IncludeDeleted = bool
+
+// GetBookingsParams defines parameters for GetBookings.
+type GetBookingsParams struct {
+	// IncludeDeleted Include deleted records
+	IncludeDeleted *IncludeDeleted `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+
+	// Guest Only bookings whose guest name, email, phone or external reference contains this, ignoring case
+	Guest *string `form:"guest,omitempty" json:"guest,omitempty"`
+}
+
+// GetBookingsIdParams defines parameters for GetBookingsId.
+type GetBookingsIdParams struct {
+	// IncludeDeleted Include deleted records
+	IncludeDeleted *IncludeDeleted `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// PatchBookingsIdApplicationMergePatchPlusJSONBody defines parameters for PatchBookingsId.
+type PatchBookingsIdApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchBookingsIdParams defines parameters for PatchBookingsId.
+type PatchBookingsIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PutBookingsIdParams defines parameters for PutBookingsId.
+type PutBookingsIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PutBookingsIdServicePreferencesParams defines parameters for PutBookingsIdServicePreferences.
+type PutBookingsIdServicePreferencesParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PatchBuildingsIdApplicationMergePatchPlusJSONBody defines parameters for PatchBuildingsId.
+type PatchBuildingsIdApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchBuildingsIdParams defines parameters for PatchBuildingsId.
+type PatchBuildingsIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetChecklistTemplatesParams defines parameters for GetChecklistTemplates.
+type GetChecklistTemplatesParams struct {
+	CleaningType *string `form:"cleaning_type,omitempty" json:"cleaning_type,omitempty"`
+}
+
+// GetCleanersParams defines parameters for GetCleaners.
+type GetCleanersParams struct {
+	// IncludeDeleted Include deleted records
+	IncludeDeleted *IncludeDeleted `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// GetCleanersIdParams defines parameters for GetCleanersId.
+type GetCleanersIdParams struct {
+	// IncludeDeleted Include deleted records
+	IncludeDeleted *IncludeDeleted `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// PatchCleanersIdApplicationMergePatchPlusJSONBody defines parameters for PatchCleanersId.
+type PatchCleanersIdApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchCleanersIdParams defines parameters for PatchCleanersId.
+type PatchCleanersIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PutCleanersIdParams defines parameters for PutCleanersId.
+type PutCleanersIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetCleanersIdCleaningOrdersParams defines parameters for GetCleanersIdCleaningOrders.
+type GetCleanersIdCleaningOrdersParams struct {
+	// Sort Order of the list, priority sorts the most urgent orders first
+	Sort *GetCleanersIdCleaningOrdersParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// GetCleanersIdCleaningOrdersParamsSort defines parameters for GetCleanersIdCleaningOrders.
+type GetCleanersIdCleaningOrdersParamsSort string
+
+// GetCleanersIdRouteParams defines parameters for GetCleanersIdRoute.
+type GetCleanersIdRouteParams struct {
+	// Date Day in the hotel time zone, today if omitted
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// GetCleaningOrdersParams defines parameters for GetCleaningOrders.
+type GetCleaningOrdersParams struct {
+	// ZoneId Only orders of rooms in this zone
+	ZoneId *int `form:"zone_id,omitempty" json:"zone_id,omitempty"`
+
+	// FloorId Only orders of rooms on this floor
+	FloorId *int `form:"floor_id,omitempty" json:"floor_id,omitempty"`
+
+	// BuildingId Only orders of rooms in this building
+	BuildingId *int `form:"building_id,omitempty" json:"building_id,omitempty"`
+
+	// Sort Order of the list, priority sorts the most urgent orders first
+	Sort *GetCleaningOrdersParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+}
+
+// GetCleaningOrdersParamsSort defines parameters for GetCleaningOrders.
+type GetCleaningOrdersParamsSort string
+
+// PatchCleaningOrdersIdApplicationMergePatchPlusJSONBody defines parameters for PatchCleaningOrdersId.
+type PatchCleaningOrdersIdApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchCleaningOrdersIdParams defines parameters for PatchCleaningOrdersId.
+type PatchCleaningOrdersIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PutCleaningOrdersIdParams defines parameters for PutCleaningOrdersId.
+type PutCleaningOrdersIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostCleaningOrdersIdAttachmentsMultipartBody defines parameters for PostCleaningOrdersIdAttachments.
+type PostCleaningOrdersIdAttachmentsMultipartBody struct {
+	// CleanerId Cleaner who uploads the file
+	CleanerId   *int               `json:"cleaner_id,omitempty"`
+	Description *string            `json:"description,omitempty"`
+	File        openapi_types.File `json:"file"`
+}
+
+// PostCleaningOrdersIdConsumptionJSONBody defines parameters for PostCleaningOrdersIdConsumption.
+type PostCleaningOrdersIdConsumptionJSONBody = []ConsumptionRequest
+
+// GetFloorsParams defines parameters for GetFloors.
+type GetFloorsParams struct {
+	// BuildingId Only floors of this building
+	BuildingId *int `form:"building_id,omitempty" json:"building_id,omitempty"`
+}
+
+// PatchFloorsIdApplicationMergePatchPlusJSONBody defines parameters for PatchFloorsId.
+type PatchFloorsIdApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchFloorsIdParams defines parameters for PatchFloorsId.
+type PatchFloorsIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetInventoryDefaultsParams defines parameters for GetInventoryDefaults.
+type GetInventoryDefaultsParams struct {
+	CleaningType *string `form:"cleaning_type,omitempty" json:"cleaning_type,omitempty"`
+}
+
+// GetInventoryItemsParams defines parameters for GetInventoryItems.
+type GetInventoryItemsParams struct {
+	// IncludeInactive Include items no longer in use
+	IncludeInactive *bool `form:"include_inactive,omitempty" json:"include_inactive,omitempty"`
+}
+
+// PatchInventoryItemsIdApplicationMergePatchPlusJSONBody defines parameters for PatchInventoryItemsId.
+type PatchInventoryItemsIdApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchInventoryItemsIdParams defines parameters for PatchInventoryItemsId.
+type PatchInventoryItemsIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetInventoryMovementsParams defines parameters for GetInventoryMovements.
+type GetInventoryMovementsParams struct {
+	ItemId   *int    `form:"item_id,omitempty" json:"item_id,omitempty"`
+	Location *string `form:"location,omitempty" json:"location,omitempty"`
+
+	// From Movements made at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Movements made before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetInventoryStockParams defines parameters for GetInventoryStock.
+type GetInventoryStockParams struct {
+	ItemId   *int    `form:"item_id,omitempty" json:"item_id,omitempty"`
+	Location *string `form:"location,omitempty" json:"location,omitempty"`
+}
+
+// GetLostItemsParams defines parameters for GetLostItems.
+type GetLostItemsParams struct {
+	Status    *GetLostItemsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	RoomId    *int                      `form:"room_id,omitempty" json:"room_id,omitempty"`
+	BookingId *int                      `form:"booking_id,omitempty" json:"booking_id,omitempty"`
+
+	// Due Only stored items past their disposal date
+	Due *bool `form:"due,omitempty" json:"due,omitempty"`
+}
+
+// GetLostItemsParamsStatus defines parameters for GetLostItems.
+type GetLostItemsParamsStatus string
+
+// PatchLostItemsIdApplicationMergePatchPlusJSONBody defines parameters for PatchLostItemsId.
+type PatchLostItemsIdApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchLostItemsIdParams defines parameters for PatchLostItemsId.
+type PatchLostItemsIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetMaintenanceTicketsParams defines parameters for GetMaintenanceTickets.
+type GetMaintenanceTicketsParams struct {
+	Status *GetMaintenanceTicketsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	RoomId *int                               `form:"room_id,omitempty" json:"room_id,omitempty"`
+}
+
+// GetMaintenanceTicketsParamsStatus defines parameters for GetMaintenanceTickets.
+type GetMaintenanceTicketsParamsStatus string
+
+// PatchMaintenanceTicketsIdApplicationMergePatchPlusJSONBody defines parameters for PatchMaintenanceTicketsId.
+type PatchMaintenanceTicketsIdApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchMaintenanceTicketsIdParams defines parameters for PatchMaintenanceTicketsId.
+type PatchMaintenanceTicketsIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PatchPropertiesIdApplicationMergePatchPlusJSONBody defines parameters for PatchPropertiesId.
+type PatchPropertiesIdApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchPropertiesIdParams defines parameters for PatchPropertiesId.
+type PatchPropertiesIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PatchRecurrenceRulesIdApplicationMergePatchPlusJSONBody defines parameters for PatchRecurrenceRulesId.
+type PatchRecurrenceRulesIdApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchRecurrenceRulesIdParams defines parameters for PatchRecurrenceRulesId.
+type PatchRecurrenceRulesIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetReportsBoardParams defines parameters for GetReportsBoard.
+type GetReportsBoardParams struct {
+	// Date Day in the hotel time zone, today if omitted
+	Date    *openapi_types.Date           `form:"date,omitempty" json:"date,omitempty"`
+	GroupBy *GetReportsBoardParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+}
+
+// GetReportsBoardParamsGroupBy defines parameters for GetReportsBoard.
+type GetReportsBoardParamsGroupBy string
+
+// GetReportsCleanerQualityParams defines parameters for GetReportsCleanerQuality.
+type GetReportsCleanerQualityParams struct {
+	// From Count inspections made at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Count inspections made before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetReportsCleanerWorkloadParams defines parameters for GetReportsCleanerWorkload.
+type GetReportsCleanerWorkloadParams struct {
+	// From First day, 30 days before the last if omitted
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day, today if omitted
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+
+	// GroupBy Dimension of the rows, cleaner if omitted
+	GroupBy *GetReportsCleanerWorkloadParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+}
+
+// GetReportsCleanerWorkloadParamsGroupBy defines parameters for GetReportsCleanerWorkload.
+type GetReportsCleanerWorkloadParamsGroupBy string
+
+// GetReportsCleaningTimeParams defines parameters for GetReportsCleaningTime.
+type GetReportsCleaningTimeParams struct {
+	// From First day, 30 days before the last if omitted
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day, today if omitted
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+
+	// GroupBy Dimension of the rows, cleaning_type if omitted
+	GroupBy *GetReportsCleaningTimeParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+}
+
+// GetReportsCleaningTimeParamsGroupBy defines parameters for GetReportsCleaningTime.
+type GetReportsCleaningTimeParamsGroupBy string
+
+// GetReportsConsumptionParams defines parameters for GetReportsConsumption.
+type GetReportsConsumptionParams struct {
+	// From Count consumptions made at or after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Count consumptions made before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// CleaningType Only count consumptions of cleaning orders of this type
+	CleaningType *string `form:"cleaning_type,omitempty" json:"cleaning_type,omitempty"`
+}
+
+// GetReportsCostPerNightParams defines parameters for GetReportsCostPerNight.
+type GetReportsCostPerNightParams struct {
+	// From First day, 30 days before the last if omitted
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day, today if omitted
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+
+	// GroupBy Dimension of the rows, day if omitted
+	GroupBy *GetReportsCostPerNightParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+}
+
+// GetReportsCostPerNightParamsGroupBy defines parameters for GetReportsCostPerNight.
+type GetReportsCostPerNightParamsGroupBy string
+
+// GetReportsForecastParams defines parameters for GetReportsForecast.
+type GetReportsForecastParams struct {
+	// From First day in the hotel time zone, today if omitted
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day in the hotel time zone, a week in total if omitted
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetReportsOccupancyParams defines parameters for GetReportsOccupancy.
+type GetReportsOccupancyParams struct {
+	// From First day, 30 days before the last if omitted
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day, today if omitted
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+
+	// GroupBy Dimension of the rows, day if omitted
+	GroupBy *GetReportsOccupancyParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+}
+
+// GetReportsOccupancyParamsGroupBy defines parameters for GetReportsOccupancy.
+type GetReportsOccupancyParamsGroupBy string
+
+// GetReportsOnTimeParams defines parameters for GetReportsOnTime.
+type GetReportsOnTimeParams struct {
+	// From First day, 30 days before the last if omitted
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Last day, today if omitted
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+
+	// GroupBy Dimension of the rows, day if omitted
+	GroupBy *GetReportsOnTimeParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+}
+
+// GetReportsOnTimeParamsGroupBy defines parameters for GetReportsOnTime.
+type GetReportsOnTimeParamsGroupBy string
+
+// PatchRoomTypesIdApplicationMergePatchPlusJSONBody defines parameters for PatchRoomTypesId.
+type PatchRoomTypesIdApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchRoomTypesIdParams defines parameters for PatchRoomTypesId.
+type PatchRoomTypesIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetRoomsParams defines parameters for GetRooms.
+type GetRoomsParams struct {
+	// IncludeDeleted Include deleted records
+	IncludeDeleted *IncludeDeleted `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// GetRoomsIdParams defines parameters for GetRoomsId.
+type GetRoomsIdParams struct {
+	// IncludeDeleted Include deleted records
+	IncludeDeleted *IncludeDeleted `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// PatchRoomsIdApplicationMergePatchPlusJSONBody defines parameters for PatchRoomsId.
+type PatchRoomsIdApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchRoomsIdParams defines parameters for PatchRoomsId.
+type PatchRoomsIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PutRoomsIdParams defines parameters for PutRoomsId.
+type PutRoomsIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetShiftsParams defines parameters for GetShifts.
+type GetShiftsParams struct {
+	// CleanerId Only shifts of this cleaner
+	CleanerId *int `form:"cleaner_id,omitempty" json:"cleaner_id,omitempty"`
+
+	// From Only shifts ending after this time
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only shifts starting before this time
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// PatchShiftsIdApplicationMergePatchPlusJSONBody defines parameters for PatchShiftsId.
+type PatchShiftsIdApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchShiftsIdParams defines parameters for PatchShiftsId.
+type PatchShiftsIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetZonesParams defines parameters for GetZones.
+type GetZonesParams struct {
+	// FloorId Only zones of this floor
+	FloorId *int `form:"floor_id,omitempty" json:"floor_id,omitempty"`
+}
+
+// PatchZonesIdApplicationMergePatchPlusJSONBody defines parameters for PatchZonesId.
+type PatchZonesIdApplicationMergePatchPlusJSONBody = map[string]interface{}
+
+// PatchZonesIdParams defines parameters for PatchZonesId.
+type PatchZonesIdParams struct {
+	// IfMatch ETag of the version the change is based on, the change fails with 412 if it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PostBookingsJSONRequestBody defines body for PostBookings for application/json ContentType.
+type PostBookingsJSONRequestBody = BookingCreateRequest
+
+// PatchBookingsIdApplicationMergePatchPlusJSONRequestBody defines body for PatchBookingsId for application/merge-patch+json ContentType.
+type PatchBookingsIdApplicationMergePatchPlusJSONRequestBody = PatchBookingsIdApplicationMergePatchPlusJSONBody
+
+// PutBookingsIdJSONRequestBody defines body for PutBookingsId for application/json ContentType.
+type PutBookingsIdJSONRequestBody = BookingUpdateRequest
+
+// PostBookingsIdCancelJSONRequestBody defines body for PostBookingsIdCancel for application/json ContentType.
+type PostBookingsIdCancelJSONRequestBody = BookingCancelRequest
+
+// PostBookingsIdCheckInJSONRequestBody defines body for PostBookingsIdCheckIn for application/json ContentType.
+type PostBookingsIdCheckInJSONRequestBody = BookingEventRequest
+
+// PostBookingsIdCheckOutJSONRequestBody defines body for PostBookingsIdCheckOut for application/json ContentType.
+type PostBookingsIdCheckOutJSONRequestBody = BookingEventRequest
+
+// PutBookingsIdServicePreferencesJSONRequestBody defines body for PutBookingsIdServicePreferences for application/json ContentType.
+type PutBookingsIdServicePreferencesJSONRequestBody = ServicePreferences
+
+// PostBuildingsJSONRequestBody defines body for PostBuildings for application/json ContentType.
+type PostBuildingsJSONRequestBody = BuildingCreateRequest
+
+// PatchBuildingsIdApplicationMergePatchPlusJSONRequestBody defines body for PatchBuildingsId for application/merge-patch+json ContentType.
+type PatchBuildingsIdApplicationMergePatchPlusJSONRequestBody = PatchBuildingsIdApplicationMergePatchPlusJSONBody
+
+// PostChecklistTemplatesJSONRequestBody defines body for PostChecklistTemplates for application/json ContentType.
+type PostChecklistTemplatesJSONRequestBody = ChecklistTemplateItemCreateRequest
+
+// PostCleanersJSONRequestBody defines body for PostCleaners for application/json ContentType.
+type PostCleanersJSONRequestBody = CleanerCreateRequest
+
+// PatchCleanersIdApplicationMergePatchPlusJSONRequestBody defines body for PatchCleanersId for application/merge-patch+json ContentType.
+type PatchCleanersIdApplicationMergePatchPlusJSONRequestBody = PatchCleanersIdApplicationMergePatchPlusJSONBody
+
+// PutCleanersIdJSONRequestBody defines body for PutCleanersId for application/json ContentType.
+type PutCleanersIdJSONRequestBody = CleanerUpdateRequest
+
+// PostCleaningOrdersJSONRequestBody defines body for PostCleaningOrders for application/json ContentType.
+type PostCleaningOrdersJSONRequestBody = CleaningOrderCreateRequest
+
+// PostCleaningOrdersBulkJSONRequestBody defines body for PostCleaningOrdersBulk for application/json ContentType.
+type PostCleaningOrdersBulkJSONRequestBody = CleaningOrderBulkRequest
+
+// PatchCleaningOrdersIdApplicationMergePatchPlusJSONRequestBody defines body for PatchCleaningOrdersId for application/merge-patch+json ContentType.
+type PatchCleaningOrdersIdApplicationMergePatchPlusJSONRequestBody = PatchCleaningOrdersIdApplicationMergePatchPlusJSONBody
+
+// PutCleaningOrdersIdJSONRequestBody defines body for PutCleaningOrdersId for application/json ContentType.
+type PutCleaningOrdersIdJSONRequestBody = CleaningOrderUpdateRequest
+
+// PostCleaningOrdersIdAttachmentsMultipartRequestBody defines body for PostCleaningOrdersIdAttachments for multipart/form-data ContentType.
+type PostCleaningOrdersIdAttachmentsMultipartRequestBody PostCleaningOrdersIdAttachmentsMultipartBody
+
+// PutCleaningOrdersIdChecklistItemIdJSONRequestBody defines body for PutCleaningOrdersIdChecklistItemId for application/json ContentType.
+type PutCleaningOrdersIdChecklistItemIdJSONRequestBody = ChecklistItemCheckRequest
+
+// PostCleaningOrdersIdCleanersJSONRequestBody defines body for PostCleaningOrdersIdCleaners for application/json ContentType.
+type PostCleaningOrdersIdCleanersJSONRequestBody = CleanerOrderCreateRequest
+
+// PostCleaningOrdersIdConsumptionJSONRequestBody defines body for PostCleaningOrdersIdConsumption for application/json ContentType.
+type PostCleaningOrdersIdConsumptionJSONRequestBody = PostCleaningOrdersIdConsumptionJSONBody
+
+// PostCleaningOrdersIdDndJSONRequestBody defines body for PostCleaningOrdersIdDnd for application/json ContentType.
+type PostCleaningOrdersIdDndJSONRequestBody = DndSkipRequest
+
+// PostCleaningOrdersIdInspectionsJSONRequestBody defines body for PostCleaningOrdersIdInspections for application/json ContentType.
+type PostCleaningOrdersIdInspectionsJSONRequestBody = InspectionCreateRequest
+
+// PutCleaningOrdersIdPriorityJSONRequestBody defines body for PutCleaningOrdersIdPriority for application/json ContentType.
+type PutCleaningOrdersIdPriorityJSONRequestBody = CleaningOrderPriorityRequest
+
+// PostFloorsJSONRequestBody defines body for PostFloors for application/json ContentType.
+type PostFloorsJSONRequestBody = FloorCreateRequest
+
+// PatchFloorsIdApplicationMergePatchPlusJSONRequestBody defines body for PatchFloorsId for application/merge-patch+json ContentType.
+type PatchFloorsIdApplicationMergePatchPlusJSONRequestBody = PatchFloorsIdApplicationMergePatchPlusJSONBody
+
+// PostInspectionItemsJSONRequestBody defines body for PostInspectionItems for application/json ContentType.
+type PostInspectionItemsJSONRequestBody = InspectionItemCreateRequest
+
+// PostInventoryDefaultsJSONRequestBody defines body for PostInventoryDefaults for application/json ContentType.
+type PostInventoryDefaultsJSONRequestBody = ConsumptionDefaultCreateRequest
+
+// PostInventoryItemsJSONRequestBody defines body for PostInventoryItems for application/json ContentType.
+type PostInventoryItemsJSONRequestBody = InventoryItemCreateRequest
+
+// PatchInventoryItemsIdApplicationMergePatchPlusJSONRequestBody defines body for PatchInventoryItemsId for application/merge-patch+json ContentType.
+type PatchInventoryItemsIdApplicationMergePatchPlusJSONRequestBody = PatchInventoryItemsIdApplicationMergePatchPlusJSONBody
+
+// PostInventoryMovementsJSONRequestBody defines body for PostInventoryMovements for application/json ContentType.
+type PostInventoryMovementsJSONRequestBody = StockMovementCreateRequest
+
+// PostLostItemsJSONRequestBody defines body for PostLostItems for application/json ContentType.
+type PostLostItemsJSONRequestBody = LostItemCreateRequest
+
+// PatchLostItemsIdApplicationMergePatchPlusJSONRequestBody defines body for PatchLostItemsId for application/merge-patch+json ContentType.
+type PatchLostItemsIdApplicationMergePatchPlusJSONRequestBody = PatchLostItemsIdApplicationMergePatchPlusJSONBody
+
+// PostMaintenanceTicketsJSONRequestBody defines body for PostMaintenanceTickets for application/json ContentType.
+type PostMaintenanceTicketsJSONRequestBody = MaintenanceTicketCreateRequest
+
+// PatchMaintenanceTicketsIdApplicationMergePatchPlusJSONRequestBody defines body for PatchMaintenanceTicketsId for application/merge-patch+json ContentType.
+type PatchMaintenanceTicketsIdApplicationMergePatchPlusJSONRequestBody = PatchMaintenanceTicketsIdApplicationMergePatchPlusJSONBody
+
+// PostPropertiesJSONRequestBody defines body for PostProperties for application/json ContentType.
+type PostPropertiesJSONRequestBody = PropertyCreateRequest
+
+// PatchPropertiesIdApplicationMergePatchPlusJSONRequestBody defines body for PatchPropertiesId for application/merge-patch+json ContentType.
+type PatchPropertiesIdApplicationMergePatchPlusJSONRequestBody = PatchPropertiesIdApplicationMergePatchPlusJSONBody
+
+// PostRecurrenceRulesJSONRequestBody defines body for PostRecurrenceRules for application/json ContentType.
+type PostRecurrenceRulesJSONRequestBody = RecurrenceRuleCreateRequest
+
+// PatchRecurrenceRulesIdApplicationMergePatchPlusJSONRequestBody defines body for PatchRecurrenceRulesId for application/merge-patch+json ContentType.
+type PatchRecurrenceRulesIdApplicationMergePatchPlusJSONRequestBody = PatchRecurrenceRulesIdApplicationMergePatchPlusJSONBody
+
+// PostRoomTypesJSONRequestBody defines body for PostRoomTypes for application/json ContentType.
+type PostRoomTypesJSONRequestBody = RoomTypeCreateRequest
+
+// PatchRoomTypesIdApplicationMergePatchPlusJSONRequestBody defines body for PatchRoomTypesId for application/merge-patch+json ContentType.
+type PatchRoomTypesIdApplicationMergePatchPlusJSONRequestBody = PatchRoomTypesIdApplicationMergePatchPlusJSONBody
+
+// PostRoomsJSONRequestBody defines body for PostRooms for application/json ContentType.
+type PostRoomsJSONRequestBody = RoomCreateRequest
+
+// PatchRoomsIdApplicationMergePatchPlusJSONRequestBody defines body for PatchRoomsId for application/merge-patch+json ContentType.
+type PatchRoomsIdApplicationMergePatchPlusJSONRequestBody = PatchRoomsIdApplicationMergePatchPlusJSONBody
+
+// PutRoomsIdJSONRequestBody defines body for PutRoomsId for application/json ContentType.
+type PutRoomsIdJSONRequestBody = RoomUpdateRequest
+
+// PostRoomsIdBlocksJSONRequestBody defines body for PostRoomsIdBlocks for application/json ContentType.
+type PostRoomsIdBlocksJSONRequestBody = RoomBlockCreateRequest
+
+// PutRoomsIdStatusJSONRequestBody defines body for PutRoomsIdStatus for application/json ContentType.
+type PutRoomsIdStatusJSONRequestBody = RoomStatusUpdateRequest
+
+// PostShiftsJSONRequestBody defines body for PostShifts for application/json ContentType.
+type PostShiftsJSONRequestBody = ShiftCreateRequest
+
+// PatchShiftsIdApplicationMergePatchPlusJSONRequestBody defines body for PatchShiftsId for application/merge-patch+json ContentType.
+type PatchShiftsIdApplicationMergePatchPlusJSONRequestBody = PatchShiftsIdApplicationMergePatchPlusJSONBody
+
+// PostZonesJSONRequestBody defines body for PostZones for application/json ContentType.
+type PostZonesJSONRequestBody = ZoneCreateRequest
+
+// PatchZonesIdApplicationMergePatchPlusJSONRequestBody defines body for PatchZonesId for application/merge-patch+json ContentType.
+type PatchZonesIdApplicationMergePatchPlusJSONRequestBody = PatchZonesIdApplicationMergePatchPlusJSONBody
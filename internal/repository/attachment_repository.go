@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// AttachmentRepository defines the interface for attachment data operations
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment *models.Attachment) error
+	GetByID(ctx context.Context, orderID, id int) (*models.Attachment, error)
+	GetByOrderID(ctx context.Context, orderID int) ([]models.Attachment, error)
+	GetPurgeable(ctx context.Context, deletedBefore time.Time) ([]models.Attachment, error)
+	Delete(ctx context.Context, orderID, id int) error
+}
+
+// attachmentRepository implements AttachmentRepository
+type attachmentRepository struct {
+	db DBTX
+}
+
+// NewAttachmentRepository creates a new attachment repository
+func NewAttachmentRepository(db DBTX) AttachmentRepository {
+	return &attachmentRepository{db: db}
+}
+
+// Create inserts a new attachment into the database
+func (r *attachmentRepository) Create(ctx context.Context, attachment *models.Attachment) error {
+	query := `
+		INSERT INTO attachments (order_id, filename, content_type, size, description, uploaded_by, has_thumbnail)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at`
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
+		attachment.OrderId,
+		attachment.Filename,
+		attachment.ContentType,
+		attachment.Size,
+		attachment.Description,
+		attachment.UploadedBy,
+		attachment.HasThumbnail,
+	).Scan(&attachment.Id, &attachment.CreatedAt)
+}
+
+// GetByID retrieves an attachment of a cleaning order
+func (r *attachmentRepository) GetByID(ctx context.Context, orderID, id int) (*models.Attachment, error) {
+	query := `
+		SELECT id, order_id, filename, content_type, size, description, uploaded_by, has_thumbnail, created_at
+		FROM attachments
+		WHERE id = $1 AND order_id = $2 AND ` + orderInProperty("order_id", 3)
+
+	attachment := &models.Attachment{}
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, orderID, propertyScope(ctx)).Scan(
+		&attachment.Id,
+		&attachment.OrderId,
+		&attachment.Filename,
+		&attachment.ContentType,
+		&attachment.Size,
+		&attachment.Description,
+		&attachment.UploadedBy,
+		&attachment.HasThumbnail,
+		&attachment.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// GetByOrderID retrieves the attachments of a cleaning order
+func (r *attachmentRepository) GetByOrderID(ctx context.Context, orderID int) ([]models.Attachment, error) {
+	query := `
+		SELECT id, order_id, filename, content_type, size, description, uploaded_by, has_thumbnail, created_at
+		FROM attachments
+		WHERE order_id = $1
+		ORDER BY created_at`
+
+	return r.query(ctx, query, orderID)
+}
+
+// GetPurgeable retrieves the attachments of the cleaning orders that purging
+// the bookings and rooms deleted before the given time removes
+func (r *attachmentRepository) GetPurgeable(ctx context.Context, deletedBefore time.Time) ([]models.Attachment, error) {
+	query := `
+		SELECT attachments.id, attachments.order_id, attachments.filename, attachments.content_type,
+		attachments.size, attachments.description, attachments.uploaded_by, attachments.has_thumbnail,
+		attachments.created_at
+		FROM attachments
+		JOIN cleaning_orders ON cleaning_orders.id = attachments.order_id
+		LEFT JOIN bookings ON bookings.id = cleaning_orders.booking_id
+		LEFT JOIN rooms ON rooms.id = cleaning_orders.room_id
+		WHERE bookings.deleted_at < $1
+		OR rooms.deleted_at < $1 AND NOT EXISTS (
+			SELECT 1 FROM bookings kept WHERE kept.room_id = rooms.id
+			AND (kept.deleted_at IS NULL OR kept.deleted_at >= $1))
+		ORDER BY attachments.id`
+
+	return r.query(ctx, query, deletedBefore)
+}
+
+// query runs a query returning attachments
+func (r *attachmentRepository) query(ctx context.Context, query string, args ...any) ([]models.Attachment, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	attachments := []models.Attachment{}
+	for rows.Next() {
+		var attachment models.Attachment
+		err := rows.Scan(
+			&attachment.Id,
+			&attachment.OrderId,
+			&attachment.Filename,
+			&attachment.ContentType,
+			&attachment.Size,
+			&attachment.Description,
+			&attachment.UploadedBy,
+			&attachment.HasThumbnail,
+			&attachment.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	return attachments, rows.Err()
+}
+
+// Delete removes an attachment of a cleaning order
+func (r *attachmentRepository) Delete(ctx context.Context, orderID, id int) error {
+	query := `DELETE FROM attachments WHERE id = $1 AND order_id = $2 AND ` + orderInProperty("order_id", 3)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, orderID, propertyScope(ctx))
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
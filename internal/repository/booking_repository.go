@@ -3,60 +3,156 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"strings"
+	"time"
 
 	"github.com/StEvseeva/cleany/internal/models"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// BookingFilter selects bookings
+type BookingFilter struct {
+	IncludeDeleted bool
+	// Guest matches part of the guest name, email, phone or external
+	// reference, ignoring case
+	Guest *string
+}
+
+// likeEscaper escapes the wildcards of a LIKE pattern
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 // BookingRepository defines the interface for booking data operations
 type BookingRepository interface {
 	Create(ctx context.Context, booking *models.Booking) error
 	GetByID(ctx context.Context, id int) (*models.Booking, error)
-	GetAll(ctx context.Context) ([]models.Booking, error)
+	GetByIDWithDeleted(ctx context.Context, id int) (*models.Booking, error)
+	GetAll(ctx context.Context, filter BookingFilter) ([]models.Booking, error)
 	Update(ctx context.Context, booking *models.Booking) error
 	Delete(ctx context.Context, id int) error
+	Restore(ctx context.Context, id int) error
+	Cancel(ctx context.Context, booking *models.Booking, reason string) error
+	CheckIn(ctx context.Context, booking *models.Booking, at time.Time) error
+	CheckOut(ctx context.Context, booking *models.Booking, at time.Time) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+	GetLastByRoom(ctx context.Context, roomID int, before time.Time) (*models.Booking, error)
+	GetNextByRoom(ctx context.Context, roomID int, after time.Time) (*models.Booking, error)
+	GetForPeriod(ctx context.Context, from, to time.Time) ([]models.Booking, error)
+	SetServicePreferences(ctx context.Context, booking *models.Booking) error
+	Anonymize(ctx context.Context, booking *models.Booking) error
+	AnonymizeDeparted(ctx context.Context, departedBefore time.Time) (int64, error)
 }
 
 // bookingRepository implements BookingRepository
 type bookingRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
 // NewBookingRepository creates a new booking repository
-func NewBookingRepository(db *sql.DB) BookingRepository {
+func NewBookingRepository(db DBTX) BookingRepository {
 	return &bookingRepository{db: db}
 }
 
+// bookingColumns are the columns read by scanBooking
+const bookingColumns = `id, room_id, check_in_ts, check_out_ts, guests, version, updated_at, deleted_at,
+		cancelled_at, cancel_reason, checked_in_at, checked_out_at,
+		cleaning_preference, service_window_start, service_window_end, dnd_date,
+		guest_name, guest_email, guest_phone, guest_language, guest_notes, guest_vip, external_ref, anonymized_at`
+
+// scanBooking scans a row of bookingColumns
+func scanBooking(row interface{ Scan(...any) error }, booking *models.Booking) error {
+	var prefs models.ServicePreferences
+	var dndDate *time.Time
+	var guest models.Guest
+	err := row.Scan(
+		&booking.Id,
+		&booking.RoomId,
+		&booking.CheckInTs,
+		&booking.CheckOutTs,
+		&booking.Guests,
+		&booking.Version,
+		&booking.UpdatedAt,
+		&booking.DeletedAt,
+		&booking.CancelledAt,
+		&booking.CancelReason,
+		&booking.CheckedInAt,
+		&booking.CheckedOutAt,
+		&prefs.Cleaning,
+		&prefs.WindowStart,
+		&prefs.WindowEnd,
+		&dndDate,
+		&guest.Name,
+		&guest.Email,
+		&guest.Phone,
+		&guest.Language,
+		&guest.Notes,
+		&guest.Vip,
+		&guest.ExternalRef,
+		&booking.AnonymizedAt,
+	)
+	if err != nil {
+		return err
+	}
+	if dndDate != nil {
+		prefs.DndDate = &openapi_types.Date{Time: *dndDate}
+	}
+	booking.ServicePreferences = &prefs
+	booking.Guest = &guest
+	return nil
+}
+
 // Create inserts a new booking into the database
 func (r *bookingRepository) Create(ctx context.Context, booking *models.Booking) error {
 	query := `
-		INSERT INTO bookings (room_id, check_in_ts, check_out_ts, guests)
-		VALUES ($1, $2, $3, $4)
-		RETURNING id`
+		INSERT INTO bookings (room_id, check_in_ts, check_out_ts, guests,
+		cleaning_preference, service_window_start, service_window_end, dnd_date,
+		guest_name, guest_email, guest_phone, guest_language, guest_notes, guest_vip, external_ref)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		RETURNING id, version, updated_at`
 
-	return r.db.QueryRowContext(ctx, query,
+	prefs := servicePreferences(booking)
+	guest := bookingGuest(booking)
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
 		booking.RoomId,
 		booking.CheckInTs,
 		booking.CheckOutTs,
 		booking.Guests,
-	).Scan(&booking.Id)
+		prefs.Cleaning,
+		prefs.WindowStart,
+		prefs.WindowEnd,
+		dndDate(prefs),
+		guest.Name,
+		guest.Email,
+		guest.Phone,
+		guest.Language,
+		guest.Notes,
+		guest.Vip != nil && *guest.Vip,
+		guest.ExternalRef,
+	).Scan(&booking.Id, &booking.Version, &booking.UpdatedAt)
 }
 
 // GetByID retrieves a booking by its ID
 func (r *bookingRepository) GetByID(ctx context.Context, id int) (*models.Booking, error) {
-	query := `
-		SELECT id, room_id, check_in_ts, check_out_ts, guests
+	query := `SELECT ` + bookingColumns + `
 		FROM bookings
-		WHERE id = $1`
+		WHERE id = $1 AND deleted_at IS NULL AND ` + roomInProperty("room_id", 2)
 
 	booking := &models.Booking{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&booking.Id,
-		&booking.RoomId,
-		&booking.CheckInTs,
-		&booking.CheckOutTs,
-		&booking.Guests,
-	)
+	err := scanBooking(conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)), booking)
+	if err != nil {
+		return nil, err
+	}
 
+	return booking, nil
+}
+
+// GetByIDWithDeleted retrieves a booking by its ID even if it is deleted
+func (r *bookingRepository) GetByIDWithDeleted(ctx context.Context, id int) (*models.Booking, error) {
+	query := `SELECT ` + bookingColumns + `
+		FROM bookings
+		WHERE id = $1 AND ` + roomInProperty("room_id", 2)
+
+	booking := &models.Booking{}
+	err := scanBooking(conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)), booking)
 	if err != nil {
 		return nil, err
 	}
@@ -64,14 +160,23 @@ func (r *bookingRepository) GetByID(ctx context.Context, id int) (*models.Bookin
 	return booking, nil
 }
 
-// GetAll retrieves all bookings
-func (r *bookingRepository) GetAll(ctx context.Context) ([]models.Booking, error) {
-	query := `
-		SELECT id, room_id, check_in_ts, check_out_ts, guests
+// GetAll retrieves the bookings matching a filter
+func (r *bookingRepository) GetAll(ctx context.Context, filter BookingFilter) ([]models.Booking, error) {
+	query := `SELECT ` + bookingColumns + `
 		FROM bookings
+		WHERE ($1 OR deleted_at IS NULL)
+		AND ($2::text IS NULL OR guest_name ILIKE $2 OR guest_email ILIKE $2
+		OR guest_phone ILIKE $2 OR external_ref ILIKE $2)
+		AND ` + roomInProperty("room_id", 3) + `
 		ORDER BY id`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	var guest *string
+	if filter.Guest != nil {
+		pattern := "%" + likeEscaper.Replace(*filter.Guest) + "%"
+		guest = &pattern
+	}
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, filter.IncludeDeleted, guest, propertyScope(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -80,13 +185,7 @@ func (r *bookingRepository) GetAll(ctx context.Context) ([]models.Booking, error
 	var bookings []models.Booking
 	for rows.Next() {
 		var booking models.Booking
-		err := rows.Scan(
-			&booking.Id,
-			&booking.RoomId,
-			&booking.CheckInTs,
-			&booking.CheckOutTs,
-			&booking.Guests,
-		)
+		err := scanBooking(rows, &booking)
 		if err != nil {
 			return nil, err
 		}
@@ -100,16 +199,45 @@ func (r *bookingRepository) GetAll(ctx context.Context) ([]models.Booking, error
 func (r *bookingRepository) Update(ctx context.Context, booking *models.Booking) error {
 	query := `
 		UPDATE bookings
-		SET room_id = $1, check_in_ts = $2, check_out_ts = $3, guests = $4
-		WHERE id = $5`
+		SET room_id = $1, check_in_ts = $2, check_out_ts = $3, guests = $4,
+		guest_name = $5, guest_email = $6, guest_phone = $7, guest_language = $8, guest_notes = $9,
+		guest_vip = $10, external_ref = $11,
+		version = version + 1, updated_at = NOW()
+		WHERE id = $12 AND version = $13 AND deleted_at IS NULL AND ` + roomInProperty("room_id", 14) + `
+		RETURNING version, updated_at`
 
-	result, err := r.db.ExecContext(ctx, query,
+	guest := bookingGuest(booking)
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
 		booking.RoomId,
 		booking.CheckInTs,
 		booking.CheckOutTs,
 		booking.Guests,
+		guest.Name,
+		guest.Email,
+		guest.Phone,
+		guest.Language,
+		guest.Notes,
+		guest.Vip != nil && *guest.Vip,
+		guest.ExternalRef,
 		booking.Id,
-	)
+		booking.Version,
+		propertyScope(ctx),
+	).Scan(&booking.Version, &booking.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "bookings", booking.Id)
+	}
+
+	return err
+}
+
+// Delete marks a booking as deleted
+func (r *bookingRepository) Delete(ctx context.Context, id int) error {
+	query := `
+		UPDATE bookings
+		SET deleted_at = NOW(), version = version + 1, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL AND ` + roomInProperty("room_id", 2)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
 	if err != nil {
 		return err
 	}
@@ -126,11 +254,62 @@ func (r *bookingRepository) Update(ctx context.Context, booking *models.Booking)
 	return nil
 }
 
-// Delete removes a booking by its ID
-func (r *bookingRepository) Delete(ctx context.Context, id int) error {
-	query := `DELETE FROM bookings WHERE id = $1`
+// Cancel marks a booking as cancelled with the given reason
+func (r *bookingRepository) Cancel(ctx context.Context, booking *models.Booking, reason string) error {
+	query := `
+		UPDATE bookings
+		SET cancelled_at = NOW(), cancel_reason = $1, version = version + 1, updated_at = NOW()
+		WHERE id = $2 AND deleted_at IS NULL AND cancelled_at IS NULL AND ` + roomInProperty("room_id", 3) + `
+		RETURNING cancelled_at, cancel_reason, version, updated_at`
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query, reason, booking.Id, propertyScope(ctx)).Scan(
+		&booking.CancelledAt,
+		&booking.CancelReason,
+		&booking.Version,
+		&booking.UpdatedAt,
+	)
+}
+
+// CheckIn records the actual check-in time of a booking
+func (r *bookingRepository) CheckIn(ctx context.Context, booking *models.Booking, at time.Time) error {
+	query := `
+		UPDATE bookings
+		SET checked_in_at = $1, version = version + 1, updated_at = NOW()
+		WHERE id = $2 AND deleted_at IS NULL AND cancelled_at IS NULL AND checked_in_at IS NULL
+		AND ` + roomInProperty("room_id", 3) + `
+		RETURNING checked_in_at, version, updated_at`
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	return conn(ctx, r.db).QueryRowContext(ctx, query, at, booking.Id, propertyScope(ctx)).Scan(
+		&booking.CheckedInAt,
+		&booking.Version,
+		&booking.UpdatedAt,
+	)
+}
+
+// CheckOut records the actual check-out time of a checked in booking
+func (r *bookingRepository) CheckOut(ctx context.Context, booking *models.Booking, at time.Time) error {
+	query := `
+		UPDATE bookings
+		SET checked_out_at = $1, version = version + 1, updated_at = NOW()
+		WHERE id = $2 AND deleted_at IS NULL AND checked_in_at IS NOT NULL AND checked_out_at IS NULL
+		AND ` + roomInProperty("room_id", 3) + `
+		RETURNING checked_out_at, version, updated_at`
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query, at, booking.Id, propertyScope(ctx)).Scan(
+		&booking.CheckedOutAt,
+		&booking.Version,
+		&booking.UpdatedAt,
+	)
+}
+
+// Restore clears the deletion mark of a deleted booking
+func (r *bookingRepository) Restore(ctx context.Context, id int) error {
+	query := `
+		UPDATE bookings
+		SET deleted_at = NULL, version = version + 1, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NOT NULL AND ` + roomInProperty("room_id", 2)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
 	if err != nil {
 		return err
 	}
@@ -146,3 +325,173 @@ func (r *bookingRepository) Delete(ctx context.Context, id int) error {
 
 	return nil
 }
+
+// Purge permanently removes bookings deleted before the given time together
+// with their cleaning orders.
+func (r *bookingRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	query := `
+		DELETE FROM bookings
+		WHERE deleted_at < $1`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// GetLastByRoom retrieves the not cancelled booking of a room that started
+// last before the given time, by the recorded check-in if there is one
+func (r *bookingRepository) GetLastByRoom(ctx context.Context, roomID int, before time.Time) (*models.Booking, error) {
+	query := `SELECT ` + bookingColumns + `
+		FROM bookings
+		WHERE room_id = $1 AND deleted_at IS NULL AND cancelled_at IS NULL
+		AND COALESCE(checked_in_at, check_in_ts) <= $2
+		AND ` + roomInProperty("room_id", 3) + `
+		ORDER BY COALESCE(checked_in_at, check_in_ts) DESC
+		LIMIT 1`
+
+	booking := &models.Booking{}
+	err := scanBooking(conn(ctx, r.db).QueryRowContext(ctx, query, roomID, before, propertyScope(ctx)), booking)
+	if err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+// GetNextByRoom retrieves the not cancelled booking of a room that checks
+// in first after the given time
+func (r *bookingRepository) GetNextByRoom(ctx context.Context, roomID int, after time.Time) (*models.Booking, error) {
+	query := `SELECT ` + bookingColumns + `
+		FROM bookings
+		WHERE room_id = $1 AND deleted_at IS NULL AND cancelled_at IS NULL
+		AND check_in_ts > $2
+		AND ` + roomInProperty("room_id", 3) + `
+		ORDER BY check_in_ts
+		LIMIT 1`
+
+	booking := &models.Booking{}
+	err := scanBooking(conn(ctx, r.db).QueryRowContext(ctx, query, roomID, after, propertyScope(ctx)), booking)
+	if err != nil {
+		return nil, err
+	}
+
+	return booking, nil
+}
+
+// GetForPeriod retrieves the not deleted, not cancelled bookings staying
+// in [from, to)
+func (r *bookingRepository) GetForPeriod(ctx context.Context, from, to time.Time) ([]models.Booking, error) {
+	query := `SELECT ` + bookingColumns + `
+		FROM bookings
+		WHERE deleted_at IS NULL AND cancelled_at IS NULL
+		AND check_in_ts < $2 AND check_out_ts > $1
+		AND ` + roomInProperty("room_id", 3) + `
+		ORDER BY check_in_ts`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, from, to, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bookings := []models.Booking{}
+	for rows.Next() {
+		var booking models.Booking
+		if err := scanBooking(rows, &booking); err != nil {
+			return nil, err
+		}
+		bookings = append(bookings, booking)
+	}
+
+	return bookings, nil
+}
+
+// SetServicePreferences updates the service preferences of a booking if its
+// version matches
+func (r *bookingRepository) SetServicePreferences(ctx context.Context, booking *models.Booking) error {
+	query := `
+		UPDATE bookings
+		SET cleaning_preference = $1, service_window_start = $2, service_window_end = $3, dnd_date = $4,
+		version = version + 1, updated_at = NOW()
+		WHERE id = $5 AND version = $6 AND deleted_at IS NULL AND ` + roomInProperty("room_id", 7) + `
+		RETURNING version, updated_at`
+
+	prefs := servicePreferences(booking)
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		prefs.Cleaning,
+		prefs.WindowStart,
+		prefs.WindowEnd,
+		dndDate(prefs),
+		booking.Id,
+		booking.Version,
+		propertyScope(ctx),
+	).Scan(&booking.Version, &booking.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "bookings", booking.Id)
+	}
+
+	return err
+}
+
+// Anonymize erases the personal data of the guest of a booking
+func (r *bookingRepository) Anonymize(ctx context.Context, booking *models.Booking) error {
+	query := `
+		UPDATE bookings
+		SET ` + anonymizeAssignments + `, version = version + 1, updated_at = NOW()
+		WHERE id = $1 AND ` + roomInProperty("room_id", 2) + `
+		RETURNING ` + bookingColumns
+
+	return scanBooking(conn(ctx, r.db).QueryRowContext(ctx, query, booking.Id, propertyScope(ctx)), booking)
+}
+
+// AnonymizeDeparted erases the personal data of the guests of bookings that
+// were checked out or cancelled before the given time
+func (r *bookingRepository) AnonymizeDeparted(ctx context.Context, departedBefore time.Time) (int64, error) {
+	query := `
+		UPDATE bookings
+		SET ` + anonymizeAssignments + `, version = version + 1, updated_at = NOW()
+		WHERE anonymized_at IS NULL
+		AND (COALESCE(checked_out_at, check_out_ts) < $1 OR cancelled_at < $1)`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, departedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// anonymizeAssignments clears the personal data of a guest, language and the
+// VIP flag are no personal data on their own and stay for statistics
+const anonymizeAssignments = `guest_name = NULL, guest_email = NULL, guest_phone = NULL,
+		guest_notes = NULL, external_ref = NULL, anonymized_at = NOW()`
+
+// bookingGuest returns the guest of a booking, empty if it has none
+func bookingGuest(booking *models.Booking) models.Guest {
+	if booking.Guest == nil {
+		return models.Guest{}
+	}
+	return *booking.Guest
+}
+
+// servicePreferences returns the preferences of a booking, daily cleaning
+// if it has none
+func servicePreferences(booking *models.Booking) models.ServicePreferences {
+	if booking.ServicePreferences == nil {
+		return models.ServicePreferences{Cleaning: models.ServicePreferencesCleaningDaily}
+	}
+	return *booking.ServicePreferences
+}
+
+// dndDate returns the Do Not Disturb day of preferences as a column value,
+// a date string so that it is not shifted to UTC
+func dndDate(prefs models.ServicePreferences) *string {
+	if prefs.DndDate == nil {
+		return nil
+	}
+	date := prefs.DndDate.Format(time.DateOnly)
+	return &date
+}
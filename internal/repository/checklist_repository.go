@@ -0,0 +1,209 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// ChecklistRepository defines the interface for checklist template and
+// order checklist data operations
+type ChecklistRepository interface {
+	CreateTemplateItem(ctx context.Context, item *models.ChecklistTemplateItem, position *int) error
+	GetTemplateItems(ctx context.Context, cleaningType *string) ([]models.ChecklistTemplateItem, error)
+	DeactivateTemplateItem(ctx context.Context, id int) error
+	Instantiate(ctx context.Context, orderID int) error
+	GetByOrderID(ctx context.Context, orderID int) ([]models.OrderChecklistItem, error)
+	SetChecked(ctx context.Context, orderID, itemID int, checkedAt *time.Time, checkedBy *int) (*models.OrderChecklistItem, error)
+	CountRequiredUnchecked(ctx context.Context, orderID int) (int, error)
+}
+
+// checklistRepository implements ChecklistRepository
+type checklistRepository struct {
+	db DBTX
+}
+
+// NewChecklistRepository creates a new checklist repository
+func NewChecklistRepository(db DBTX) ChecklistRepository {
+	return &checklistRepository{db: db}
+}
+
+// CreateTemplateItem inserts a new template item, without a position it is
+// appended to the checklist of its cleaning type
+func (r *checklistRepository) CreateTemplateItem(ctx context.Context, item *models.ChecklistTemplateItem, position *int) error {
+	query := `
+		INSERT INTO checklist_templates (cleaning_type, name, required, position, property_id)
+		VALUES ($1, $2, $3, COALESCE($4, (
+			SELECT COALESCE(MAX(position), 0) + 1
+			FROM checklist_templates
+			WHERE cleaning_type = $1 AND active AND property_id = $5
+		)), $5)
+		RETURNING id, position`
+
+	propertyID, err := requireProperty(ctx)
+	if err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
+		item.CleaningType,
+		item.Name,
+		item.Required,
+		position,
+		propertyID,
+	).Scan(&item.Id, &item.Position)
+}
+
+// GetTemplateItems retrieves the active template items, optionally of one
+// cleaning type
+func (r *checklistRepository) GetTemplateItems(ctx context.Context, cleaningType *string) ([]models.ChecklistTemplateItem, error) {
+	query := `
+		SELECT id, cleaning_type, name, required, position
+		FROM checklist_templates
+		WHERE active AND ($1::varchar IS NULL OR cleaning_type = $1)
+		AND ` + inProperty("property_id", 2) + `
+		ORDER BY cleaning_type, position, id`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, cleaningType, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []models.ChecklistTemplateItem
+	for rows.Next() {
+		var item models.ChecklistTemplateItem
+		err := rows.Scan(
+			&item.Id,
+			&item.CleaningType,
+			&item.Name,
+			&item.Required,
+			&item.Position,
+		)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// DeactivateTemplateItem removes a template item from checklists of new orders
+func (r *checklistRepository) DeactivateTemplateItem(ctx context.Context, id int) error {
+	query := `UPDATE checklist_templates SET active = FALSE WHERE id = $1 AND active AND ` + inProperty("property_id", 2)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Instantiate copies the active template items of the cleaning type of an
+// order in the property of its room or zone to its checklist
+func (r *checklistRepository) Instantiate(ctx context.Context, orderID int) error {
+	query := `
+		INSERT INTO order_checklist_items (order_id, name, required, position)
+		SELECT cleaning_orders.id, checklist_templates.name,
+		checklist_templates.required, checklist_templates.position
+		FROM cleaning_orders
+		LEFT JOIN bookings ON bookings.id = cleaning_orders.booking_id
+		LEFT JOIN rooms ON rooms.id = COALESCE(cleaning_orders.room_id, bookings.room_id)
+		LEFT JOIN zones ON zones.id = cleaning_orders.zone_id
+		LEFT JOIN floors ON floors.id = zones.floor_id
+		LEFT JOIN buildings ON buildings.id = floors.building_id
+		JOIN checklist_templates ON checklist_templates.cleaning_type = cleaning_orders.cleaning_type
+		AND checklist_templates.property_id = COALESCE(rooms.property_id, buildings.property_id)
+		WHERE cleaning_orders.id = $1 AND checklist_templates.active`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, orderID)
+	return err
+}
+
+// GetByOrderID retrieves the checklist of a cleaning order
+func (r *checklistRepository) GetByOrderID(ctx context.Context, orderID int) ([]models.OrderChecklistItem, error) {
+	query := `
+		SELECT id, order_id, name, required, position, checked_at, checked_by
+		FROM order_checklist_items
+		WHERE order_id = $1
+		ORDER BY position, id`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []models.OrderChecklistItem{}
+	for rows.Next() {
+		var item models.OrderChecklistItem
+		err := rows.Scan(
+			&item.Id,
+			&item.OrderId,
+			&item.Name,
+			&item.Required,
+			&item.Position,
+			&item.CheckedAt,
+			&item.CheckedBy,
+		)
+		if err != nil {
+			return nil, err
+		}
+		item.Checked = item.CheckedAt != nil
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// SetChecked ticks an item of an order checklist, a nil checkedAt unticks it
+func (r *checklistRepository) SetChecked(ctx context.Context, orderID, itemID int, checkedAt *time.Time, checkedBy *int) (*models.OrderChecklistItem, error) {
+	query := `
+		UPDATE order_checklist_items
+		SET checked_at = $3, checked_by = $4
+		WHERE id = $1 AND order_id = $2
+		RETURNING id, order_id, name, required, position, checked_at, checked_by`
+
+	item := &models.OrderChecklistItem{}
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, itemID, orderID, checkedAt, checkedBy).Scan(
+		&item.Id,
+		&item.OrderId,
+		&item.Name,
+		&item.Required,
+		&item.Position,
+		&item.CheckedAt,
+		&item.CheckedBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	item.Checked = item.CheckedAt != nil
+
+	return item, nil
+}
+
+// CountRequiredUnchecked counts the required items of an order checklist
+// that are not checked
+func (r *checklistRepository) CountRequiredUnchecked(ctx context.Context, orderID int) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM order_checklist_items
+		WHERE order_id = $1 AND required AND checked_at IS NULL`
+
+	var count int
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, orderID).Scan(&count)
+	return count, err
+}
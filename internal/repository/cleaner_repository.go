@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/StEvseeva/cleany/internal/models"
 )
@@ -11,46 +12,87 @@ import (
 type CleanerRepository interface {
 	Create(ctx context.Context, cleaner *models.Cleaner) error
 	GetByID(ctx context.Context, id int) (*models.Cleaner, error)
-	GetAll(ctx context.Context) ([]models.Cleaner, error)
+	GetByIDWithDeleted(ctx context.Context, id int) (*models.Cleaner, error)
+	GetAll(ctx context.Context, includeDeleted bool) ([]models.Cleaner, error)
 	Update(ctx context.Context, cleaner *models.Cleaner) error
 	Delete(ctx context.Context, id int) error
+	Restore(ctx context.Context, id int) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
 }
 
 // cleanerRepository implements CleanerRepository
 type cleanerRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
 // NewCleanerRepository creates a new cleaner repository
-func NewCleanerRepository(db *sql.DB) CleanerRepository {
+func NewCleanerRepository(db DBTX) CleanerRepository {
 	return &cleanerRepository{db: db}
 }
 
 // Create inserts a new cleaner into the database
 func (r *cleanerRepository) Create(ctx context.Context, cleaner *models.Cleaner) error {
 	query := `
-		INSERT INTO cleaners (name, surname)
-		VALUES ($1, $2)
-		RETURNING id`
+		INSERT INTO cleaners (name, surname, home_zone_id, property_id)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, property_id, version, updated_at`
 
-	return r.db.QueryRowContext(ctx, query,
+	propertyID, err := requireProperty(ctx)
+	if err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
 		cleaner.Name,
 		cleaner.Surname,
-	).Scan(&cleaner.Id)
+		cleaner.HomeZoneId,
+		propertyID,
+	).Scan(&cleaner.Id, &cleaner.PropertyId, &cleaner.Version, &cleaner.UpdatedAt)
 }
 
 // GetByID retrieves a cleaner by its ID
 func (r *cleanerRepository) GetByID(ctx context.Context, id int) (*models.Cleaner, error) {
 	query := `
-		SELECT id, name, surname
+		SELECT id, name, surname, home_zone_id, property_id, version, updated_at, deleted_at
+		FROM cleaners
+		WHERE id = $1 AND deleted_at IS NULL AND ` + inProperty("property_id", 2)
+
+	cleaner := &models.Cleaner{}
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)).Scan(
+		&cleaner.Id,
+		&cleaner.Name,
+		&cleaner.Surname,
+		&cleaner.HomeZoneId,
+		&cleaner.PropertyId,
+		&cleaner.Version,
+		&cleaner.UpdatedAt,
+		&cleaner.DeletedAt,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cleaner, nil
+}
+
+// GetByIDWithDeleted retrieves a cleaner by its ID even if it is deleted
+func (r *cleanerRepository) GetByIDWithDeleted(ctx context.Context, id int) (*models.Cleaner, error) {
+	query := `
+		SELECT id, name, surname, home_zone_id, property_id, version, updated_at, deleted_at
 		FROM cleaners
-		WHERE id = $1`
+		WHERE id = $1 AND ` + inProperty("property_id", 2)
 
 	cleaner := &models.Cleaner{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)).Scan(
 		&cleaner.Id,
 		&cleaner.Name,
 		&cleaner.Surname,
+		&cleaner.HomeZoneId,
+		&cleaner.PropertyId,
+		&cleaner.Version,
+		&cleaner.UpdatedAt,
+		&cleaner.DeletedAt,
 	)
 
 	if err != nil {
@@ -60,14 +102,15 @@ func (r *cleanerRepository) GetByID(ctx context.Context, id int) (*models.Cleane
 	return cleaner, nil
 }
 
-// GetAll retrieves all cleaners
-func (r *cleanerRepository) GetAll(ctx context.Context) ([]models.Cleaner, error) {
+// GetAll retrieves all cleaners, deleted ones only if includeDeleted is set
+func (r *cleanerRepository) GetAll(ctx context.Context, includeDeleted bool) ([]models.Cleaner, error) {
 	query := `
-		SELECT id, name, surname
+		SELECT id, name, surname, home_zone_id, property_id, version, updated_at, deleted_at
 		FROM cleaners
+		WHERE ($1 OR deleted_at IS NULL) AND ` + inProperty("property_id", 2) + `
 		ORDER BY id`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, includeDeleted, propertyScope(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +123,11 @@ func (r *cleanerRepository) GetAll(ctx context.Context) ([]models.Cleaner, error
 			&cleaner.Id,
 			&cleaner.Name,
 			&cleaner.Surname,
+			&cleaner.HomeZoneId,
+			&cleaner.PropertyId,
+			&cleaner.Version,
+			&cleaner.UpdatedAt,
+			&cleaner.DeletedAt,
 		)
 		if err != nil {
 			return nil, err
@@ -94,14 +142,32 @@ func (r *cleanerRepository) GetAll(ctx context.Context) ([]models.Cleaner, error
 func (r *cleanerRepository) Update(ctx context.Context, cleaner *models.Cleaner) error {
 	query := `
 		UPDATE cleaners
-		SET name = $1, surname = $2
-		WHERE id = $3`
+		SET name = $1, surname = $2, home_zone_id = $3, version = version + 1, updated_at = NOW()
+		WHERE id = $4 AND version = $5 AND deleted_at IS NULL
+		RETURNING version, updated_at`
 
-	result, err := r.db.ExecContext(ctx, query,
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
 		cleaner.Name,
 		cleaner.Surname,
+		cleaner.HomeZoneId,
 		cleaner.Id,
-	)
+		cleaner.Version,
+	).Scan(&cleaner.Version, &cleaner.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "cleaners", cleaner.Id)
+	}
+
+	return err
+}
+
+// Delete marks a cleaner as deleted
+func (r *cleanerRepository) Delete(ctx context.Context, id int) error {
+	query := `
+		UPDATE cleaners
+		SET deleted_at = NOW(), version = version + 1, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL AND ` + inProperty("property_id", 2)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
 	if err != nil {
 		return err
 	}
@@ -118,11 +184,14 @@ func (r *cleanerRepository) Update(ctx context.Context, cleaner *models.Cleaner)
 	return nil
 }
 
-// Delete removes a cleaner by its ID
-func (r *cleanerRepository) Delete(ctx context.Context, id int) error {
-	query := `DELETE FROM cleaners WHERE id = $1`
+// Restore clears the deletion mark of a deleted cleaner
+func (r *cleanerRepository) Restore(ctx context.Context, id int) error {
+	query := `
+		UPDATE cleaners
+		SET deleted_at = NULL, version = version + 1, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NOT NULL AND ` + inProperty("property_id", 2)
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
 	if err != nil {
 		return err
 	}
@@ -138,3 +207,18 @@ func (r *cleanerRepository) Delete(ctx context.Context, id int) error {
 
 	return nil
 }
+
+// Purge permanently removes cleaners deleted before the given time together
+// with their assignments.
+func (r *cleanerRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	query := `
+		DELETE FROM cleaners
+		WHERE deleted_at < $1`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
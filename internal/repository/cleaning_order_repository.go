@@ -4,33 +4,111 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/lib/pq"
 )
 
+// CleaningOrderFilter selects cleaning orders by the location of their
+// room, nil fields match everything
+type CleaningOrderFilter struct {
+	ZoneID     *int
+	FloorID    *int
+	BuildingID *int
+}
+
 // CleaningOrderRepository defines the interface for cleaning order data operations
 type CleaningOrderRepository interface {
 	Create(ctx context.Context, order *models.CleaningOrder) error
 	CreateMany(ctx context.Context, orders []models.CleaningOrderCreateRequest) ([]int, error)
 	GetByID(ctx context.Context, id int) (*models.CleaningOrder, error)
-	GetAll(ctx context.Context) ([]models.CleaningOrder, error)
+	GetAll(ctx context.Context, filter CleaningOrderFilter) ([]models.CleaningOrder, error)
 	GetAllByCleanerId(ctx context.Context, id int) ([]models.CleaningOrder, error)
 	Update(ctx context.Context, order *models.CleaningOrder) error
+	UpdateMany(ctx context.Context, orders []models.CleaningOrder) error
 	Delete(ctx context.Context, id int) error
 	AssignCleaner(ctx context.Context, orderID, cleanerID int) error
 	RemoveCleaner(ctx context.Context, orderID, cleanerID int) error
+	GetCleanerIDs(ctx context.Context, orderID int) ([]int, error)
+	CancelByBooking(ctx context.Context, bookingID int, from time.Time, cleaningType, reason string) (int64, error)
+	RescheduleByBooking(ctx context.Context, bookingID int, cleaningType string, cleaningTs time.Time) ([]int, error)
+	GetPendingByBooking(ctx context.Context, bookingID int, after time.Time, cleaningType string) ([]models.CleaningOrder, error)
+	Cancel(ctx context.Context, order *models.CleaningOrder, reason string) error
+	CountUnassigned(ctx context.Context, from, to time.Time) (int, error)
+	CountOverdue(ctx context.Context, before time.Time) (int, error)
+	GetUnassignedDepartures(ctx context.Context, arrivalFrom, arrivalTo time.Time) ([]models.CleaningOrder, error)
+	SetPriorityOverride(ctx context.Context, id int, priority *models.CleaningOrderPriority) error
+	Board(ctx context.Context, from, to time.Time, byZone bool) ([]models.BoardRow, error)
+	CreateFromRule(ctx context.Context, rule *models.RecurrenceRule, cleaningTs time.Time, cost int) (int, error)
+	DeletePendingByRule(ctx context.Context, ruleID int, after time.Time) (int64, error)
 }
 
 // cleaningOrderRepository implements CleaningOrderRepository
 type cleaningOrderRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
 // NewCleaningOrderRepository creates a new cleaning order repository
-func NewCleaningOrderRepository(db *sql.DB) CleaningOrderRepository {
+func NewCleaningOrderRepository(db DBTX) CleaningOrderRepository {
 	return &cleaningOrderRepository{db: db}
 }
 
+// cleaningOrderColumns are the columns read by scanCleaningOrder, selected
+// from cleaningOrderTables
+const cleaningOrderColumns = `cleaning_orders.id, cleaning_orders.booking_id,
+		COALESCE(cleaning_orders.room_id, bookings.room_id), cleaning_orders.zone_id, cleaning_orders.rule_id,
+		cleaning_orders.cleaning_ts,
+		cleaning_orders.cleaning_type, cleaning_orders.cost, cleaning_orders.done, cleaning_orders.done_at,
+		cleaning_orders.notes, cleaning_orders.version, cleaning_orders.updated_at, cleaning_orders.cancelled_at, cleaning_orders.cancel_reason,
+		cleaning_orders.priority_override, following.check_in_ts,
+		CASE WHEN cleaning_orders.cleaning_type = 'general' THEN COALESCE(following.guest_vip, FALSE)
+		ELSE COALESCE(bookings.guest_vip, FALSE) END`
+
+// cleaningOrderTables joins the booking of an order, if it has one, and,
+// for departure cleanings, the next booking of the room
+const cleaningOrderTables = `cleaning_orders
+		LEFT JOIN bookings ON bookings.id = cleaning_orders.booking_id
+		LEFT JOIN LATERAL (
+			SELECT arrival.check_in_ts, arrival.guest_vip FROM bookings arrival
+			WHERE arrival.room_id = COALESCE(cleaning_orders.room_id, bookings.room_id)
+			AND arrival.check_in_ts > COALESCE(bookings.check_in_ts, cleaning_orders.cleaning_ts)
+			AND arrival.deleted_at IS NULL AND arrival.cancelled_at IS NULL
+			ORDER BY arrival.check_in_ts
+			LIMIT 1
+		) following ON cleaning_orders.cleaning_type = 'general'`
+
+// scanCleaningOrder scans a row of cleaningOrderColumns
+func scanCleaningOrder(row interface{ Scan(...any) error }, order *models.CleaningOrder) error {
+	var vip bool
+	err := row.Scan(
+		&order.Id,
+		&order.BookingId,
+		&order.RoomId,
+		&order.ZoneId,
+		&order.RuleId,
+		&order.CleaningTs,
+		&order.CleaningType,
+		&order.Cost,
+		&order.Done,
+		&order.DoneAt,
+		&order.Notes,
+		&order.Version,
+		&order.UpdatedAt,
+		&order.CancelledAt,
+		&order.CancelReason,
+		&order.PriorityOverride,
+		&order.NextArrival,
+		&vip,
+	)
+	if err != nil {
+		return err
+	}
+	order.Vip = &vip
+	return nil
+}
+
 // Create inserts a new cleaning orders into the database
 func (r *cleaningOrderRepository) CreateMany(ctx context.Context, orders []models.CleaningOrderCreateRequest) ([]int, error) {
 	if len(orders) == 0 {
@@ -38,24 +116,26 @@ func (r *cleaningOrderRepository) CreateMany(ctx context.Context, orders []model
 	}
 
 	// TODO: remove hardcode
-	params_number := 6
+	params_number := 8
 
 	valuesPart := generatePlaceholders(params_number, len(orders)*params_number)
 
 	// collect a query
 	query := fmt.Sprintf(`
 		INSERT INTO cleaning_orders 
-		(booking_id, cleaning_ts, cleaning_type, cost, done, notes)
+		(booking_id, room_id, zone_id, cleaning_ts, cleaning_type, cost, done, notes)
 		VALUES %s
 		 RETURNING id`,
 		valuesPart,
 	)
 
 	// make params for query
-	params := make([]interface{}, 0, len(orders)*6)
+	params := make([]interface{}, 0, len(orders)*params_number)
 	for _, order := range orders {
 		params = append(params,
 			order.BookingId,
+			order.RoomId,
+			order.ZoneId,
 			order.CleaningTs,
 			order.CleaningType,
 			order.Cost,
@@ -64,7 +144,7 @@ func (r *cleaningOrderRepository) CreateMany(ctx context.Context, orders []model
 		)
 	}
 
-	rows, err := r.db.QueryContext(ctx, query, params...)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, params...)
 
 	if err != nil {
 		return nil, err
@@ -84,46 +164,49 @@ func (r *cleaningOrderRepository) CreateMany(ctx context.Context, orders []model
 		ids = append(ids, id)
 	}
 
+	// orders created done are done when they are inserted
+	_, err = conn(ctx, r.db).ExecContext(ctx, `
+		UPDATE cleaning_orders SET done_at = updated_at
+		WHERE id = ANY($1) AND done IS TRUE`,
+		pq.Array(ids),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return ids, nil
 
 }
 
-// Create inserts a new cleaning order into the database
+// Create inserts a new cleaning order into the database. The room is only
+// stored for orders without booking, the others are in the room of the
+// booking.
 func (r *cleaningOrderRepository) Create(ctx context.Context, order *models.CleaningOrder) error {
 	query := `
-		INSERT INTO cleaning_orders (booking_id, cleaning_ts, cleaning_type, cost, done, notes)
-		VALUES ($1, $2, $3, $4, $5, $6)
-		RETURNING id`
+		INSERT INTO cleaning_orders (booking_id, room_id, zone_id, cleaning_ts, cleaning_type, cost, done, notes, done_at)
+		VALUES ($1, CASE WHEN $1::int IS NULL THEN $2::int END, $3, $4, $5, $6, $7, $8, CASE WHEN $7 IS TRUE THEN NOW() END)
+		RETURNING id, version, updated_at, done_at`
 
-	return r.db.QueryRowContext(ctx, query,
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
 		order.BookingId,
+		order.RoomId,
+		order.ZoneId,
 		order.CleaningTs,
 		order.CleaningType,
 		order.Cost,
 		order.Done,
 		order.Notes,
-	).Scan(&order.Id)
+	).Scan(&order.Id, &order.Version, &order.UpdatedAt, &order.DoneAt)
 }
 
 // GetByID retrieves a cleaning order by its ID
 func (r *cleaningOrderRepository) GetByID(ctx context.Context, id int) (*models.CleaningOrder, error) {
-	query := `
-		SELECT id, booking_id, cleaning_ts, cleaning_type, cost, done, notes
-		FROM cleaning_orders
-		WHERE id = $1`
+	query := `SELECT ` + cleaningOrderColumns + `
+		FROM ` + cleaningOrderTables + `
+		WHERE cleaning_orders.id = $1 AND ` + orderTargetInProperty("cleaning_orders", 2)
 
 	order := &models.CleaningOrder{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&order.Id,
-		&order.BookingId,
-		&order.CleaningTs,
-		&order.CleaningType,
-		&order.Cost,
-		&order.Done,
-		&order.Notes,
-	)
-
-	if err != nil {
+	if err := scanCleaningOrder(conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)), order); err != nil {
 		return nil, err
 	}
 
@@ -132,15 +215,15 @@ func (r *cleaningOrderRepository) GetByID(ctx context.Context, id int) (*models.
 
 // GetByID retrieves a cleaning order by its ID
 func (r *cleaningOrderRepository) GetAllByCleanerId(ctx context.Context, cleaner_id int) ([]models.CleaningOrder, error) {
-	query := `
-		SELECT cleaning_orders.id, cleaning_orders.booking_id, cleaning_orders.cleaning_ts, cleaning_orders.cleaning_type, 
-		cleaning_orders.cost, cleaning_orders.done, cleaning_orders.notes
-		FROM cleaning_orders
+	query := `SELECT ` + cleaningOrderColumns + `
+		FROM ` + cleaningOrderTables + `
 		JOIN cleaner_orders ON cleaning_orders.id = cleaner_orders.order_id
 		WHERE cleaner_orders.cleaner_id = $1
+		AND bookings.deleted_at IS NULL
+		AND ` + orderTargetInProperty("cleaning_orders", 2) + `
 		ORDER BY cleaning_orders.cleaning_ts`
 
-	rows, err := r.db.QueryContext(ctx, query, cleaner_id)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, cleaner_id, propertyScope(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -149,16 +232,7 @@ func (r *cleaningOrderRepository) GetAllByCleanerId(ctx context.Context, cleaner
 	orders := []models.CleaningOrder{}
 	for rows.Next() {
 		var order models.CleaningOrder
-		err := rows.Scan(
-			&order.Id,
-			&order.BookingId,
-			&order.CleaningTs,
-			&order.CleaningType,
-			&order.Cost,
-			&order.Done,
-			&order.Notes,
-		)
-		if err != nil {
+		if err := scanCleaningOrder(rows, &order); err != nil {
 			return nil, err
 		}
 		orders = append(orders, order)
@@ -167,14 +241,29 @@ func (r *cleaningOrderRepository) GetAllByCleanerId(ctx context.Context, cleaner
 	return orders, nil
 }
 
-// GetAll retrieves all cleaning orders
-func (r *cleaningOrderRepository) GetAll(ctx context.Context) ([]models.CleaningOrder, error) {
-	query := `
-		SELECT id, booking_id, cleaning_ts, cleaning_type, cost, done, notes
-		FROM cleaning_orders
-		ORDER BY id`
-
-	rows, err := r.db.QueryContext(ctx, query)
+// GetAll retrieves the cleaning orders matching the filter except those of
+// deleted bookings
+func (r *cleaningOrderRepository) GetAll(ctx context.Context, filter CleaningOrderFilter) ([]models.CleaningOrder, error) {
+	query := `SELECT ` + cleaningOrderColumns + `
+		FROM ` + cleaningOrderTables + `
+		WHERE bookings.deleted_at IS NULL
+		AND ` + orderTargetInProperty("cleaning_orders", 1) + `
+		AND ($2::int IS NULL AND $3::int IS NULL AND $4::int IS NULL OR COALESCE(
+			(SELECT rooms.zone_id FROM rooms WHERE rooms.id = COALESCE(cleaning_orders.room_id, bookings.room_id)),
+			cleaning_orders.zone_id) IN (
+			SELECT zones.id FROM zones
+			JOIN floors ON floors.id = zones.floor_id
+			WHERE ($2::int IS NULL OR zones.id = $2)
+			AND ($3::int IS NULL OR floors.id = $3)
+			AND ($4::int IS NULL OR floors.building_id = $4)))
+		ORDER BY cleaning_orders.id`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query,
+		propertyScope(ctx),
+		filter.ZoneID,
+		filter.FloorID,
+		filter.BuildingID,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -183,16 +272,7 @@ func (r *cleaningOrderRepository) GetAll(ctx context.Context) ([]models.Cleaning
 	var orders []models.CleaningOrder
 	for rows.Next() {
 		var order models.CleaningOrder
-		err := rows.Scan(
-			&order.Id,
-			&order.BookingId,
-			&order.CleaningTs,
-			&order.CleaningType,
-			&order.Cost,
-			&order.Done,
-			&order.Notes,
-		)
-		if err != nil {
+		if err := scanCleaningOrder(rows, &order); err != nil {
 			return nil, err
 		}
 		orders = append(orders, order)
@@ -205,29 +285,108 @@ func (r *cleaningOrderRepository) GetAll(ctx context.Context) ([]models.Cleaning
 func (r *cleaningOrderRepository) Update(ctx context.Context, order *models.CleaningOrder) error {
 	query := `
 		UPDATE cleaning_orders
-		SET booking_id = $1, cleaning_ts = $2, cleaning_type = $3, cost = $4, done = $5, notes = $6
-		WHERE id = $7`
+		SET booking_id = $1, room_id = CASE WHEN $1::int IS NULL THEN $2::int END, zone_id = $3,
+		cleaning_ts = $4, cleaning_type = $5, cost = $6, done = $7, notes = $8, version = version + 1, updated_at = NOW(),
+		done_at = CASE WHEN $7 IS TRUE THEN COALESCE(done_at, NOW()) END
+		WHERE id = $9 AND version = $10 AND ` + orderTargetInProperty("cleaning_orders", 11) + `
+		RETURNING version, updated_at, done_at`
 
-	result, err := r.db.ExecContext(ctx, query,
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
 		order.BookingId,
+		order.RoomId,
+		order.ZoneId,
 		order.CleaningTs,
 		order.CleaningType,
 		order.Cost,
 		order.Done,
 		order.Notes,
 		order.Id,
+		order.Version,
+		propertyScope(ctx),
+	).Scan(&order.Version, &order.UpdatedAt, &order.DoneAt)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "cleaning_orders", order.Id)
+	}
+
+	return err
+}
+
+// UpdateMany modifies several cleaning orders with a single query. It
+// returns ErrVersionConflict if any of the orders has been modified since
+// it was read, or sql.ErrNoRows if it does not exist anymore; in both cases
+// the other orders may have been updated, so callers should roll back.
+func (r *cleaningOrderRepository) UpdateMany(ctx context.Context, orders []models.CleaningOrder) error {
+	if len(orders) == 0 {
+		return nil
+	}
+
+	params_number := 10
+
+	// values need explicit types, postgres can't infer them in a FROM list
+	var values strings.Builder
+	for i := range orders {
+		if i > 0 {
+			values.WriteString(", ")
+		}
+		n := i * params_number
+		fmt.Fprintf(&values, "($%d::integer, $%d::integer, $%d::integer, $%d::integer, $%d::timestamp, $%d::varchar, $%d::integer, $%d::boolean, $%d::text, $%d::integer)",
+			n+1, n+2, n+3, n+4, n+5, n+6, n+7, n+8, n+9, n+10)
+	}
+
+	query := fmt.Sprintf(`
+		UPDATE cleaning_orders
+		SET booking_id = v.booking_id, room_id = CASE WHEN v.booking_id IS NULL THEN v.room_id END, zone_id = v.zone_id,
+		cleaning_ts = v.cleaning_ts, cleaning_type = v.cleaning_type,
+		cost = v.cost, done = v.done, notes = v.notes,
+		version = cleaning_orders.version + 1, updated_at = NOW(),
+		done_at = CASE WHEN v.done IS TRUE THEN COALESCE(cleaning_orders.done_at, NOW()) END
+		FROM (VALUES %s) AS v(id, booking_id, room_id, zone_id, cleaning_ts, cleaning_type, cost, done, notes, version)
+		WHERE cleaning_orders.id = v.id AND cleaning_orders.version = v.version
+		AND %s
+		RETURNING cleaning_orders.id`,
+		values.String(),
+		orderTargetInProperty("cleaning_orders", len(orders)*params_number+1),
 	)
+
+	params := make([]interface{}, 0, len(orders)*params_number+1)
+	for _, order := range orders {
+		params = append(params,
+			order.Id,
+			order.BookingId,
+			order.RoomId,
+			order.ZoneId,
+			order.CleaningTs,
+			order.CleaningType,
+			order.Cost,
+			order.Done,
+			order.Notes,
+			order.Version,
+		)
+	}
+	params = append(params, propertyScope(ctx))
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, params...)
 	if err != nil {
 		return err
 	}
+	defer rows.Close()
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
+	updated := map[int]bool{}
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return err
+		}
+		updated[id] = true
+	}
+	if err := rows.Err(); err != nil {
 		return err
 	}
 
-	if rowsAffected == 0 {
-		return sql.ErrNoRows
+	for _, order := range orders {
+		if !updated[order.Id] {
+			return updateError(ctx, r.db, "cleaning_orders", order.Id)
+		}
 	}
 
 	return nil
@@ -235,9 +394,9 @@ func (r *cleaningOrderRepository) Update(ctx context.Context, order *models.Clea
 
 // Delete removes a cleaning order by its ID
 func (r *cleaningOrderRepository) Delete(ctx context.Context, id int) error {
-	query := `DELETE FROM cleaning_orders WHERE id = $1`
+	query := `DELETE FROM cleaning_orders WHERE id = $1 AND ` + orderTargetInProperty("cleaning_orders", 2)
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
 	if err != nil {
 		return err
 	}
@@ -258,19 +417,203 @@ func (r *cleaningOrderRepository) Delete(ctx context.Context, id int) error {
 func (r *cleaningOrderRepository) AssignCleaner(ctx context.Context, orderID, cleanerID int) error {
 	query := `
 		INSERT INTO cleaner_orders (order_id, cleaner_id)
-		VALUES ($1, $2)`
+		SELECT $1::int, $2::int
+		WHERE ` + orderInProperty("$1::int", 3) + ` AND ` + cleanerInProperty("$2::int", 3)
 
-	_, err := r.db.ExecContext(ctx, query, orderID, cleanerID)
-	return err
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, orderID, cleanerID, propertyScope(ctx))
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
 }
 
 // RemoveCleaner removes a cleaner from a cleaning order
 func (r *cleaningOrderRepository) RemoveCleaner(ctx context.Context, orderID, cleanerID int) error {
 	query := `
 		DELETE FROM cleaner_orders
-		WHERE order_id = $1 AND cleaner_id = $2`
+		WHERE order_id = $1 AND cleaner_id = $2 AND ` + orderInProperty("order_id", 3)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, orderID, cleanerID, propertyScope(ctx))
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// GetCleanerIDs retrieves the IDs of the cleaners assigned to a cleaning order
+func (r *cleaningOrderRepository) GetCleanerIDs(ctx context.Context, orderID int) ([]int, error) {
+	query := `
+		SELECT cleaner_id
+		FROM cleaner_orders
+		WHERE order_id = $1 AND ` + orderInProperty("order_id", 2) + `
+		ORDER BY cleaner_id`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, orderID, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// CancelByBooking cancels the not done orders of a booking scheduled from
+// the given time on. An empty cleaningType matches orders of every type.
+func (r *cleaningOrderRepository) CancelByBooking(ctx context.Context, bookingID int, from time.Time, cleaningType, reason string) (int64, error) {
+	query := `
+		UPDATE cleaning_orders
+		SET cancelled_at = NOW(), cancel_reason = $1, version = version + 1, updated_at = NOW()
+		WHERE booking_id = $2 AND cleaning_ts >= $3
+		AND ($4 = '' OR cleaning_type = $4)
+		AND done IS NOT TRUE AND cancelled_at IS NULL
+		AND ` + orderTargetInProperty("cleaning_orders", 5)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, reason, bookingID, from, cleaningType, propertyScope(ctx))
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// RescheduleByBooking moves the not done, not cancelled orders of the given
+// type of a booking and returns their IDs
+func (r *cleaningOrderRepository) RescheduleByBooking(ctx context.Context, bookingID int, cleaningType string, cleaningTs time.Time) ([]int, error) {
+	query := `
+		UPDATE cleaning_orders
+		SET cleaning_ts = $1, version = version + 1, updated_at = NOW()
+		WHERE booking_id = $2 AND cleaning_type = $3
+		AND done IS NOT TRUE AND cancelled_at IS NULL
+		AND ` + orderTargetInProperty("cleaning_orders", 4) + `
+		RETURNING id`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, cleaningTs, bookingID, cleaningType, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
+
+// CountUnassigned counts not done, not cancelled cleaning orders in [from, to)
+// without any cleaner
+func (r *cleaningOrderRepository) CountUnassigned(ctx context.Context, from, to time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM cleaning_orders
+		WHERE cleaning_ts >= $1 AND cleaning_ts < $2
+		AND done IS NOT TRUE
+		AND cancelled_at IS NULL
+		AND (booking_id IS NULL OR booking_id IN (SELECT id FROM bookings WHERE deleted_at IS NULL))
+		AND NOT EXISTS (
+			SELECT 1 FROM cleaner_orders WHERE cleaner_orders.order_id = cleaning_orders.id
+		)
+		AND ` + orderTargetInProperty("cleaning_orders", 3)
+
+	var count int
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, from, to, propertyScope(ctx)).Scan(&count)
+	return count, err
+}
+
+// CountOverdue counts not done, not cancelled cleaning orders scheduled before
+// the given time
+func (r *cleaningOrderRepository) CountOverdue(ctx context.Context, before time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM cleaning_orders
+		WHERE cleaning_ts < $1
+		AND done IS NOT TRUE
+		AND cancelled_at IS NULL
+		AND (booking_id IS NULL OR booking_id IN (SELECT id FROM bookings WHERE deleted_at IS NULL))
+		AND ` + orderTargetInProperty("cleaning_orders", 2)
+
+	var count int
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, before, propertyScope(ctx)).Scan(&count)
+	return count, err
+}
+
+// GetUnassignedDepartures retrieves the not done, not cancelled departure
+// cleanings without any cleaner whose room has an arrival in
+// [arrivalFrom, arrivalTo)
+func (r *cleaningOrderRepository) GetUnassignedDepartures(ctx context.Context, arrivalFrom, arrivalTo time.Time) ([]models.CleaningOrder, error) {
+	query := `SELECT ` + cleaningOrderColumns + `
+		FROM ` + cleaningOrderTables + `
+		WHERE cleaning_orders.cleaning_type = 'general'
+		AND following.check_in_ts >= $1 AND following.check_in_ts < $2
+		AND cleaning_orders.done IS NOT TRUE
+		AND cleaning_orders.cancelled_at IS NULL
+		AND bookings.deleted_at IS NULL
+		AND NOT EXISTS (
+			SELECT 1 FROM cleaner_orders WHERE cleaner_orders.order_id = cleaning_orders.id
+		)
+		AND ` + orderTargetInProperty("cleaning_orders", 3) + `
+		ORDER BY following.check_in_ts`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, arrivalFrom, arrivalTo, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orders := []models.CleaningOrder{}
+	for rows.Next() {
+		var order models.CleaningOrder
+		if err := scanCleaningOrder(rows, &order); err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+// SetPriorityOverride sets the manual priority of an order, nil clears it
+func (r *cleaningOrderRepository) SetPriorityOverride(ctx context.Context, id int, priority *models.CleaningOrderPriority) error {
+	query := `
+		UPDATE cleaning_orders
+		SET priority_override = $1, version = version + 1, updated_at = NOW()
+		WHERE id = $2 AND ` + orderTargetInProperty("cleaning_orders", 3)
 
-	result, err := r.db.ExecContext(ctx, query, orderID, cleanerID)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, priority, id, propertyScope(ctx))
 	if err != nil {
 		return err
 	}
@@ -286,3 +629,151 @@ func (r *cleaningOrderRepository) RemoveCleaner(ctx context.Context, orderID, cl
 
 	return nil
 }
+
+// GetPendingByBooking retrieves the not done, not cancelled orders of a
+// booking of the given type scheduled after the given time
+func (r *cleaningOrderRepository) GetPendingByBooking(ctx context.Context, bookingID int, after time.Time, cleaningType string) ([]models.CleaningOrder, error) {
+	query := `SELECT ` + cleaningOrderColumns + `
+		FROM ` + cleaningOrderTables + `
+		WHERE cleaning_orders.booking_id = $1 AND cleaning_orders.cleaning_ts > $2 AND cleaning_orders.cleaning_type = $3
+		AND cleaning_orders.done IS NOT TRUE AND cleaning_orders.cancelled_at IS NULL
+		AND ` + orderTargetInProperty("cleaning_orders", 4) + `
+		ORDER BY cleaning_orders.cleaning_ts`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, bookingID, after, cleaningType, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	orders := []models.CleaningOrder{}
+	for rows.Next() {
+		var order models.CleaningOrder
+		if err := scanCleaningOrder(rows, &order); err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+// Cancel marks a not done order as cancelled with the given reason if its
+// version matches
+func (r *cleaningOrderRepository) Cancel(ctx context.Context, order *models.CleaningOrder, reason string) error {
+	query := `
+		UPDATE cleaning_orders
+		SET cancelled_at = NOW(), cancel_reason = $1, version = version + 1, updated_at = NOW()
+		WHERE id = $2 AND version = $3 AND done IS NOT TRUE AND cancelled_at IS NULL
+		AND ` + orderTargetInProperty("cleaning_orders", 4) + `
+		RETURNING cancelled_at, cancel_reason, version, updated_at`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, reason, order.Id, order.Version, propertyScope(ctx)).Scan(
+		&order.CancelledAt,
+		&order.CancelReason,
+		&order.Version,
+		&order.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "cleaning_orders", order.Id)
+	}
+
+	return err
+}
+
+// Board counts the cleaning orders in [from, to) per zone, or per floor if
+// byZone is not set. Orders of rooms outside any zone are counted in a row
+// without location, orders of a zone in the row of the zone.
+func (r *cleaningOrderRepository) Board(ctx context.Context, from, to time.Time, byZone bool) ([]models.BoardRow, error) {
+	query := `
+		SELECT floors.building_id, floors.id, floors.level,
+		CASE WHEN $3 THEN zones.id END,
+		CASE WHEN $3 THEN zones.name ELSE floors.name END,
+		COUNT(*),
+		COUNT(*) FILTER (WHERE cleaning_orders.done IS TRUE),
+		COUNT(*) FILTER (WHERE cleaning_orders.done IS NOT TRUE AND NOT EXISTS (
+			SELECT 1 FROM cleaner_orders WHERE cleaner_orders.order_id = cleaning_orders.id)),
+		COALESCE(SUM(cleaning_orders.cost), 0)
+		FROM cleaning_orders
+		LEFT JOIN bookings ON bookings.id = cleaning_orders.booking_id
+		LEFT JOIN rooms ON rooms.id = COALESCE(cleaning_orders.room_id, bookings.room_id)
+		LEFT JOIN zones ON zones.id = COALESCE(rooms.zone_id, cleaning_orders.zone_id)
+		LEFT JOIN floors ON floors.id = zones.floor_id
+		WHERE cleaning_orders.cleaning_ts >= $1 AND cleaning_orders.cleaning_ts < $2
+		AND cleaning_orders.cancelled_at IS NULL
+		AND bookings.deleted_at IS NULL
+		AND ` + orderTargetInProperty("cleaning_orders", 4) + `
+		GROUP BY 1, 2, 3, 4, 5
+		ORDER BY 1, 3, 5`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, from, to, byZone, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	board := []models.BoardRow{}
+	for rows.Next() {
+		var row models.BoardRow
+		err := rows.Scan(
+			&row.BuildingId,
+			&row.FloorId,
+			&row.Level,
+			&row.ZoneId,
+			&row.Name,
+			&row.Orders,
+			&row.Done,
+			&row.Unassigned,
+			&row.Cost,
+		)
+		if err != nil {
+			return nil, err
+		}
+		board = append(board, row)
+	}
+
+	return board, nil
+}
+
+// CreateFromRule inserts the order of a recurrence rule at cleaningTs. It
+// returns sql.ErrNoRows if the rule already has an order at that time.
+func (r *cleaningOrderRepository) CreateFromRule(ctx context.Context, rule *models.RecurrenceRule, cleaningTs time.Time, cost int) (int, error) {
+	query := `
+		INSERT INTO cleaning_orders (room_id, zone_id, rule_id, cleaning_ts, cleaning_type, cost, notes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (rule_id, cleaning_ts) DO NOTHING
+		RETURNING id`
+
+	var id int
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		rule.RoomId,
+		rule.ZoneId,
+		rule.Id,
+		cleaningTs,
+		rule.CleaningType,
+		cost,
+		rule.Notes,
+	).Scan(&id)
+	return id, err
+}
+
+// DeletePendingByRule removes the orders of a recurrence rule scheduled
+// after the given time that are neither done, cancelled nor assigned to a
+// cleaner
+func (r *cleaningOrderRepository) DeletePendingByRule(ctx context.Context, ruleID int, after time.Time) (int64, error) {
+	query := `
+		DELETE FROM cleaning_orders
+		WHERE rule_id = $1 AND cleaning_ts > $2
+		AND done IS NOT TRUE AND cancelled_at IS NULL
+		AND NOT EXISTS (
+			SELECT 1 FROM cleaner_orders WHERE cleaner_orders.order_id = cleaning_orders.id
+		)
+		AND ` + orderTargetInProperty("cleaning_orders", 3)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, ruleID, after, propertyScope(ctx))
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
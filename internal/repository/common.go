@@ -1,10 +1,31 @@
 package repository
 
 import (
+	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 )
 
+// ErrVersionConflict is returned when updating a row whose version differs
+// from the expected one, i.e. it has been modified concurrently
+var ErrVersionConflict = errors.New("version conflict")
+
+// updateError is called when an optimistic update matched no row. It returns
+// sql.ErrNoRows if the row does not exist and ErrVersionConflict otherwise.
+func updateError(ctx context.Context, db DBTX, table string, id int) error {
+	var exists bool
+	query := fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE id = $1)`, table)
+	if err := conn(ctx, db).QueryRowContext(ctx, query, id).Scan(&exists); err != nil {
+		return err
+	}
+	if !exists {
+		return sql.ErrNoRows
+	}
+	return ErrVersionConflict
+}
+
 func generatePlaceholders(chunkSize, totalNumbers int) string {
 	if chunkSize <= 0 || totalNumbers <= 0 {
 		return ""
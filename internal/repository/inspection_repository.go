@@ -0,0 +1,288 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// InspectionRepository defines the interface for inspection data operations
+type InspectionRepository interface {
+	CreateItem(ctx context.Context, item *models.InspectionItem) error
+	GetItems(ctx context.Context, activeOnly bool) ([]models.InspectionItem, error)
+	DeactivateItem(ctx context.Context, id int) error
+	Create(ctx context.Context, inspection *models.Inspection) error
+	GetByID(ctx context.Context, id int) (*models.Inspection, error)
+	GetByOrderID(ctx context.Context, orderID int) ([]models.Inspection, error)
+	CleanerQuality(ctx context.Context, from, to *time.Time) ([]models.CleanerQuality, error)
+}
+
+// inspectionRepository implements InspectionRepository
+type inspectionRepository struct {
+	db DBTX
+}
+
+// NewInspectionRepository creates a new inspection repository
+func NewInspectionRepository(db DBTX) InspectionRepository {
+	return &inspectionRepository{db: db}
+}
+
+// CreateItem inserts a new checklist item into the database
+func (r *inspectionRepository) CreateItem(ctx context.Context, item *models.InspectionItem) error {
+	query := `
+		INSERT INTO inspection_items (name, description, weight, property_id)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, active, property_id`
+
+	propertyID, err := requireProperty(ctx)
+	if err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
+		item.Name,
+		item.Description,
+		item.Weight,
+		propertyID,
+	).Scan(&item.Id, &item.Active, &item.PropertyId)
+}
+
+// GetItems retrieves the checklist items, optionally only the active ones
+func (r *inspectionRepository) GetItems(ctx context.Context, activeOnly bool) ([]models.InspectionItem, error) {
+	query := `
+		SELECT id, name, description, weight, active, property_id
+		FROM inspection_items
+		WHERE (NOT $1 OR active) AND ` + inProperty("property_id", 2) + `
+		ORDER BY id`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, activeOnly, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []models.InspectionItem
+	for rows.Next() {
+		var item models.InspectionItem
+		err := rows.Scan(
+			&item.Id,
+			&item.Name,
+			&item.Description,
+			&item.Weight,
+			&item.Active,
+			&item.PropertyId,
+		)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// DeactivateItem removes a checklist item from future inspections
+func (r *inspectionRepository) DeactivateItem(ctx context.Context, id int) error {
+	query := `UPDATE inspection_items SET active = FALSE WHERE id = $1 AND active AND ` + inProperty("property_id", 2)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Create inserts an inspection with its results
+func (r *inspectionRepository) Create(ctx context.Context, inspection *models.Inspection) error {
+	query := `
+		INSERT INTO inspections (order_id, inspector, comments, passed, score, action, reclean_order_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		inspection.OrderId,
+		inspection.Inspector,
+		inspection.Comments,
+		inspection.Passed,
+		inspection.Score,
+		inspection.Action,
+		inspection.RecleanOrderId,
+	).Scan(&inspection.Id, &inspection.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	query = `
+		INSERT INTO inspection_results (inspection_id, item_id, passed, comment)
+		VALUES ($1, $2, $3, $4)`
+
+	for _, result := range inspection.Results {
+		_, err := conn(ctx, r.db).ExecContext(ctx, query,
+			inspection.Id,
+			result.ItemId,
+			result.Passed,
+			result.Comment,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetByID retrieves an inspection with its results
+func (r *inspectionRepository) GetByID(ctx context.Context, id int) (*models.Inspection, error) {
+	inspections, err := r.get(ctx, "id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(inspections) == 0 {
+		return nil, sql.ErrNoRows
+	}
+
+	return &inspections[0], nil
+}
+
+// GetByOrderID retrieves the inspections of a cleaning order
+func (r *inspectionRepository) GetByOrderID(ctx context.Context, orderID int) ([]models.Inspection, error) {
+	return r.get(ctx, "order_id = $1", orderID)
+}
+
+func (r *inspectionRepository) get(ctx context.Context, where string, arg any) ([]models.Inspection, error) {
+	query := `
+		SELECT id, order_id, inspector, comments, passed, score, action, reclean_order_id, created_at
+		FROM inspections
+		WHERE ` + where + ` AND ` + orderInProperty("order_id", 2) + `
+		ORDER BY created_at`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, arg, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var inspections []models.Inspection
+	for rows.Next() {
+		var inspection models.Inspection
+		err := rows.Scan(
+			&inspection.Id,
+			&inspection.OrderId,
+			&inspection.Inspector,
+			&inspection.Comments,
+			&inspection.Passed,
+			&inspection.Score,
+			&inspection.Action,
+			&inspection.RecleanOrderId,
+			&inspection.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		inspection.Results = []models.InspectionResult{}
+		inspections = append(inspections, inspection)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for i := range inspections {
+		results, err := r.getResults(ctx, inspections[i].Id)
+		if err != nil {
+			return nil, err
+		}
+		inspections[i].Results = results
+	}
+
+	return inspections, nil
+}
+
+func (r *inspectionRepository) getResults(ctx context.Context, inspectionID int) ([]models.InspectionResult, error) {
+	query := `
+		SELECT inspection_results.item_id, inspection_items.name,
+		inspection_results.passed, inspection_results.comment
+		FROM inspection_results
+		JOIN inspection_items ON inspection_items.id = inspection_results.item_id
+		WHERE inspection_results.inspection_id = $1
+		ORDER BY inspection_results.item_id`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, inspectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := []models.InspectionResult{}
+	for rows.Next() {
+		var result models.InspectionResult
+		err := rows.Scan(
+			&result.ItemId,
+			&result.ItemName,
+			&result.Passed,
+			&result.Comment,
+		)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// CleanerQuality aggregates the inspections of the orders of every cleaner
+// made in [from, to), a nil bound is open
+func (r *inspectionRepository) CleanerQuality(ctx context.Context, from, to *time.Time) ([]models.CleanerQuality, error) {
+	query := `
+		SELECT cleaners.id, cleaners.name, cleaners.surname,
+		COUNT(*),
+		COUNT(*) FILTER (WHERE inspections.passed),
+		COUNT(*) FILTER (WHERE NOT inspections.passed),
+		AVG(inspections.score)
+		FROM inspections
+		JOIN cleaner_orders ON cleaner_orders.order_id = inspections.order_id
+		JOIN cleaners ON cleaners.id = cleaner_orders.cleaner_id
+		WHERE ($1::timestamp IS NULL OR inspections.created_at >= $1)
+		AND ($2::timestamp IS NULL OR inspections.created_at < $2)
+		AND ` + inProperty("cleaners.property_id", 3) + `
+		GROUP BY cleaners.id, cleaners.name, cleaners.surname
+		ORDER BY cleaners.id`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, from, to, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var report []models.CleanerQuality
+	for rows.Next() {
+		var row models.CleanerQuality
+		err := rows.Scan(
+			&row.CleanerId,
+			&row.Name,
+			&row.Surname,
+			&row.Inspections,
+			&row.Passed,
+			&row.Failed,
+			&row.AverageScore,
+		)
+		if err != nil {
+			return nil, err
+		}
+		report = append(report, row)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// SQLConn is the subset of *sql.DB and *sql.Tx used by repositories
+type SQLConn interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// DBTX is the connection repositories run their queries on, an instrumented
+// *sql.DB or *sql.Tx
+type DBTX interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// Rows is the subset of *sql.Rows used by repositories
+type Rows interface {
+	Next() bool
+	Scan(dest ...any) error
+	Err() error
+	Close() error
+}
+
+// QueryHook is called before a repository query is executed. method is the
+// repository method running the query, e.g. "bookingRepository.GetByID".
+// The returned function is called with the query error once it has finished,
+// for a query returning rows when the rows are closed.
+type QueryHook func(ctx context.Context, method, query string) (context.Context, func(err error))
+
+// instrumentedDB runs every query through a list of hooks. Time arguments
+// are passed in UTC, see utcArgs.
+type instrumentedDB struct {
+	db    SQLConn
+	hooks []QueryHook
+}
+
+// Instrument wraps db so that every query runs through the given hooks
+func Instrument(db SQLConn, hooks ...QueryHook) DBTX {
+	return &instrumentedDB{db: db, hooks: hooks}
+}
+
+// ExecContext executes a query without returning any rows
+func (i *instrumentedDB) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	ctx, done := i.start(ctx, query)
+	result, err := i.db.ExecContext(ctx, query, utcArgs(args)...)
+	done(err)
+	return result, err
+}
+
+// QueryContext executes a query that returns rows. The hooks end when the
+// rows are closed, so that reading them counts towards the query.
+func (i *instrumentedDB) QueryContext(ctx context.Context, query string, args ...any) (Rows, error) {
+	ctx, done := i.start(ctx, query)
+	rows, err := i.db.QueryContext(ctx, query, utcArgs(args)...)
+	if err != nil {
+		done(err)
+		return nil, err
+	}
+	return &instrumentedRows{Rows: rows, done: done}, nil
+}
+
+// QueryRowContext executes a query that is expected to return at most one row
+func (i *instrumentedDB) QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row {
+	ctx, done := i.start(ctx, query)
+	row := i.db.QueryRowContext(ctx, query, utcArgs(args)...)
+	done(row.Err())
+	return row
+}
+
+// utcArgs returns the arguments with times converted to UTC. The TIMESTAMP
+// columns have no time zone and hold UTC: Postgres drops the offset lib/pq
+// sends, so a time in the hotel zone would be stored as its local clock time.
+func utcArgs(args []any) []any {
+	converted := make([]any, len(args))
+	for i, arg := range args {
+		switch t := arg.(type) {
+		case time.Time:
+			converted[i] = t.UTC()
+		case *time.Time:
+			if t != nil {
+				utc := t.UTC()
+				converted[i] = &utc
+			}
+		default:
+			converted[i] = arg
+		}
+	}
+	return converted
+}
+
+// instrumentedRows ends the hooks of its query once it is closed
+type instrumentedRows struct {
+	*sql.Rows
+	done   func(err error)
+	closed bool
+}
+
+// Close closes the rows and ends the hooks with the error met reading them
+func (r *instrumentedRows) Close() error {
+	err := r.Rows.Close()
+	if !r.closed {
+		r.closed = true
+		if rowsErr := r.Rows.Err(); rowsErr != nil {
+			r.done(rowsErr)
+		} else {
+			r.done(err)
+		}
+	}
+	return err
+}
+
+// start calls every hook and returns a function ending them in reverse order
+func (i *instrumentedDB) start(ctx context.Context, query string) (context.Context, func(err error)) {
+	method := callerMethod()
+	ends := make([]func(err error), 0, len(i.hooks))
+	for _, hook := range i.hooks {
+		var end func(err error)
+		ctx, end = hook(ctx, method, query)
+		ends = append(ends, end)
+	}
+
+	return ctx, func(err error) {
+		for j := len(ends) - 1; j >= 0; j-- {
+			ends[j](err)
+		}
+	}
+}
+
+// callerMethod returns the name of the repository method that issued the
+// query, e.g. "bookingRepository.GetByID"
+func callerMethod() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		// e.g. github.com/StEvseeva/cleany/internal/repository.(*bookingRepository).GetByID
+		if idx := strings.Index(frame.Function, "/repository.(*"); idx >= 0 &&
+			!strings.Contains(frame.Function, "(*instrumentedDB)") {
+			name := frame.Function[idx+len("/repository.(*"):]
+			name = strings.Replace(name, ")", "", 1)
+			// drop closure suffixes such as ".func1"
+			if parts := strings.SplitN(name, ".", 3); len(parts) >= 2 {
+				return parts[0] + "." + parts[1]
+			}
+			return name
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
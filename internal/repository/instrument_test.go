@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+)
+
+// captureDriver is a database driver recording the arguments of the last
+// statement executed
+type captureDriver struct {
+	args []driver.NamedValue
+}
+
+func (d *captureDriver) Open(string) (driver.Conn, error) { return &captureConn{driver: d}, nil }
+
+type captureConn struct {
+	driver *captureDriver
+}
+
+func (c *captureConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c *captureConn) Close() error                        { return nil }
+func (c *captureConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (c *captureConn) ExecContext(_ context.Context, _ string, args []driver.NamedValue) (driver.Result, error) {
+	c.driver.args = args
+	return driver.RowsAffected(1), nil
+}
+
+var capture = &captureDriver{}
+
+func init() {
+	sql.Register("cleany-capture", capture)
+}
+
+func TestInstrumentPassesTimesInUTC(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone data not available: %v", err)
+	}
+
+	db, err := sql.Open("cleany-capture", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	// 11:00 in Berlin is 10:00 UTC in winter and 09:00 UTC in summer
+	winter := time.Date(2025, time.January, 10, 11, 0, 0, 0, berlin)
+	summer := time.Date(2025, time.July, 10, 11, 0, 0, 0, berlin)
+	var missing *time.Time
+	if _, err := Instrument(db).ExecContext(context.Background(), "UPDATE", winter, &summer, missing, 42); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []any{
+		time.Date(2025, time.January, 10, 10, 0, 0, 0, time.UTC),
+		time.Date(2025, time.July, 10, 9, 0, 0, 0, time.UTC),
+		nil,
+		int64(42),
+	}
+	if len(capture.args) != len(want) {
+		t.Fatalf("got %d arguments, want %d", len(capture.args), len(want))
+	}
+	for i, arg := range capture.args {
+		if wantTime, ok := want[i].(time.Time); ok {
+			got, ok := arg.Value.(time.Time)
+			if !ok || got.Location() != time.UTC || !got.Equal(wantTime) {
+				t.Errorf("argument %d = %v, want %v", i, arg.Value, wantTime)
+			}
+			continue
+		}
+		if arg.Value != want[i] {
+			t.Errorf("argument %d = %v, want %v", i, arg.Value, want[i])
+		}
+	}
+}
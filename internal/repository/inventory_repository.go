@@ -0,0 +1,442 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// StockMovementFilter selects stock movements, nil fields match everything
+type StockMovementFilter struct {
+	ItemID   *int
+	Location *string
+	OrderID  *int
+	From     *time.Time
+	To       *time.Time
+}
+
+// InventoryRepository defines the interface for linen and consumables data operations
+type InventoryRepository interface {
+	CreateItem(ctx context.Context, item *models.InventoryItem) error
+	GetItemByID(ctx context.Context, id int) (*models.InventoryItem, error)
+	GetItems(ctx context.Context, activeOnly bool) ([]models.InventoryItem, error)
+	UpdateItem(ctx context.Context, item *models.InventoryItem) error
+	GetStock(ctx context.Context, itemID *int, location *string) ([]models.StockLevel, error)
+	GetTotalStock(ctx context.Context, itemID int) (int, error)
+	GetLowStock(ctx context.Context) ([]models.LowStockItem, error)
+	AddMovement(ctx context.Context, movement *models.StockMovement) error
+	GetMovements(ctx context.Context, filter StockMovementFilter) ([]models.StockMovement, error)
+	CountOrderConsumption(ctx context.Context, orderID int) (int, error)
+	SetDefault(ctx context.Context, def *models.ConsumptionDefault) error
+	GetDefaults(ctx context.Context, cleaningType *string) ([]models.ConsumptionDefault, error)
+	DeleteDefault(ctx context.Context, id int) error
+	ConsumptionReport(ctx context.Context, from, to *time.Time, cleaningType *string) ([]models.ConsumptionReportRow, error)
+}
+
+// inventoryRepository implements InventoryRepository
+type inventoryRepository struct {
+	db DBTX
+}
+
+// NewInventoryRepository creates a new inventory repository
+func NewInventoryRepository(db DBTX) InventoryRepository {
+	return &inventoryRepository{db: db}
+}
+
+const inventoryItemColumns = `id, name, unit, min_stock, default_location, active, property_id, version, updated_at`
+
+// scanInventoryItem scans a row of inventoryItemColumns
+func scanInventoryItem(row interface{ Scan(...any) error }, item *models.InventoryItem) error {
+	return row.Scan(
+		&item.Id,
+		&item.Name,
+		&item.Unit,
+		&item.MinStock,
+		&item.DefaultLocation,
+		&item.Active,
+		&item.PropertyId,
+		&item.Version,
+		&item.UpdatedAt,
+	)
+}
+
+// CreateItem inserts a new item into the database
+func (r *inventoryRepository) CreateItem(ctx context.Context, item *models.InventoryItem) error {
+	query := `
+		INSERT INTO inventory_items (name, unit, min_stock, default_location, active, property_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, property_id, version, updated_at`
+
+	propertyID, err := requireProperty(ctx)
+	if err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
+		item.Name,
+		item.Unit,
+		item.MinStock,
+		item.DefaultLocation,
+		item.Active,
+		propertyID,
+	).Scan(&item.Id, &item.PropertyId, &item.Version, &item.UpdatedAt)
+}
+
+// GetItemByID retrieves an item by its ID
+func (r *inventoryRepository) GetItemByID(ctx context.Context, id int) (*models.InventoryItem, error) {
+	query := `SELECT ` + inventoryItemColumns + `
+		FROM inventory_items
+		WHERE id = $1 AND ` + inProperty("property_id", 2)
+
+	item := &models.InventoryItem{}
+	if err := scanInventoryItem(conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)), item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// GetItems retrieves all items, optionally only those in use
+func (r *inventoryRepository) GetItems(ctx context.Context, activeOnly bool) ([]models.InventoryItem, error) {
+	query := `SELECT ` + inventoryItemColumns + `
+		FROM inventory_items
+		WHERE (active OR NOT $1) AND ` + inProperty("property_id", 2) + `
+		ORDER BY name`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, activeOnly, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []models.InventoryItem
+	for rows.Next() {
+		var item models.InventoryItem
+		if err := scanInventoryItem(rows, &item); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// UpdateItem updates an item if its version matches
+func (r *inventoryRepository) UpdateItem(ctx context.Context, item *models.InventoryItem) error {
+	query := `
+		UPDATE inventory_items
+		SET name = $1, unit = $2, min_stock = $3, default_location = $4, active = $5,
+		version = version + 1, updated_at = NOW()
+		WHERE id = $6 AND version = $7 AND ` + inProperty("property_id", 8) + `
+		RETURNING version, updated_at`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		item.Name,
+		item.Unit,
+		item.MinStock,
+		item.DefaultLocation,
+		item.Active,
+		item.Id,
+		item.Version,
+		propertyScope(ctx),
+	).Scan(&item.Version, &item.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "inventory_items", item.Id)
+	}
+
+	return err
+}
+
+// GetStock retrieves stock levels, optionally of one item and location
+func (r *inventoryRepository) GetStock(ctx context.Context, itemID *int, location *string) ([]models.StockLevel, error) {
+	query := `
+		SELECT item_id, location, quantity, updated_at
+		FROM inventory_stock
+		WHERE ($1::integer IS NULL OR item_id = $1)
+		AND ($2::varchar IS NULL OR location = $2)
+		AND ` + itemInProperty("item_id", 3) + `
+		ORDER BY item_id, location`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, itemID, location, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var levels []models.StockLevel
+	for rows.Next() {
+		var level models.StockLevel
+		err := rows.Scan(
+			&level.ItemId,
+			&level.Location,
+			&level.Quantity,
+			&level.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		levels = append(levels, level)
+	}
+
+	return levels, nil
+}
+
+// GetTotalStock sums the stock of an item in all locations
+func (r *inventoryRepository) GetTotalStock(ctx context.Context, itemID int) (int, error) {
+	query := `SELECT COALESCE(SUM(quantity), 0) FROM inventory_stock
+		WHERE item_id = $1 AND ` + itemInProperty("item_id", 2)
+
+	var total int
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, itemID, propertyScope(ctx)).Scan(&total)
+	return total, err
+}
+
+// GetLowStock retrieves the active items whose total stock is below their minimum
+func (r *inventoryRepository) GetLowStock(ctx context.Context) ([]models.LowStockItem, error) {
+	query := `
+		SELECT inventory_items.id, inventory_items.name, inventory_items.unit, inventory_items.min_stock,
+		COALESCE(SUM(inventory_stock.quantity), 0) AS total
+		FROM inventory_items
+		LEFT JOIN inventory_stock ON inventory_stock.item_id = inventory_items.id
+		WHERE inventory_items.active AND ` + inProperty("inventory_items.property_id", 1) + `
+		GROUP BY inventory_items.id, inventory_items.name, inventory_items.unit, inventory_items.min_stock
+		HAVING COALESCE(SUM(inventory_stock.quantity), 0) < inventory_items.min_stock
+		ORDER BY inventory_items.name`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []models.LowStockItem
+	for rows.Next() {
+		var item models.LowStockItem
+		err := rows.Scan(
+			&item.ItemId,
+			&item.Name,
+			&item.Unit,
+			&item.MinStock,
+			&item.Quantity,
+		)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// AddMovement records a movement and adds its quantity to the stock of the
+// location. Call it within a transaction to keep both in step.
+func (r *inventoryRepository) AddMovement(ctx context.Context, movement *models.StockMovement) error {
+	query := `
+		INSERT INTO inventory_movements (item_id, location, quantity, kind, order_id, note)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		movement.ItemId,
+		movement.Location,
+		movement.Quantity,
+		movement.Kind,
+		movement.OrderId,
+		movement.Note,
+	).Scan(&movement.Id, &movement.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	query = `
+		INSERT INTO inventory_stock (item_id, location, quantity)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (item_id, location) DO UPDATE
+		SET quantity = inventory_stock.quantity + EXCLUDED.quantity, updated_at = NOW()`
+
+	_, err = conn(ctx, r.db).ExecContext(ctx, query, movement.ItemId, movement.Location, movement.Quantity)
+	return err
+}
+
+// GetMovements retrieves the movements matching a filter, newest first
+func (r *inventoryRepository) GetMovements(ctx context.Context, filter StockMovementFilter) ([]models.StockMovement, error) {
+	query := `
+		SELECT id, item_id, location, quantity, kind, order_id, note, created_at
+		FROM inventory_movements
+		WHERE ($1::integer IS NULL OR item_id = $1)
+		AND ($2::varchar IS NULL OR location = $2)
+		AND ($3::integer IS NULL OR order_id = $3)
+		AND ($4::timestamp IS NULL OR created_at >= $4)
+		AND ($5::timestamp IS NULL OR created_at < $5)
+		AND ` + itemInProperty("item_id", 6) + `
+		ORDER BY created_at DESC, id DESC`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query,
+		filter.ItemID,
+		filter.Location,
+		filter.OrderID,
+		filter.From,
+		filter.To,
+		propertyScope(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var movements []models.StockMovement
+	for rows.Next() {
+		var movement models.StockMovement
+		err := rows.Scan(
+			&movement.Id,
+			&movement.ItemId,
+			&movement.Location,
+			&movement.Quantity,
+			&movement.Kind,
+			&movement.OrderId,
+			&movement.Note,
+			&movement.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		movements = append(movements, movement)
+	}
+
+	return movements, nil
+}
+
+// CountOrderConsumption counts the consumptions recorded for a cleaning order
+func (r *inventoryRepository) CountOrderConsumption(ctx context.Context, orderID int) (int, error) {
+	query := `SELECT COUNT(*) FROM inventory_movements WHERE order_id = $1 AND kind = 'consumption'`
+
+	var count int
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, orderID).Scan(&count)
+	return count, err
+}
+
+// SetDefault inserts the default consumption of an item by a cleaning type
+// of the property, replacing the quantity if there is one
+func (r *inventoryRepository) SetDefault(ctx context.Context, def *models.ConsumptionDefault) error {
+	query := `
+		INSERT INTO consumption_defaults (cleaning_type, item_id, quantity, property_id)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (property_id, cleaning_type, item_id) DO UPDATE
+		SET quantity = EXCLUDED.quantity
+		RETURNING id`
+
+	propertyID, err := requireProperty(ctx)
+	if err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
+		def.CleaningType,
+		def.ItemId,
+		def.Quantity,
+		propertyID,
+	).Scan(&def.Id)
+}
+
+// GetDefaults retrieves the default consumption, optionally of one cleaning
+// type, leaving out inactive items
+func (r *inventoryRepository) GetDefaults(ctx context.Context, cleaningType *string) ([]models.ConsumptionDefault, error) {
+	query := `
+		SELECT consumption_defaults.id, consumption_defaults.cleaning_type,
+		consumption_defaults.item_id, consumption_defaults.quantity
+		FROM consumption_defaults
+		JOIN inventory_items ON inventory_items.id = consumption_defaults.item_id
+		WHERE inventory_items.active
+		AND ($1::varchar IS NULL OR consumption_defaults.cleaning_type = $1)
+		AND ` + inProperty("consumption_defaults.property_id", 2) + `
+		ORDER BY consumption_defaults.cleaning_type, inventory_items.name`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, cleaningType, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var defs []models.ConsumptionDefault
+	for rows.Next() {
+		var def models.ConsumptionDefault
+		err := rows.Scan(
+			&def.Id,
+			&def.CleaningType,
+			&def.ItemId,
+			&def.Quantity,
+		)
+		if err != nil {
+			return nil, err
+		}
+		defs = append(defs, def)
+	}
+
+	return defs, nil
+}
+
+// DeleteDefault removes a default consumption
+func (r *inventoryRepository) DeleteDefault(ctx context.Context, id int) error {
+	query := `DELETE FROM consumption_defaults WHERE id = $1 AND ` + inProperty("property_id", 2)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// ConsumptionReport sums the consumptions per item made in [from, to),
+// optionally only those of cleaning orders of one type
+func (r *inventoryRepository) ConsumptionReport(ctx context.Context, from, to *time.Time, cleaningType *string) ([]models.ConsumptionReportRow, error) {
+	query := `
+		SELECT inventory_items.id, inventory_items.name, inventory_items.unit,
+		-SUM(inventory_movements.quantity),
+		COUNT(DISTINCT inventory_movements.order_id)
+		FROM inventory_movements
+		JOIN inventory_items ON inventory_items.id = inventory_movements.item_id
+		LEFT JOIN cleaning_orders ON cleaning_orders.id = inventory_movements.order_id
+		WHERE inventory_movements.kind = 'consumption'
+		AND ($1::timestamp IS NULL OR inventory_movements.created_at >= $1)
+		AND ($2::timestamp IS NULL OR inventory_movements.created_at < $2)
+		AND ($3::varchar IS NULL OR cleaning_orders.cleaning_type = $3)
+		AND ` + orderInProperty("inventory_movements.order_id", 4) + `
+		GROUP BY inventory_items.id, inventory_items.name, inventory_items.unit
+		ORDER BY inventory_items.name`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, from, to, cleaningType, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var report []models.ConsumptionReportRow
+	for rows.Next() {
+		var row models.ConsumptionReportRow
+		err := rows.Scan(
+			&row.ItemId,
+			&row.Name,
+			&row.Unit,
+			&row.Quantity,
+			&row.Orders,
+		)
+		if err != nil {
+			return nil, err
+		}
+		report = append(report, row)
+	}
+
+	return report, nil
+}
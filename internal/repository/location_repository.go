@@ -0,0 +1,397 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// LocationRepository defines the interface for building, floor and zone data operations
+type LocationRepository interface {
+	CreateBuilding(ctx context.Context, building *models.Building) error
+	GetBuildingByID(ctx context.Context, id int) (*models.Building, error)
+	GetBuildings(ctx context.Context) ([]models.Building, error)
+	UpdateBuilding(ctx context.Context, building *models.Building) error
+	DeleteBuilding(ctx context.Context, id int) error
+	CreateFloor(ctx context.Context, floor *models.Floor) error
+	GetFloorByID(ctx context.Context, id int) (*models.Floor, error)
+	GetFloors(ctx context.Context, buildingID *int) ([]models.Floor, error)
+	UpdateFloor(ctx context.Context, floor *models.Floor) error
+	DeleteFloor(ctx context.Context, id int) error
+	CreateZone(ctx context.Context, zone *models.Zone) error
+	GetZoneByID(ctx context.Context, id int) (*models.Zone, error)
+	GetZones(ctx context.Context, floorID *int) ([]models.Zone, error)
+	UpdateZone(ctx context.Context, zone *models.Zone) error
+	DeleteZone(ctx context.Context, id int) error
+	CountZoneRooms(ctx context.Context, zoneID int) (int, error)
+	SyncRoomFloors(ctx context.Context, floorID int) (int64, error)
+}
+
+// locationRepository implements LocationRepository
+type locationRepository struct {
+	db DBTX
+}
+
+// NewLocationRepository creates a new location repository
+func NewLocationRepository(db DBTX) LocationRepository {
+	return &locationRepository{db: db}
+}
+
+const buildingColumns = `id, property_id, name, version, updated_at`
+
+// scanBuilding scans a row of buildingColumns
+func scanBuilding(row interface{ Scan(...any) error }, building *models.Building) error {
+	return row.Scan(
+		&building.Id,
+		&building.PropertyId,
+		&building.Name,
+		&building.Version,
+		&building.UpdatedAt,
+	)
+}
+
+const floorColumns = `id, building_id, level, name, version, updated_at`
+
+// scanFloor scans a row of floorColumns
+func scanFloor(row interface{ Scan(...any) error }, floor *models.Floor) error {
+	return row.Scan(
+		&floor.Id,
+		&floor.BuildingId,
+		&floor.Level,
+		&floor.Name,
+		&floor.Version,
+		&floor.UpdatedAt,
+	)
+}
+
+const zoneColumns = `id, floor_id, name, version, updated_at`
+
+// scanZone scans a row of zoneColumns
+func scanZone(row interface{ Scan(...any) error }, zone *models.Zone) error {
+	return row.Scan(
+		&zone.Id,
+		&zone.FloorId,
+		&zone.Name,
+		&zone.Version,
+		&zone.UpdatedAt,
+	)
+}
+
+// CreateBuilding inserts a new building into the property of the context
+func (r *locationRepository) CreateBuilding(ctx context.Context, building *models.Building) error {
+	propertyID, err := requireProperty(ctx)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO buildings (property_id, name)
+		VALUES ($1, $2)
+		RETURNING id, property_id, version, updated_at`
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
+		propertyID,
+		building.Name,
+	).Scan(&building.Id, &building.PropertyId, &building.Version, &building.UpdatedAt)
+}
+
+// GetBuildingByID retrieves a building by its ID
+func (r *locationRepository) GetBuildingByID(ctx context.Context, id int) (*models.Building, error) {
+	query := `SELECT ` + buildingColumns + `
+		FROM buildings
+		WHERE id = $1 AND ` + inProperty("property_id", 2)
+
+	building := &models.Building{}
+	if err := scanBuilding(conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)), building); err != nil {
+		return nil, err
+	}
+
+	return building, nil
+}
+
+// GetBuildings retrieves all buildings
+func (r *locationRepository) GetBuildings(ctx context.Context) ([]models.Building, error) {
+	query := `SELECT ` + buildingColumns + `
+		FROM buildings
+		WHERE ` + inProperty("property_id", 1) + `
+		ORDER BY name`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var buildings []models.Building
+	for rows.Next() {
+		var building models.Building
+		if err := scanBuilding(rows, &building); err != nil {
+			return nil, err
+		}
+		buildings = append(buildings, building)
+	}
+
+	return buildings, nil
+}
+
+// UpdateBuilding modifies a building if its version matches
+func (r *locationRepository) UpdateBuilding(ctx context.Context, building *models.Building) error {
+	query := `
+		UPDATE buildings
+		SET name = $1, version = version + 1, updated_at = NOW()
+		WHERE id = $2 AND version = $3
+		RETURNING version, updated_at`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		building.Name,
+		building.Id,
+		building.Version,
+	).Scan(&building.Version, &building.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "buildings", building.Id)
+	}
+
+	return err
+}
+
+// DeleteBuilding removes a building
+func (r *locationRepository) DeleteBuilding(ctx context.Context, id int) error {
+	query := `DELETE FROM buildings WHERE id = $1 AND ` + inProperty("property_id", 2)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// CreateFloor inserts a new floor into the database
+func (r *locationRepository) CreateFloor(ctx context.Context, floor *models.Floor) error {
+	query := `
+		INSERT INTO floors (building_id, level, name)
+		VALUES ($1, $2, $3)
+		RETURNING id, version, updated_at`
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
+		floor.BuildingId,
+		floor.Level,
+		floor.Name,
+	).Scan(&floor.Id, &floor.Version, &floor.UpdatedAt)
+}
+
+// GetFloorByID retrieves a floor by its ID
+func (r *locationRepository) GetFloorByID(ctx context.Context, id int) (*models.Floor, error) {
+	query := `SELECT ` + floorColumns + `
+		FROM floors
+		WHERE id = $1 AND ` + buildingInProperty("building_id", 2)
+
+	floor := &models.Floor{}
+	if err := scanFloor(conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)), floor); err != nil {
+		return nil, err
+	}
+
+	return floor, nil
+}
+
+// GetFloors retrieves the floors of a building, of all buildings if
+// buildingID is nil
+func (r *locationRepository) GetFloors(ctx context.Context, buildingID *int) ([]models.Floor, error) {
+	query := `SELECT ` + floorColumns + `
+		FROM floors
+		WHERE ($1::int IS NULL OR building_id = $1)
+		AND ` + buildingInProperty("building_id", 2) + `
+		ORDER BY building_id, level`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, buildingID, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var floors []models.Floor
+	for rows.Next() {
+		var floor models.Floor
+		if err := scanFloor(rows, &floor); err != nil {
+			return nil, err
+		}
+		floors = append(floors, floor)
+	}
+
+	return floors, nil
+}
+
+// UpdateFloor modifies a floor if its version matches
+func (r *locationRepository) UpdateFloor(ctx context.Context, floor *models.Floor) error {
+	query := `
+		UPDATE floors
+		SET building_id = $1, level = $2, name = $3, version = version + 1, updated_at = NOW()
+		WHERE id = $4 AND version = $5
+		RETURNING version, updated_at`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		floor.BuildingId,
+		floor.Level,
+		floor.Name,
+		floor.Id,
+		floor.Version,
+	).Scan(&floor.Version, &floor.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "floors", floor.Id)
+	}
+
+	return err
+}
+
+// DeleteFloor removes a floor
+func (r *locationRepository) DeleteFloor(ctx context.Context, id int) error {
+	query := `DELETE FROM floors WHERE id = $1 AND ` + buildingInProperty("building_id", 2)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// CreateZone inserts a new zone into the database
+func (r *locationRepository) CreateZone(ctx context.Context, zone *models.Zone) error {
+	query := `
+		INSERT INTO zones (floor_id, name)
+		VALUES ($1, $2)
+		RETURNING id, version, updated_at`
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
+		zone.FloorId,
+		zone.Name,
+	).Scan(&zone.Id, &zone.Version, &zone.UpdatedAt)
+}
+
+// GetZoneByID retrieves a zone by its ID
+func (r *locationRepository) GetZoneByID(ctx context.Context, id int) (*models.Zone, error) {
+	query := `SELECT ` + zoneColumns + `
+		FROM zones
+		WHERE id = $1 AND ` + floorInProperty("floor_id", 2)
+
+	zone := &models.Zone{}
+	if err := scanZone(conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)), zone); err != nil {
+		return nil, err
+	}
+
+	return zone, nil
+}
+
+// GetZones retrieves the zones of a floor, of all floors if floorID is nil
+func (r *locationRepository) GetZones(ctx context.Context, floorID *int) ([]models.Zone, error) {
+	query := `SELECT ` + zoneColumns + `
+		FROM zones
+		WHERE ($1::int IS NULL OR floor_id = $1)
+		AND ` + floorInProperty("floor_id", 2) + `
+		ORDER BY floor_id, name`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, floorID, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var zones []models.Zone
+	for rows.Next() {
+		var zone models.Zone
+		if err := scanZone(rows, &zone); err != nil {
+			return nil, err
+		}
+		zones = append(zones, zone)
+	}
+
+	return zones, nil
+}
+
+// UpdateZone modifies a zone if its version matches
+func (r *locationRepository) UpdateZone(ctx context.Context, zone *models.Zone) error {
+	query := `
+		UPDATE zones
+		SET floor_id = $1, name = $2, version = version + 1, updated_at = NOW()
+		WHERE id = $3 AND version = $4
+		RETURNING version, updated_at`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		zone.FloorId,
+		zone.Name,
+		zone.Id,
+		zone.Version,
+	).Scan(&zone.Version, &zone.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "zones", zone.Id)
+	}
+
+	return err
+}
+
+// DeleteZone removes a zone
+func (r *locationRepository) DeleteZone(ctx context.Context, id int) error {
+	query := `DELETE FROM zones WHERE id = $1 AND ` + floorInProperty("floor_id", 2)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// CountZoneRooms counts the rooms placed in a zone, deleted ones included
+func (r *locationRepository) CountZoneRooms(ctx context.Context, zoneID int) (int, error) {
+	query := `SELECT COUNT(*) FROM rooms WHERE zone_id = $1`
+
+	var count int
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, zoneID).Scan(&count)
+	return count, err
+}
+
+// SyncRoomFloors sets the floor of the rooms in the zones of a floor to its
+// level and returns the number of rooms changed
+func (r *locationRepository) SyncRoomFloors(ctx context.Context, floorID int) (int64, error) {
+	query := `
+		UPDATE rooms
+		SET floor = floors.level, version = rooms.version + 1, updated_at = NOW()
+		FROM zones
+		JOIN floors ON floors.id = zones.floor_id
+		WHERE rooms.zone_id = zones.id AND floors.id = $1 AND rooms.floor <> floors.level`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, floorID)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
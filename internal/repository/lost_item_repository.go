@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// LostItemFilter selects lost and found items, nil fields match everything
+type LostItemFilter struct {
+	Status    *string
+	RoomID    *int
+	BookingID *int
+	// DueBefore selects stored items due for disposal before this time
+	DueBefore *time.Time
+}
+
+// LostItemRepository defines the interface for lost and found data operations
+type LostItemRepository interface {
+	Create(ctx context.Context, item *models.LostItem) error
+	GetByID(ctx context.Context, id int) (*models.LostItem, error)
+	GetAll(ctx context.Context, filter LostItemFilter) ([]models.LostItem, error)
+	Update(ctx context.Context, item *models.LostItem) error
+}
+
+// lostItemRepository implements LostItemRepository
+type lostItemRepository struct {
+	db DBTX
+}
+
+// NewLostItemRepository creates a new lost and found repository
+func NewLostItemRepository(db DBTX) LostItemRepository {
+	return &lostItemRepository{db: db}
+}
+
+const lostItemColumns = `id, order_id, room_id, booking_id, description, storage_location, status,
+		found_at, found_by, dispose_after, returned_to, notes, closed_at, version, updated_at`
+
+// scanLostItem scans a row of lostItemColumns
+func scanLostItem(row interface{ Scan(...any) error }, item *models.LostItem) error {
+	return row.Scan(
+		&item.Id,
+		&item.OrderId,
+		&item.RoomId,
+		&item.BookingId,
+		&item.Description,
+		&item.StorageLocation,
+		&item.Status,
+		&item.FoundAt,
+		&item.FoundBy,
+		&item.DisposeAfter,
+		&item.ReturnedTo,
+		&item.Notes,
+		&item.ClosedAt,
+		&item.Version,
+		&item.UpdatedAt,
+	)
+}
+
+// Create inserts a new item into the database
+func (r *lostItemRepository) Create(ctx context.Context, item *models.LostItem) error {
+	query := `
+		INSERT INTO lost_items
+		(order_id, room_id, booking_id, description, storage_location, status, found_at, found_by, dispose_after, notes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, version, updated_at`
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
+		item.OrderId,
+		item.RoomId,
+		item.BookingId,
+		item.Description,
+		item.StorageLocation,
+		item.Status,
+		item.FoundAt,
+		item.FoundBy,
+		item.DisposeAfter,
+		item.Notes,
+	).Scan(&item.Id, &item.Version, &item.UpdatedAt)
+}
+
+// GetByID retrieves an item by its ID
+func (r *lostItemRepository) GetByID(ctx context.Context, id int) (*models.LostItem, error) {
+	query := `SELECT ` + lostItemColumns + `
+		FROM lost_items
+		WHERE id = $1 AND ` + roomInProperty("room_id", 2)
+
+	item := &models.LostItem{}
+	if err := scanLostItem(conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)), item); err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// GetAll retrieves the items matching a filter, most recently found first
+func (r *lostItemRepository) GetAll(ctx context.Context, filter LostItemFilter) ([]models.LostItem, error) {
+	query := `SELECT ` + lostItemColumns + `
+		FROM lost_items
+		WHERE ($1::varchar IS NULL OR status = $1)
+		AND ($2::integer IS NULL OR room_id = $2)
+		AND ($3::integer IS NULL OR booking_id = $3)
+		AND ($4::timestamp IS NULL OR (status = 'stored' AND dispose_after <= $4))
+		AND ` + roomInProperty("room_id", 5) + `
+		ORDER BY found_at DESC`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query,
+		filter.Status,
+		filter.RoomID,
+		filter.BookingID,
+		filter.DueBefore,
+		propertyScope(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []models.LostItem
+	for rows.Next() {
+		var item models.LostItem
+		if err := scanLostItem(rows, &item); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// Update updates an item if its version matches
+func (r *lostItemRepository) Update(ctx context.Context, item *models.LostItem) error {
+	query := `
+		UPDATE lost_items
+		SET description = $1, storage_location = $2, status = $3, dispose_after = $4,
+		returned_to = $5, notes = $6, closed_at = $7,
+		version = version + 1, updated_at = NOW()
+		WHERE id = $8 AND version = $9
+		RETURNING version, updated_at`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		item.Description,
+		item.StorageLocation,
+		item.Status,
+		item.DisposeAfter,
+		item.ReturnedTo,
+		item.Notes,
+		item.ClosedAt,
+		item.Id,
+		item.Version,
+	).Scan(&item.Version, &item.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "lost_items", item.Id)
+	}
+
+	return err
+}
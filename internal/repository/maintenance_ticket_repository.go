@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// MaintenanceTicketRepository defines the interface for maintenance ticket data operations
+type MaintenanceTicketRepository interface {
+	Create(ctx context.Context, ticket *models.MaintenanceTicket) error
+	GetByID(ctx context.Context, id int) (*models.MaintenanceTicket, error)
+	GetAll(ctx context.Context, status *string, roomID *int) ([]models.MaintenanceTicket, error)
+	Update(ctx context.Context, ticket *models.MaintenanceTicket) error
+}
+
+// maintenanceTicketRepository implements MaintenanceTicketRepository
+type maintenanceTicketRepository struct {
+	db DBTX
+}
+
+// NewMaintenanceTicketRepository creates a new maintenance ticket repository
+func NewMaintenanceTicketRepository(db DBTX) MaintenanceTicketRepository {
+	return &maintenanceTicketRepository{db: db}
+}
+
+const maintenanceTicketColumns = `id, room_id, order_id, title, description, priority, status, assignee,
+		reported_by, out_of_order, room_block_id, created_at, resolved_at, version, updated_at`
+
+// scanMaintenanceTicket scans a row of maintenanceTicketColumns
+func scanMaintenanceTicket(row interface{ Scan(...any) error }, ticket *models.MaintenanceTicket) error {
+	return row.Scan(
+		&ticket.Id,
+		&ticket.RoomId,
+		&ticket.OrderId,
+		&ticket.Title,
+		&ticket.Description,
+		&ticket.Priority,
+		&ticket.Status,
+		&ticket.Assignee,
+		&ticket.ReportedBy,
+		&ticket.OutOfOrder,
+		&ticket.RoomBlockId,
+		&ticket.CreatedAt,
+		&ticket.ResolvedAt,
+		&ticket.Version,
+		&ticket.UpdatedAt,
+	)
+}
+
+// Create inserts a new ticket into the database
+func (r *maintenanceTicketRepository) Create(ctx context.Context, ticket *models.MaintenanceTicket) error {
+	query := `
+		INSERT INTO maintenance_tickets
+		(room_id, order_id, title, description, priority, status, assignee, reported_by, out_of_order, room_block_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at, version, updated_at`
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
+		ticket.RoomId,
+		ticket.OrderId,
+		ticket.Title,
+		ticket.Description,
+		ticket.Priority,
+		ticket.Status,
+		ticket.Assignee,
+		ticket.ReportedBy,
+		ticket.OutOfOrder,
+		ticket.RoomBlockId,
+	).Scan(&ticket.Id, &ticket.CreatedAt, &ticket.Version, &ticket.UpdatedAt)
+}
+
+// GetByID retrieves a ticket by its ID
+func (r *maintenanceTicketRepository) GetByID(ctx context.Context, id int) (*models.MaintenanceTicket, error) {
+	query := `SELECT ` + maintenanceTicketColumns + `
+		FROM maintenance_tickets
+		WHERE id = $1 AND ` + roomInProperty("room_id", 2)
+
+	ticket := &models.MaintenanceTicket{}
+	if err := scanMaintenanceTicket(conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)), ticket); err != nil {
+		return nil, err
+	}
+
+	return ticket, nil
+}
+
+// GetAll retrieves tickets, optionally of one status and room. Unresolved
+// tickets come first, most urgent first.
+func (r *maintenanceTicketRepository) GetAll(ctx context.Context, status *string, roomID *int) ([]models.MaintenanceTicket, error) {
+	query := `SELECT ` + maintenanceTicketColumns + `
+		FROM maintenance_tickets
+		WHERE ($1::varchar IS NULL OR status = $1)
+		AND ($2::integer IS NULL OR room_id = $2)
+		AND ` + roomInProperty("room_id", 3) + `
+		ORDER BY status = 'resolved',
+		CASE priority WHEN 'urgent' THEN 0 WHEN 'high' THEN 1 WHEN 'normal' THEN 2 ELSE 3 END,
+		created_at`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, status, roomID, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tickets []models.MaintenanceTicket
+	for rows.Next() {
+		var ticket models.MaintenanceTicket
+		if err := scanMaintenanceTicket(rows, &ticket); err != nil {
+			return nil, err
+		}
+		tickets = append(tickets, ticket)
+	}
+
+	return tickets, nil
+}
+
+// Update updates a ticket if its version matches
+func (r *maintenanceTicketRepository) Update(ctx context.Context, ticket *models.MaintenanceTicket) error {
+	query := `
+		UPDATE maintenance_tickets
+		SET title = $1, description = $2, priority = $3, status = $4, assignee = $5,
+		out_of_order = $6, room_block_id = $7, resolved_at = $8,
+		version = version + 1, updated_at = NOW()
+		WHERE id = $9 AND version = $10
+		RETURNING version, updated_at`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		ticket.Title,
+		ticket.Description,
+		ticket.Priority,
+		ticket.Status,
+		ticket.Assignee,
+		ticket.OutOfOrder,
+		ticket.RoomBlockId,
+		ticket.ResolvedAt,
+		ticket.Id,
+		ticket.Version,
+	).Scan(&ticket.Version, &ticket.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "maintenance_tickets", ticket.Id)
+	}
+
+	return err
+}
@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrNoProperty is returned when a record has to be created in a property
+// but the context carries none
+var ErrNoProperty = errors.New("no property selected")
+
+type propertyKey struct{}
+
+// WithProperty returns a context that scopes repository queries to a
+// property. Records of other properties are not found, new records are
+// created in it. Without a property queries see all properties, which is
+// what background jobs use.
+func WithProperty(ctx context.Context, propertyID int) context.Context {
+	return context.WithValue(ctx, propertyKey{}, propertyID)
+}
+
+// PropertyFromContext returns the property a context is scoped to
+func PropertyFromContext(ctx context.Context) (int, bool) {
+	id, ok := ctx.Value(propertyKey{}).(int)
+	return id, ok
+}
+
+// propertyScope returns the property of the context as a query parameter,
+// nil for all properties
+func propertyScope(ctx context.Context) *int {
+	if id, ok := PropertyFromContext(ctx); ok {
+		return &id
+	}
+	return nil
+}
+
+// requireProperty returns the property new records of the context are
+// created in
+func requireProperty(ctx context.Context) (int, error) {
+	id, ok := PropertyFromContext(ctx)
+	if !ok {
+		return 0, ErrNoProperty
+	}
+	return id, nil
+}
+
+// inProperty matches rows whose column is the property in parameter $n, all
+// rows when the parameter is NULL
+func inProperty(column string, n int) string {
+	return fmt.Sprintf(`($%[2]d::int IS NULL OR %[1]s = $%[2]d)`, column, n)
+}
+
+// roomInProperty matches rows whose room, given by the column, belongs to
+// the property in parameter $n
+func roomInProperty(column string, n int) string {
+	return fmt.Sprintf(`($%[2]d::int IS NULL OR %[1]s IN (
+			SELECT rooms.id FROM rooms WHERE rooms.property_id = $%[2]d))`, column, n)
+}
+
+// cleanerInProperty matches rows whose cleaner, given by the column,
+// works at the property in parameter $n
+func cleanerInProperty(column string, n int) string {
+	return fmt.Sprintf(`($%[2]d::int IS NULL OR %[1]s IN (
+			SELECT cleaners.id FROM cleaners WHERE cleaners.property_id = $%[2]d))`, column, n)
+}
+
+// bookingInProperty matches rows whose booking, given by the column, is for
+// a room of the property in parameter $n
+func bookingInProperty(column string, n int) string {
+	return fmt.Sprintf(`($%[2]d::int IS NULL OR %[1]s IN (
+			SELECT bookings.id FROM bookings JOIN rooms ON rooms.id = bookings.room_id
+			WHERE rooms.property_id = $%[2]d))`, column, n)
+}
+
+// orderInProperty matches rows whose cleaning order, given by the column, is
+// for a booking, room or zone of the property in parameter $n
+func orderInProperty(column string, n int) string {
+	return fmt.Sprintf(`($%[2]d::int IS NULL OR %[1]s IN (
+			SELECT cleaning_orders.id FROM cleaning_orders
+			WHERE %[3]s))`, column, n, orderTargetInProperty("cleaning_orders", n))
+}
+
+// orderTargetInProperty matches the cleaning orders, given by the table
+// alias, whose booking, room or zone belongs to the property in parameter $n
+func orderTargetInProperty(table string, n int) string {
+	return fmt.Sprintf(`(%s OR %s)`, bookingInProperty(table+".booking_id", n), placeInProperty(table, n))
+}
+
+// placeInProperty matches the rows, given by the table alias, whose room_id
+// or zone_id belongs to the property in parameter $n
+func placeInProperty(table string, n int) string {
+	return fmt.Sprintf(`(%s OR %s)`, roomInProperty(table+".room_id", n), zoneInProperty(table+".zone_id", n))
+}
+
+// itemInProperty matches rows whose inventory item, given by the column, is
+// stocked at the property in parameter $n
+func itemInProperty(column string, n int) string {
+	return fmt.Sprintf(`($%[2]d::int IS NULL OR %[1]s IN (
+			SELECT inventory_items.id FROM inventory_items WHERE inventory_items.property_id = $%[2]d))`, column, n)
+}
+
+// buildingInProperty matches rows whose building, given by the column,
+// belongs to the property in parameter $n
+func buildingInProperty(column string, n int) string {
+	return fmt.Sprintf(`($%[2]d::int IS NULL OR %[1]s IN (
+			SELECT buildings.id FROM buildings WHERE buildings.property_id = $%[2]d))`, column, n)
+}
+
+// zoneInProperty matches rows whose zone, given by the column, is on a
+// floor of a building of the property in parameter $n
+func zoneInProperty(column string, n int) string {
+	return fmt.Sprintf(`($%[2]d::int IS NULL OR %[1]s IN (
+			SELECT zones.id FROM zones JOIN floors ON floors.id = zones.floor_id
+			JOIN buildings ON buildings.id = floors.building_id
+			WHERE buildings.property_id = $%[2]d))`, column, n)
+}
+
+// floorInProperty matches rows whose floor, given by the column, is in a
+// building of the property in parameter $n
+func floorInProperty(column string, n int) string {
+	return fmt.Sprintf(`($%[2]d::int IS NULL OR %[1]s IN (
+			SELECT floors.id FROM floors JOIN buildings ON buildings.id = floors.building_id
+			WHERE buildings.property_id = $%[2]d))`, column, n)
+}
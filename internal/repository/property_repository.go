@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// PropertyRepository defines the interface for property data operations
+type PropertyRepository interface {
+	Create(ctx context.Context, property *models.Property) error
+	GetByID(ctx context.Context, id int) (*models.Property, error)
+	GetAll(ctx context.Context, user *string) ([]models.Property, error)
+	Update(ctx context.Context, property *models.Property) error
+	GetUsers(ctx context.Context, propertyID int) ([]models.PropertyUser, error)
+	AddUser(ctx context.Context, propertyID int, user string) (*models.PropertyUser, error)
+	RemoveUser(ctx context.Context, propertyID int, user string) error
+	IsAuthorized(ctx context.Context, propertyID int, user string) (bool, error)
+}
+
+// propertyRepository implements PropertyRepository
+type propertyRepository struct {
+	db DBTX
+}
+
+// NewPropertyRepository creates a new property repository
+func NewPropertyRepository(db DBTX) PropertyRepository {
+	return &propertyRepository{db: db}
+}
+
+const propertyColumns = `id, name, address, version, updated_at`
+
+// scanProperty scans a row of propertyColumns
+func scanProperty(row interface{ Scan(...any) error }, property *models.Property) error {
+	return row.Scan(
+		&property.Id,
+		&property.Name,
+		&property.Address,
+		&property.Version,
+		&property.UpdatedAt,
+	)
+}
+
+// Create inserts a new property into the database
+func (r *propertyRepository) Create(ctx context.Context, property *models.Property) error {
+	query := `
+		INSERT INTO properties (name, address)
+		VALUES ($1, $2)
+		RETURNING id, version, updated_at`
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
+		property.Name,
+		property.Address,
+	).Scan(&property.Id, &property.Version, &property.UpdatedAt)
+}
+
+// GetByID retrieves a property by its ID
+func (r *propertyRepository) GetByID(ctx context.Context, id int) (*models.Property, error) {
+	query := `SELECT ` + propertyColumns + `
+		FROM properties
+		WHERE id = $1`
+
+	property := &models.Property{}
+	if err := scanProperty(conn(ctx, r.db).QueryRowContext(ctx, query, id), property); err != nil {
+		return nil, err
+	}
+
+	return property, nil
+}
+
+// GetAll retrieves the properties a user is authorized for, all of them if
+// user is nil
+func (r *propertyRepository) GetAll(ctx context.Context, user *string) ([]models.Property, error) {
+	query := `SELECT ` + propertyColumns + `
+		FROM properties
+		WHERE $1::varchar IS NULL OR id IN (
+			SELECT property_id FROM property_users WHERE user_name = $1)
+		ORDER BY id`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, user)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var properties []models.Property
+	for rows.Next() {
+		var property models.Property
+		if err := scanProperty(rows, &property); err != nil {
+			return nil, err
+		}
+		properties = append(properties, property)
+	}
+
+	return properties, nil
+}
+
+// Update modifies a property if its version matches
+func (r *propertyRepository) Update(ctx context.Context, property *models.Property) error {
+	query := `
+		UPDATE properties
+		SET name = $1, address = $2, version = version + 1, updated_at = NOW()
+		WHERE id = $3 AND version = $4
+		RETURNING version, updated_at`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		property.Name,
+		property.Address,
+		property.Id,
+		property.Version,
+	).Scan(&property.Version, &property.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "properties", property.Id)
+	}
+
+	return err
+}
+
+// GetUsers retrieves the users authorized for a property
+func (r *propertyRepository) GetUsers(ctx context.Context, propertyID int) ([]models.PropertyUser, error) {
+	query := `
+		SELECT property_id, user_name, created_at
+		FROM property_users
+		WHERE property_id = $1
+		ORDER BY user_name`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, propertyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := []models.PropertyUser{}
+	for rows.Next() {
+		var user models.PropertyUser
+		if err := rows.Scan(&user.PropertyId, &user.User, &user.CreatedAt); err != nil {
+			return nil, err
+		}
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
+// AddUser authorizes a user for a property, authorizing a user twice keeps
+// the first authorization
+func (r *propertyRepository) AddUser(ctx context.Context, propertyID int, user string) (*models.PropertyUser, error) {
+	query := `
+		INSERT INTO property_users (property_id, user_name)
+		VALUES ($1, $2)
+		ON CONFLICT (property_id, user_name) DO UPDATE SET user_name = EXCLUDED.user_name
+		RETURNING property_id, user_name, created_at`
+
+	propertyUser := &models.PropertyUser{}
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, propertyID, user).Scan(
+		&propertyUser.PropertyId,
+		&propertyUser.User,
+		&propertyUser.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return propertyUser, nil
+}
+
+// RemoveUser revokes the authorization of a user for a property
+func (r *propertyRepository) RemoveUser(ctx context.Context, propertyID int, user string) error {
+	query := `DELETE FROM property_users WHERE property_id = $1 AND user_name = $2`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, propertyID, user)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// IsAuthorized reports whether a user may access a property
+func (r *propertyRepository) IsAuthorized(ctx context.Context, propertyID int, user string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM property_users WHERE property_id = $1 AND user_name = $2)`
+
+	var authorized bool
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, propertyID, user).Scan(&authorized)
+	return authorized, err
+}
@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// RecurrenceRuleRepository defines the interface for recurrence rule data operations
+type RecurrenceRuleRepository interface {
+	Create(ctx context.Context, rule *models.RecurrenceRule) error
+	GetByID(ctx context.Context, id int) (*models.RecurrenceRule, error)
+	GetAll(ctx context.Context) ([]models.RecurrenceRule, error)
+	GetActive(ctx context.Context) ([]models.RecurrenceRule, error)
+	Update(ctx context.Context, rule *models.RecurrenceRule) error
+	Delete(ctx context.Context, id int) error
+	SetGeneratedUntil(ctx context.Context, id int, until *time.Time) error
+}
+
+// recurrenceRuleRepository implements RecurrenceRuleRepository
+type recurrenceRuleRepository struct {
+	db DBTX
+}
+
+// NewRecurrenceRuleRepository creates a new recurrence rule repository
+func NewRecurrenceRuleRepository(db DBTX) RecurrenceRuleRepository {
+	return &recurrenceRuleRepository{db: db}
+}
+
+const recurrenceRuleColumns = `id, room_id, zone_id, cleaning_type, rrule, starts_at, cost, notes, active,
+		generated_until, version, updated_at`
+
+// scanRecurrenceRule scans a row of recurrenceRuleColumns. The times are
+// returned in UTC, the zone the TIMESTAMP columns hold them in.
+func scanRecurrenceRule(row interface{ Scan(...any) error }, rule *models.RecurrenceRule) error {
+	err := row.Scan(
+		&rule.Id,
+		&rule.RoomId,
+		&rule.ZoneId,
+		&rule.CleaningType,
+		&rule.Rrule,
+		&rule.StartsAt,
+		&rule.Cost,
+		&rule.Notes,
+		&rule.Active,
+		&rule.GeneratedUntil,
+		&rule.Version,
+		&rule.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	rule.StartsAt = rule.StartsAt.UTC()
+	if rule.GeneratedUntil != nil {
+		until := rule.GeneratedUntil.UTC()
+		rule.GeneratedUntil = &until
+	}
+	return nil
+}
+
+// Create inserts a new recurrence rule into the database
+func (r *recurrenceRuleRepository) Create(ctx context.Context, rule *models.RecurrenceRule) error {
+	query := `
+		INSERT INTO recurrence_rules (room_id, zone_id, cleaning_type, rrule, starts_at, cost, notes, active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, version, updated_at`
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
+		rule.RoomId,
+		rule.ZoneId,
+		rule.CleaningType,
+		rule.Rrule,
+		rule.StartsAt,
+		rule.Cost,
+		rule.Notes,
+		rule.Active,
+	).Scan(&rule.Id, &rule.Version, &rule.UpdatedAt)
+}
+
+// GetByID retrieves a recurrence rule by its ID
+func (r *recurrenceRuleRepository) GetByID(ctx context.Context, id int) (*models.RecurrenceRule, error) {
+	query := `SELECT ` + recurrenceRuleColumns + `
+		FROM recurrence_rules
+		WHERE id = $1 AND ` + placeInProperty("recurrence_rules", 2)
+
+	rule := &models.RecurrenceRule{}
+	if err := scanRecurrenceRule(conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)), rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// GetAll retrieves all recurrence rules
+func (r *recurrenceRuleRepository) GetAll(ctx context.Context) ([]models.RecurrenceRule, error) {
+	query := `SELECT ` + recurrenceRuleColumns + `
+		FROM recurrence_rules
+		WHERE ` + placeInProperty("recurrence_rules", 1) + `
+		ORDER BY id`
+
+	return r.query(ctx, query, propertyScope(ctx))
+}
+
+// GetActive retrieves the active recurrence rules whose room, if they have
+// one, is not deleted
+func (r *recurrenceRuleRepository) GetActive(ctx context.Context) ([]models.RecurrenceRule, error) {
+	query := `SELECT ` + recurrenceRuleColumns + `
+		FROM recurrence_rules
+		WHERE active
+		AND (room_id IS NULL OR room_id IN (SELECT id FROM rooms WHERE deleted_at IS NULL))
+		AND ` + placeInProperty("recurrence_rules", 1) + `
+		ORDER BY id`
+
+	return r.query(ctx, query, propertyScope(ctx))
+}
+
+// query retrieves the recurrence rules selected by a query of
+// recurrenceRuleColumns
+func (r *recurrenceRuleRepository) query(ctx context.Context, query string, args ...any) ([]models.RecurrenceRule, error) {
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := []models.RecurrenceRule{}
+	for rows.Next() {
+		var rule models.RecurrenceRule
+		if err := scanRecurrenceRule(rows, &rule); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// Update modifies a recurrence rule if its version matches. The generated
+// orders are replaced, so generated_until is reset.
+func (r *recurrenceRuleRepository) Update(ctx context.Context, rule *models.RecurrenceRule) error {
+	query := `
+		UPDATE recurrence_rules
+		SET room_id = $1, zone_id = $2, cleaning_type = $3, rrule = $4, starts_at = $5, cost = $6, notes = $7,
+		active = $8, generated_until = NULL, version = version + 1, updated_at = NOW()
+		WHERE id = $9 AND version = $10 AND ` + placeInProperty("recurrence_rules", 11) + `
+		RETURNING generated_until, version, updated_at`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		rule.RoomId,
+		rule.ZoneId,
+		rule.CleaningType,
+		rule.Rrule,
+		rule.StartsAt,
+		rule.Cost,
+		rule.Notes,
+		rule.Active,
+		rule.Id,
+		rule.Version,
+		propertyScope(ctx),
+	).Scan(&rule.GeneratedUntil, &rule.Version, &rule.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "recurrence_rules", rule.Id)
+	}
+
+	return err
+}
+
+// Delete removes a recurrence rule, its orders are kept
+func (r *recurrenceRuleRepository) Delete(ctx context.Context, id int) error {
+	query := `DELETE FROM recurrence_rules WHERE id = $1 AND ` + placeInProperty("recurrence_rules", 2)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// SetGeneratedUntil records up to when the orders of a recurrence rule have
+// been generated
+func (r *recurrenceRuleRepository) SetGeneratedUntil(ctx context.Context, id int, until *time.Time) error {
+	query := `UPDATE recurrence_rules SET generated_until = $1
+		WHERE id = $2 AND ` + placeInProperty("recurrence_rules", 3)
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, until, id, propertyScope(ctx))
+	return err
+}
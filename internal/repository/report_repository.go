@@ -0,0 +1,371 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// ReportRepository aggregates bookings and cleaning orders for the KPI
+// reports. A report covers the days [from, to) in the time zone loc, the
+// stored timestamps are UTC, and has one row per group of groupBy: day,
+// week, month, room_type, cleaning_type or cleaner.
+type ReportRepository interface {
+	Occupancy(ctx context.Context, from, to time.Time, loc *time.Location, groupBy string) ([]models.OccupancyRow, error)
+	CleaningTime(ctx context.Context, from, to time.Time, loc *time.Location, groupBy string) ([]models.CleaningTimeRow, error)
+	CleanerWorkload(ctx context.Context, from, to time.Time, loc *time.Location, groupBy string) ([]models.CleanerWorkloadRow, error)
+	OnTime(ctx context.Context, from, to time.Time, loc *time.Location, now time.Time, groupBy string) ([]models.OnTimeRow, error)
+	CostPerNight(ctx context.Context, from, to time.Time, loc *time.Location, groupBy string) ([]models.CostPerNightRow, error)
+}
+
+// reportRepository implements ReportRepository
+type reportRepository struct {
+	db DBTX
+}
+
+// NewReportRepository creates a new report repository
+func NewReportRepository(db DBTX) ReportRepository {
+	return &reportRepository{db: db}
+}
+
+// reportKeys are the key columns of a report row per group, selected from
+// facts with the columns day, room_type_id, cleaning_type and cleaner_id
+var reportKeys = map[string]string{
+	"day":           `facts.day AS period, NULL::int AS room_type_id, NULL::varchar AS cleaning_type, NULL::int AS cleaner_id`,
+	"week":          `date_trunc('week', facts.day)::date AS period, NULL::int AS room_type_id, NULL::varchar AS cleaning_type, NULL::int AS cleaner_id`,
+	"month":         `date_trunc('month', facts.day)::date AS period, NULL::int AS room_type_id, NULL::varchar AS cleaning_type, NULL::int AS cleaner_id`,
+	"room_type":     `NULL::date AS period, facts.room_type_id, NULL::varchar AS cleaning_type, NULL::int AS cleaner_id`,
+	"cleaning_type": `NULL::date AS period, NULL::int AS room_type_id, facts.cleaning_type, NULL::int AS cleaner_id`,
+	"cleaner":       `NULL::date AS period, NULL::int AS room_type_id, NULL::varchar AS cleaning_type, facts.cleaner_id`,
+}
+
+// reportKey returns the key columns of a group
+func reportKey(groupBy string) (string, error) {
+	key, ok := reportKeys[groupBy]
+	if !ok {
+		return "", fmt.Errorf("unknown group %q", groupBy)
+	}
+	return key, nil
+}
+
+// localDay is the day of a stored UTC timestamp column in the time zone
+// named by parameter $n
+func localDay(column string, n int) string {
+	return fmt.Sprintf(`(%s AT TIME ZONE 'UTC' AT TIME ZONE $%d)::date`, column, n)
+}
+
+// zoneName returns the name of a time zone as a query parameter
+func zoneName(loc *time.Location) string {
+	if loc == nil {
+		return "UTC"
+	}
+	return loc.String()
+}
+
+// roomNightFacts are the nights of the rooms in the days [$1, $2), occupied
+// when a not cancelled booking covers them, of the property in $3, with the
+// days taken in the time zone $4. A room counts on the days from its creation
+// until its deletion, rooms of unknown creation time from the start
+var roomNightFacts = `
+		SELECT nights.day::date AS day, rooms.room_type_id, NULL::varchar AS cleaning_type, NULL::int AS cleaner_id,
+		EXISTS (
+			SELECT 1 FROM bookings
+			WHERE bookings.room_id = rooms.id
+			AND bookings.deleted_at IS NULL AND bookings.cancelled_at IS NULL
+			AND ` + localDay("bookings.check_in_ts", 4) + ` <= nights.day
+			AND ` + localDay("bookings.check_out_ts", 4) + ` > nights.day
+		) AS occupied
+		FROM generate_series($1::date, $2::date - 1, interval '1 day') AS nights(day)
+		CROSS JOIN rooms
+		WHERE (rooms.created_at IS NULL OR ` + localDay("rooms.created_at", 4) + ` <= nights.day)
+		AND (rooms.deleted_at IS NULL OR ` + localDay("rooms.deleted_at", 4) + ` > nights.day)
+		AND ` + inProperty("rooms.property_id", 3)
+
+// orderPeriod matches the not cancelled orders scheduled in the days
+// [$1, $2) in the time zone $4 of the property in $3, except those of
+// deleted bookings
+var orderPeriod = localDay("cleaning_orders.cleaning_ts", 4) + ` >= $1::date
+		AND ` + localDay("cleaning_orders.cleaning_ts", 4) + ` < $2::date
+		AND cleaning_orders.cancelled_at IS NULL
+		AND bookings.deleted_at IS NULL
+		AND ` + orderTargetInProperty("cleaning_orders", 3)
+
+// reportRow holds the key columns of a report row while it is scanned
+type reportRow struct {
+	period       *time.Time
+	roomTypeID   *int
+	cleaningType *string
+	cleanerID    *int
+}
+
+// dest returns the scan destinations of the key columns followed by those
+// of the metrics
+func (k *reportRow) dest(metrics ...any) []any {
+	return append([]any{&k.period, &k.roomTypeID, &k.cleaningType, &k.cleanerID}, metrics...)
+}
+
+// date returns the period of the row as a date
+func (k *reportRow) date() *openapi_types.Date {
+	if k.period == nil {
+		return nil
+	}
+	return &openapi_types.Date{Time: *k.period}
+}
+
+// Occupancy counts the occupied room nights per group
+func (r *reportRepository) Occupancy(ctx context.Context, from, to time.Time, loc *time.Location, groupBy string) ([]models.OccupancyRow, error) {
+	key, err := reportKey(groupBy)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(`
+		WITH facts AS (%s)
+		SELECT %s,
+		COUNT(*), COUNT(*) FILTER (WHERE facts.occupied),
+		COUNT(*) FILTER (WHERE facts.occupied)::float8 / COUNT(*)
+		FROM facts
+		GROUP BY 1, 2, 3, 4
+		ORDER BY 1, 2, 3, 4`,
+		roomNightFacts, key,
+	)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, from.Format(time.DateOnly), to.Format(time.DateOnly), propertyScope(ctx), zoneName(loc))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	report := []models.OccupancyRow{}
+	for rows.Next() {
+		var k reportRow
+		var row models.OccupancyRow
+		if err := rows.Scan(k.dest(&row.RoomNights, &row.OccupiedNights, &row.Rate)...); err != nil {
+			return nil, err
+		}
+		row.Period, row.RoomTypeId, row.CleaningType, row.CleanerId = k.date(), k.roomTypeID, k.cleaningType, k.cleanerID
+		report = append(report, row)
+	}
+
+	return report, nil
+}
+
+// CleaningTime averages the minutes from the start to the completion of the
+// done orders per group. An order starts when its first checklist item is
+// checked, at its scheduled time if none is.
+func (r *reportRepository) CleaningTime(ctx context.Context, from, to time.Time, loc *time.Location, groupBy string) ([]models.CleaningTimeRow, error) {
+	key, err := reportKey(groupBy)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(`
+		WITH facts AS (
+			SELECT `+localDay("cleaning_orders.cleaning_ts", 4)+` AS day, rooms.room_type_id, cleaning_orders.cleaning_type,
+			NULL::int AS cleaner_id,
+			EXTRACT(EPOCH FROM cleaning_orders.done_at - started.started_at)::float8 / 60 AS minutes
+			FROM cleaning_orders
+			LEFT JOIN bookings ON bookings.id = cleaning_orders.booking_id
+			LEFT JOIN rooms ON rooms.id = COALESCE(cleaning_orders.room_id, bookings.room_id)
+			CROSS JOIN LATERAL (
+				SELECT COALESCE(MIN(order_checklist_items.checked_at), cleaning_orders.cleaning_ts) AS started_at
+				FROM order_checklist_items
+				WHERE order_checklist_items.order_id = cleaning_orders.id
+			) started
+			WHERE cleaning_orders.done IS TRUE AND started.started_at <= cleaning_orders.done_at
+			AND %s
+		)
+		SELECT %s, COUNT(*), AVG(facts.minutes)
+		FROM facts
+		GROUP BY 1, 2, 3, 4
+		ORDER BY 1, 2, 3, 4`,
+		orderPeriod, key,
+	)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, from.Format(time.DateOnly), to.Format(time.DateOnly), propertyScope(ctx), zoneName(loc))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	report := []models.CleaningTimeRow{}
+	for rows.Next() {
+		var k reportRow
+		var row models.CleaningTimeRow
+		if err := rows.Scan(k.dest(&row.Orders, &row.AverageMinutes)...); err != nil {
+			return nil, err
+		}
+		row.Period, row.RoomTypeId, row.CleaningType, row.CleanerId = k.date(), k.roomTypeID, k.cleaningType, k.cleanerID
+		report = append(report, row)
+	}
+
+	return report, nil
+}
+
+// CleanerWorkload counts the orders assigned to cleaners per group and the
+// days the cleaners had orders
+func (r *reportRepository) CleanerWorkload(ctx context.Context, from, to time.Time, loc *time.Location, groupBy string) ([]models.CleanerWorkloadRow, error) {
+	key, err := reportKey(groupBy)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(`
+		WITH facts AS (
+			SELECT `+localDay("cleaning_orders.cleaning_ts", 4)+` AS day, rooms.room_type_id, cleaning_orders.cleaning_type,
+			cleaner_orders.cleaner_id, cleaning_orders.done IS TRUE AS done
+			FROM cleaner_orders
+			JOIN cleaning_orders ON cleaning_orders.id = cleaner_orders.order_id
+			LEFT JOIN bookings ON bookings.id = cleaning_orders.booking_id
+			LEFT JOIN rooms ON rooms.id = COALESCE(cleaning_orders.room_id, bookings.room_id)
+			WHERE %s
+		)
+		SELECT %s,
+		COUNT(*), COUNT(*) FILTER (WHERE facts.done),
+		COUNT(DISTINCT facts.cleaner_id), COUNT(DISTINCT (facts.cleaner_id, facts.day)),
+		COUNT(*)::float8 / COUNT(DISTINCT (facts.cleaner_id, facts.day))
+		FROM facts
+		GROUP BY 1, 2, 3, 4
+		ORDER BY 1, 2, 3, 4`,
+		orderPeriod, key,
+	)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, from.Format(time.DateOnly), to.Format(time.DateOnly), propertyScope(ctx), zoneName(loc))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	report := []models.CleanerWorkloadRow{}
+	for rows.Next() {
+		var k reportRow
+		var row models.CleanerWorkloadRow
+		err := rows.Scan(k.dest(
+			&row.Orders,
+			&row.Done,
+			&row.Cleaners,
+			&row.CleanerDays,
+			&row.OrdersPerCleanerDay,
+		)...)
+		if err != nil {
+			return nil, err
+		}
+		row.Period, row.RoomTypeId, row.CleaningType, row.CleanerId = k.date(), k.roomTypeID, k.cleaningType, k.cleanerID
+		report = append(report, row)
+	}
+
+	return report, nil
+}
+
+// OnTime counts the orders done or due at now per group and those done
+// before they were due: departure cleanings by the next check-in of the
+// room, the others by the end of their day in the time zone loc
+func (r *reportRepository) OnTime(ctx context.Context, from, to time.Time, loc *time.Location, now time.Time, groupBy string) ([]models.OnTimeRow, error) {
+	key, err := reportKey(groupBy)
+	if err != nil {
+		return nil, err
+	}
+	// an order has a row per cleaner, hence the distinct counts
+	query := fmt.Sprintf(`
+		WITH facts AS (
+			SELECT cleaning_orders.id, `+localDay("cleaning_orders.cleaning_ts", 4)+` AS day, rooms.room_type_id,
+			cleaning_orders.cleaning_type, cleaner_orders.cleaner_id,
+			cleaning_orders.done IS TRUE AND cleaning_orders.done_at <= due.due_at AS on_time
+			FROM %s
+			LEFT JOIN rooms ON rooms.id = COALESCE(cleaning_orders.room_id, bookings.room_id)
+			LEFT JOIN cleaner_orders ON cleaner_orders.order_id = cleaning_orders.id
+			CROSS JOIN LATERAL (
+				SELECT COALESCE(following.check_in_ts,
+				(`+localDay("cleaning_orders.cleaning_ts", 4)+` + 1)::timestamp AT TIME ZONE $4 AT TIME ZONE 'UTC') AS due_at
+			) due
+			WHERE (cleaning_orders.done IS TRUE OR due.due_at <= $5)
+			AND %s
+		)
+		SELECT %s,
+		COUNT(DISTINCT facts.id), COUNT(DISTINCT facts.id) FILTER (WHERE facts.on_time),
+		COUNT(DISTINCT facts.id) FILTER (WHERE facts.on_time)::float8 / COUNT(DISTINCT facts.id)
+		FROM facts
+		GROUP BY 1, 2, 3, 4
+		ORDER BY 1, 2, 3, 4`,
+		cleaningOrderTables, orderPeriod, key,
+	)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, from.Format(time.DateOnly), to.Format(time.DateOnly), propertyScope(ctx), zoneName(loc), now.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	report := []models.OnTimeRow{}
+	for rows.Next() {
+		var k reportRow
+		var row models.OnTimeRow
+		if err := rows.Scan(k.dest(&row.Orders, &row.OnTime, &row.Rate)...); err != nil {
+			return nil, err
+		}
+		row.Period, row.RoomTypeId, row.CleaningType, row.CleanerId = k.date(), k.roomTypeID, k.cleaningType, k.cleanerID
+		report = append(report, row)
+	}
+
+	return report, nil
+}
+
+// CostPerNight divides the cost of the done orders of a group by its
+// occupied room nights. Groups are those of the room nights, so the cost of
+// room types no room has anymore is left out.
+func (r *reportRepository) CostPerNight(ctx context.Context, from, to time.Time, loc *time.Location, groupBy string) ([]models.CostPerNightRow, error) {
+	key, err := reportKey(groupBy)
+	if err != nil {
+		return nil, err
+	}
+	query := fmt.Sprintf(`
+		WITH nights AS (%[1]s),
+		orders AS (
+			SELECT `+localDay("cleaning_orders.cleaning_ts", 4)+` AS day, rooms.room_type_id, cleaning_orders.cleaning_type,
+			NULL::int AS cleaner_id, cleaning_orders.cost
+			FROM cleaning_orders
+			LEFT JOIN bookings ON bookings.id = cleaning_orders.booking_id
+			LEFT JOIN rooms ON rooms.id = COALESCE(cleaning_orders.room_id, bookings.room_id)
+			WHERE cleaning_orders.done IS TRUE
+			AND %[2]s
+		),
+		occupied AS (
+			SELECT %[3]s, COUNT(*) FILTER (WHERE facts.occupied) AS nights
+			FROM nights facts
+			GROUP BY 1, 2, 3, 4
+		),
+		spent AS (
+			SELECT %[3]s, SUM(facts.cost) AS cost
+			FROM orders facts
+			GROUP BY 1, 2, 3, 4
+		)
+		SELECT occupied.period, occupied.room_type_id, occupied.cleaning_type, occupied.cleaner_id,
+		COALESCE(spent.cost, 0), occupied.nights,
+		COALESCE(spent.cost, 0)::float8 / NULLIF(occupied.nights, 0)
+		FROM occupied
+		LEFT JOIN spent ON spent.period IS NOT DISTINCT FROM occupied.period
+		AND spent.room_type_id IS NOT DISTINCT FROM occupied.room_type_id
+		ORDER BY 1, 2`,
+		roomNightFacts, orderPeriod, key,
+	)
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, from.Format(time.DateOnly), to.Format(time.DateOnly), propertyScope(ctx), zoneName(loc))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	report := []models.CostPerNightRow{}
+	for rows.Next() {
+		var k reportRow
+		var row models.CostPerNightRow
+		if err := rows.Scan(k.dest(&row.Cost, &row.OccupiedNights, &row.CostPerNight)...); err != nil {
+			return nil, err
+		}
+		row.Period, row.RoomTypeId, row.CleaningType, row.CleanerId = k.date(), k.roomTypeID, k.cleaningType, k.cleanerID
+		report = append(report, row)
+	}
+
+	return report, nil
+}
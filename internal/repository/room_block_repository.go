@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// RoomBlockRepository defines the interface for out-of-order and
+// out-of-service period data operations
+type RoomBlockRepository interface {
+	Create(ctx context.Context, block *models.RoomBlock) error
+	GetByRoomID(ctx context.Context, roomID int) ([]models.RoomBlock, error)
+	Delete(ctx context.Context, roomID, id int) error
+	CountOverlapping(ctx context.Context, roomID int, kind models.RoomBlockKind, from, to time.Time) (int, error)
+	End(ctx context.Context, id int, at time.Time) error
+}
+
+// roomBlockRepository implements RoomBlockRepository
+type roomBlockRepository struct {
+	db DBTX
+}
+
+// NewRoomBlockRepository creates a new room block repository
+func NewRoomBlockRepository(db DBTX) RoomBlockRepository {
+	return &roomBlockRepository{db: db}
+}
+
+// Create inserts a new period into the database
+func (r *roomBlockRepository) Create(ctx context.Context, block *models.RoomBlock) error {
+	query := `
+		INSERT INTO room_blocks (room_id, kind, reason, starts_at, ends_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at`
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
+		block.RoomId,
+		block.Kind,
+		block.Reason,
+		block.StartsAt,
+		block.EndsAt,
+	).Scan(&block.Id, &block.CreatedAt)
+}
+
+// GetByRoomID retrieves the periods of a room
+func (r *roomBlockRepository) GetByRoomID(ctx context.Context, roomID int) ([]models.RoomBlock, error) {
+	query := `
+		SELECT id, room_id, kind, reason, starts_at, ends_at, created_at
+		FROM room_blocks
+		WHERE room_id = $1
+		ORDER BY starts_at`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, roomID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var blocks []models.RoomBlock
+	for rows.Next() {
+		var block models.RoomBlock
+		err := rows.Scan(
+			&block.Id,
+			&block.RoomId,
+			&block.Kind,
+			&block.Reason,
+			&block.StartsAt,
+			&block.EndsAt,
+			&block.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
+
+// Delete removes a period of a room
+func (r *roomBlockRepository) Delete(ctx context.Context, roomID, id int) error {
+	query := `DELETE FROM room_blocks WHERE id = $1 AND room_id = $2`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, roomID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// CountOverlapping counts the periods of the given kind of a room that
+// overlap [from, to)
+func (r *roomBlockRepository) CountOverlapping(ctx context.Context, roomID int, kind models.RoomBlockKind, from, to time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM room_blocks
+		WHERE room_id = $1 AND kind = $2
+		AND starts_at < $4
+		AND (ends_at IS NULL OR ends_at > $3)`
+
+	var count int
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, roomID, kind, from, to).Scan(&count)
+	return count, err
+}
+
+// End closes a period at the given time unless it has already ended
+func (r *roomBlockRepository) End(ctx context.Context, id int, at time.Time) error {
+	query := `
+		UPDATE room_blocks
+		SET ends_at = GREATEST($2, starts_at)
+		WHERE id = $1 AND (ends_at IS NULL OR ends_at > $2)`
+
+	_, err := conn(ctx, r.db).ExecContext(ctx, query, id, at)
+	return err
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/StEvseeva/cleany/internal/models"
 )
@@ -11,46 +12,106 @@ import (
 type RoomRepository interface {
 	Create(ctx context.Context, room *models.Room) error
 	GetByID(ctx context.Context, id int) (*models.Room, error)
-	GetAll(ctx context.Context) ([]models.Room, error)
+	GetByIDWithDeleted(ctx context.Context, id int) (*models.Room, error)
+	GetAll(ctx context.Context, includeDeleted bool) ([]models.Room, error)
 	Update(ctx context.Context, room *models.Room) error
 	Delete(ctx context.Context, id int) error
+	SetStatus(ctx context.Context, id int, status models.RoomStatus) error
+	Restore(ctx context.Context, id int) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
 }
 
+// roomStatusColumn selects the effective room status: the kind of the current
+// out-of-order or out-of-service period, otherwise the housekeeping status
+const roomStatusColumn = `COALESCE((
+			SELECT room_blocks.kind FROM room_blocks
+			WHERE room_blocks.room_id = rooms.id
+			AND room_blocks.starts_at <= NOW()
+			AND (room_blocks.ends_at IS NULL OR room_blocks.ends_at > NOW())
+			ORDER BY room_blocks.kind = 'out_of_order' DESC
+			LIMIT 1
+		), rooms.status)`
+
 // roomRepository implements RoomRepository
 type roomRepository struct {
-	db *sql.DB
+	db DBTX
 }
 
 // NewRoomRepository creates a new room repository
-func NewRoomRepository(db *sql.DB) RoomRepository {
+func NewRoomRepository(db DBTX) RoomRepository {
 	return &roomRepository{db: db}
 }
 
 // Create inserts a new room into the database
 func (r *roomRepository) Create(ctx context.Context, room *models.Room) error {
 	query := `
-		INSERT INTO rooms (floor, "desc")
-		VALUES ($1, $2)
-		RETURNING id`
+		INSERT INTO rooms (floor, "desc", room_type_id, zone_id, property_id)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, property_id, version, updated_at, status`
+
+	propertyID, err := requireProperty(ctx)
+	if err != nil {
+		return err
+	}
 
-	return r.db.QueryRowContext(ctx, query,
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
 		room.Floor,
 		room.Desc,
-	).Scan(&room.Id)
+		room.RoomTypeId,
+		room.ZoneId,
+		propertyID,
+	).Scan(&room.Id, &room.PropertyId, &room.Version, &room.UpdatedAt, &room.Status)
 }
 
 // GetByID retrieves a room by its ID
 func (r *roomRepository) GetByID(ctx context.Context, id int) (*models.Room, error) {
 	query := `
-		SELECT id, floor, "desc"
+		SELECT id, floor, "desc", room_type_id, zone_id, property_id, version, updated_at, deleted_at,
+		` + roomStatusColumn + `
+		FROM rooms
+		WHERE id = $1 AND deleted_at IS NULL AND ` + inProperty("property_id", 2)
+
+	room := &models.Room{}
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)).Scan(
+		&room.Id,
+		&room.Floor,
+		&room.Desc,
+		&room.RoomTypeId,
+		&room.ZoneId,
+		&room.PropertyId,
+		&room.Version,
+		&room.UpdatedAt,
+		&room.DeletedAt,
+		&room.Status,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return room, nil
+}
+
+// GetByIDWithDeleted retrieves a room by its ID even if it is deleted
+func (r *roomRepository) GetByIDWithDeleted(ctx context.Context, id int) (*models.Room, error) {
+	query := `
+		SELECT id, floor, "desc", room_type_id, zone_id, property_id, version, updated_at, deleted_at,
+		` + roomStatusColumn + `
 		FROM rooms
-		WHERE id = $1`
+		WHERE id = $1 AND ` + inProperty("property_id", 2)
 
 	room := &models.Room{}
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)).Scan(
 		&room.Id,
 		&room.Floor,
 		&room.Desc,
+		&room.RoomTypeId,
+		&room.ZoneId,
+		&room.PropertyId,
+		&room.Version,
+		&room.UpdatedAt,
+		&room.DeletedAt,
+		&room.Status,
 	)
 
 	if err != nil {
@@ -60,14 +121,16 @@ func (r *roomRepository) GetByID(ctx context.Context, id int) (*models.Room, err
 	return room, nil
 }
 
-// GetAll retrieves all rooms
-func (r *roomRepository) GetAll(ctx context.Context) ([]models.Room, error) {
+// GetAll retrieves all rooms, deleted ones only if includeDeleted is set
+func (r *roomRepository) GetAll(ctx context.Context, includeDeleted bool) ([]models.Room, error) {
 	query := `
-		SELECT id, floor, "desc"
+		SELECT id, floor, "desc", room_type_id, zone_id, property_id, version, updated_at, deleted_at,
+		` + roomStatusColumn + `
 		FROM rooms
+		WHERE ($1 OR deleted_at IS NULL) AND ` + inProperty("property_id", 2) + `
 		ORDER BY id`
 
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, includeDeleted, propertyScope(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +143,13 @@ func (r *roomRepository) GetAll(ctx context.Context) ([]models.Room, error) {
 			&room.Id,
 			&room.Floor,
 			&room.Desc,
+			&room.RoomTypeId,
+			&room.ZoneId,
+			&room.PropertyId,
+			&room.Version,
+			&room.UpdatedAt,
+			&room.DeletedAt,
+			&room.Status,
 		)
 		if err != nil {
 			return nil, err
@@ -94,14 +164,33 @@ func (r *roomRepository) GetAll(ctx context.Context) ([]models.Room, error) {
 func (r *roomRepository) Update(ctx context.Context, room *models.Room) error {
 	query := `
 		UPDATE rooms
-		SET floor = $1, "desc" = $2
-		WHERE id = $3`
+		SET floor = $1, "desc" = $2, room_type_id = $3, zone_id = $4, version = version + 1, updated_at = NOW()
+		WHERE id = $5 AND version = $6 AND deleted_at IS NULL
+		RETURNING version, updated_at`
 
-	result, err := r.db.ExecContext(ctx, query,
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
 		room.Floor,
 		room.Desc,
+		room.RoomTypeId,
+		room.ZoneId,
 		room.Id,
-	)
+		room.Version,
+	).Scan(&room.Version, &room.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "rooms", room.Id)
+	}
+
+	return err
+}
+
+// Delete marks a room as deleted
+func (r *roomRepository) Delete(ctx context.Context, id int) error {
+	query := `
+		UPDATE rooms
+		SET deleted_at = NOW(), version = version + 1, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL AND ` + inProperty("property_id", 2)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
 	if err != nil {
 		return err
 	}
@@ -118,11 +207,14 @@ func (r *roomRepository) Update(ctx context.Context, room *models.Room) error {
 	return nil
 }
 
-// Delete removes a room by its ID
-func (r *roomRepository) Delete(ctx context.Context, id int) error {
-	query := `DELETE FROM rooms WHERE id = $1`
+// SetStatus sets the housekeeping status of a room
+func (r *roomRepository) SetStatus(ctx context.Context, id int, status models.RoomStatus) error {
+	query := `
+		UPDATE rooms
+		SET status = $1, version = version + 1, updated_at = NOW()
+		WHERE id = $2 AND deleted_at IS NULL AND ` + inProperty("property_id", 3)
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, status, id, propertyScope(ctx))
 	if err != nil {
 		return err
 	}
@@ -138,3 +230,43 @@ func (r *roomRepository) Delete(ctx context.Context, id int) error {
 
 	return nil
 }
+
+// Restore clears the deletion mark of a deleted room
+func (r *roomRepository) Restore(ctx context.Context, id int) error {
+	query := `
+		UPDATE rooms
+		SET deleted_at = NULL, version = version + 1, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NOT NULL AND ` + inProperty("property_id", 2)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// Purge permanently removes rooms deleted before the given time. Rooms that
+// still have bookings are kept.
+func (r *roomRepository) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	query := `
+		DELETE FROM rooms
+		WHERE deleted_at < $1
+		AND NOT EXISTS (SELECT 1 FROM bookings WHERE bookings.room_id = rooms.id)`
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, deletedBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,197 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/lib/pq"
+)
+
+// RoomTypeRepository defines the interface for room type data operations
+type RoomTypeRepository interface {
+	Create(ctx context.Context, roomType *models.RoomType) error
+	GetByID(ctx context.Context, id int) (*models.RoomType, error)
+	GetByRoomID(ctx context.Context, roomID int) (*models.RoomType, error)
+	GetAll(ctx context.Context) ([]models.RoomType, error)
+	Update(ctx context.Context, roomType *models.RoomType) error
+	Delete(ctx context.Context, id int) error
+	CountRooms(ctx context.Context, id int) (int, error)
+}
+
+// roomTypeRepository implements RoomTypeRepository
+type roomTypeRepository struct {
+	db DBTX
+}
+
+// NewRoomTypeRepository creates a new room type repository
+func NewRoomTypeRepository(db DBTX) RoomTypeRepository {
+	return &roomTypeRepository{db: db}
+}
+
+const roomTypeColumns = `room_types.id, room_types.name, room_types.capacity, room_types.area,
+		room_types.beds, room_types.amenities, room_types.periodic_cost, room_types.general_cost,
+		room_types.property_id, room_types.version, room_types.updated_at`
+
+// scanRoomType scans a row of roomTypeColumns
+func scanRoomType(row interface{ Scan(...any) error }, roomType *models.RoomType) error {
+	var amenities []string
+	err := row.Scan(
+		&roomType.Id,
+		&roomType.Name,
+		&roomType.Capacity,
+		&roomType.Area,
+		&roomType.Beds,
+		pq.Array(&amenities),
+		&roomType.PeriodicCost,
+		&roomType.GeneralCost,
+		&roomType.PropertyId,
+		&roomType.Version,
+		&roomType.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	if amenities == nil {
+		amenities = []string{}
+	}
+	roomType.Amenities = amenities
+	return nil
+}
+
+// Create inserts a new room type into the database
+func (r *roomTypeRepository) Create(ctx context.Context, roomType *models.RoomType) error {
+	query := `
+		INSERT INTO room_types (name, capacity, area, beds, amenities, periodic_cost, general_cost, property_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, property_id, version, updated_at`
+
+	propertyID, err := requireProperty(ctx)
+	if err != nil {
+		return err
+	}
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
+		roomType.Name,
+		roomType.Capacity,
+		roomType.Area,
+		roomType.Beds,
+		pq.Array(roomType.Amenities),
+		roomType.PeriodicCost,
+		roomType.GeneralCost,
+		propertyID,
+	).Scan(&roomType.Id, &roomType.PropertyId, &roomType.Version, &roomType.UpdatedAt)
+}
+
+// GetByID retrieves a room type by its ID
+func (r *roomTypeRepository) GetByID(ctx context.Context, id int) (*models.RoomType, error) {
+	query := `SELECT ` + roomTypeColumns + `
+		FROM room_types
+		WHERE id = $1 AND ` + inProperty("property_id", 2)
+
+	roomType := &models.RoomType{}
+	if err := scanRoomType(conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)), roomType); err != nil {
+		return nil, err
+	}
+
+	return roomType, nil
+}
+
+// GetByRoomID retrieves the type of a room, deleted rooms included. Returns
+// sql.ErrNoRows if the room has no type.
+func (r *roomTypeRepository) GetByRoomID(ctx context.Context, roomID int) (*models.RoomType, error) {
+	query := `SELECT ` + roomTypeColumns + `
+		FROM room_types
+		JOIN rooms ON rooms.room_type_id = room_types.id
+		WHERE rooms.id = $1 AND ` + inProperty("room_types.property_id", 2)
+
+	roomType := &models.RoomType{}
+	if err := scanRoomType(conn(ctx, r.db).QueryRowContext(ctx, query, roomID, propertyScope(ctx)), roomType); err != nil {
+		return nil, err
+	}
+
+	return roomType, nil
+}
+
+// GetAll retrieves all room types
+func (r *roomTypeRepository) GetAll(ctx context.Context) ([]models.RoomType, error) {
+	query := `SELECT ` + roomTypeColumns + `
+		FROM room_types
+		WHERE ` + inProperty("property_id", 1) + `
+		ORDER BY name`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query, propertyScope(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roomTypes []models.RoomType
+	for rows.Next() {
+		var roomType models.RoomType
+		if err := scanRoomType(rows, &roomType); err != nil {
+			return nil, err
+		}
+		roomTypes = append(roomTypes, roomType)
+	}
+
+	return roomTypes, nil
+}
+
+// Update modifies a room type if its version matches
+func (r *roomTypeRepository) Update(ctx context.Context, roomType *models.RoomType) error {
+	query := `
+		UPDATE room_types
+		SET name = $1, capacity = $2, area = $3, beds = $4, amenities = $5,
+		periodic_cost = $6, general_cost = $7, version = version + 1, updated_at = NOW()
+		WHERE id = $8 AND version = $9 AND ` + inProperty("property_id", 10) + `
+		RETURNING version, updated_at`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		roomType.Name,
+		roomType.Capacity,
+		roomType.Area,
+		roomType.Beds,
+		pq.Array(roomType.Amenities),
+		roomType.PeriodicCost,
+		roomType.GeneralCost,
+		roomType.Id,
+		roomType.Version,
+		propertyScope(ctx),
+	).Scan(&roomType.Version, &roomType.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "room_types", roomType.Id)
+	}
+
+	return err
+}
+
+// Delete removes a room type
+func (r *roomTypeRepository) Delete(ctx context.Context, id int) error {
+	query := `DELETE FROM room_types WHERE id = $1 AND ` + inProperty("property_id", 2)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// CountRooms counts the rooms of a type, deleted ones included
+func (r *roomTypeRepository) CountRooms(ctx context.Context, id int) (int, error) {
+	query := `SELECT COUNT(*) FROM rooms WHERE room_type_id = $1`
+
+	var count int
+	err := conn(ctx, r.db).QueryRowContext(ctx, query, id).Scan(&count)
+	return count, err
+}
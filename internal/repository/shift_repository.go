@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// ShiftFilter selects shifts, nil fields match everything
+type ShiftFilter struct {
+	CleanerID *int
+	// From and To select the shifts overlapping the period
+	From *time.Time
+	To   *time.Time
+}
+
+// ShiftRepository defines the interface for shift data operations
+type ShiftRepository interface {
+	Create(ctx context.Context, shift *models.Shift) error
+	GetByID(ctx context.Context, id int) (*models.Shift, error)
+	GetAll(ctx context.Context, filter ShiftFilter) ([]models.Shift, error)
+	Update(ctx context.Context, shift *models.Shift) error
+	Delete(ctx context.Context, id int) error
+	CountOverlapping(ctx context.Context, shift *models.Shift) (int, error)
+}
+
+// shiftRepository implements ShiftRepository
+type shiftRepository struct {
+	db DBTX
+}
+
+// NewShiftRepository creates a new shift repository
+func NewShiftRepository(db DBTX) ShiftRepository {
+	return &shiftRepository{db: db}
+}
+
+const shiftColumns = `id, cleaner_id, start_ts, end_ts, notes, version, updated_at`
+
+// scanShift scans a row of shiftColumns
+func scanShift(row interface{ Scan(...any) error }, shift *models.Shift) error {
+	return row.Scan(
+		&shift.Id,
+		&shift.CleanerId,
+		&shift.StartTs,
+		&shift.EndTs,
+		&shift.Notes,
+		&shift.Version,
+		&shift.UpdatedAt,
+	)
+}
+
+// Create inserts a new shift into the database
+func (r *shiftRepository) Create(ctx context.Context, shift *models.Shift) error {
+	query := `
+		INSERT INTO shifts (cleaner_id, start_ts, end_ts, notes)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, version, updated_at`
+
+	return conn(ctx, r.db).QueryRowContext(ctx, query,
+		shift.CleanerId,
+		shift.StartTs,
+		shift.EndTs,
+		shift.Notes,
+	).Scan(&shift.Id, &shift.Version, &shift.UpdatedAt)
+}
+
+// GetByID retrieves a shift by its ID
+func (r *shiftRepository) GetByID(ctx context.Context, id int) (*models.Shift, error) {
+	query := `SELECT ` + shiftColumns + `
+		FROM shifts
+		WHERE id = $1 AND ` + cleanerInProperty("cleaner_id", 2)
+
+	shift := &models.Shift{}
+	if err := scanShift(conn(ctx, r.db).QueryRowContext(ctx, query, id, propertyScope(ctx)), shift); err != nil {
+		return nil, err
+	}
+
+	return shift, nil
+}
+
+// GetAll retrieves the shifts of not deleted cleaners matching the filter
+func (r *shiftRepository) GetAll(ctx context.Context, filter ShiftFilter) ([]models.Shift, error) {
+	query := `SELECT ` + shiftColumns + `
+		FROM shifts
+		WHERE ($1::int IS NULL OR cleaner_id = $1)
+		AND ($2::timestamp IS NULL OR end_ts > $2)
+		AND ($3::timestamp IS NULL OR start_ts < $3)
+		AND cleaner_id IN (SELECT id FROM cleaners WHERE deleted_at IS NULL)
+		AND ` + cleanerInProperty("cleaner_id", 4) + `
+		ORDER BY start_ts, cleaner_id`
+
+	rows, err := conn(ctx, r.db).QueryContext(ctx, query,
+		filter.CleanerID,
+		filter.From,
+		filter.To,
+		propertyScope(ctx),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	shifts := []models.Shift{}
+	for rows.Next() {
+		var shift models.Shift
+		if err := scanShift(rows, &shift); err != nil {
+			return nil, err
+		}
+		shifts = append(shifts, shift)
+	}
+
+	return shifts, nil
+}
+
+// Update modifies a shift if its version matches
+func (r *shiftRepository) Update(ctx context.Context, shift *models.Shift) error {
+	query := `
+		UPDATE shifts
+		SET cleaner_id = $1, start_ts = $2, end_ts = $3, notes = $4, version = version + 1, updated_at = NOW()
+		WHERE id = $5 AND version = $6
+		RETURNING version, updated_at`
+
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		shift.CleanerId,
+		shift.StartTs,
+		shift.EndTs,
+		shift.Notes,
+		shift.Id,
+		shift.Version,
+	).Scan(&shift.Version, &shift.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return updateError(ctx, r.db, "shifts", shift.Id)
+	}
+
+	return err
+}
+
+// Delete removes a shift
+func (r *shiftRepository) Delete(ctx context.Context, id int) error {
+	query := `DELETE FROM shifts WHERE id = $1 AND ` + cleanerInProperty("cleaner_id", 2)
+
+	result, err := conn(ctx, r.db).ExecContext(ctx, query, id, propertyScope(ctx))
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// CountOverlapping counts the other shifts of the cleaner of a shift that
+// overlap it
+func (r *shiftRepository) CountOverlapping(ctx context.Context, shift *models.Shift) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM shifts
+		WHERE cleaner_id = $1 AND id <> $2
+		AND start_ts < $4 AND end_ts > $3`
+
+	var count int
+	err := conn(ctx, r.db).QueryRowContext(ctx, query,
+		shift.CleanerId,
+		shift.Id,
+		shift.StartTs,
+		shift.EndTs,
+	).Scan(&count)
+	return count, err
+}
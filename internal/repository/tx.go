@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// Transactor runs functions in a database transaction. Repositories called
+// with the context passed to fn run their queries in that transaction.
+type Transactor interface {
+	// WithinTx runs fn in a transaction committed when fn returns nil and
+	// rolled back otherwise. When ctx already carries a transaction fn joins it.
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+	// WithinSavepoint runs fn in a savepoint of the transaction carried by ctx,
+	// so that a failure of fn only rolls back its own changes. Without a
+	// transaction it behaves like WithinTx.
+	WithinSavepoint(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type txKey struct{}
+
+// txState is the transaction carried by a context
+type txState struct {
+	tx         DBTX
+	savepoints int
+}
+
+// transactor implements Transactor
+type transactor struct {
+	db    *sql.DB
+	hooks []QueryHook
+}
+
+// NewTransactor creates a transactor; queries run in its transactions go
+// through the given hooks
+func NewTransactor(db *sql.DB, hooks ...QueryHook) Transactor {
+	return &transactor{db: db, hooks: hooks}
+}
+
+// WithinTx runs fn in a transaction
+func (t *transactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*txState); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	txCtx := context.WithValue(ctx, txKey{}, &txState{tx: Instrument(tx, t.hooks...)})
+	if err := fn(txCtx); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("%w (rollback failed: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// WithinSavepoint runs fn in a savepoint of the current transaction
+func (t *transactor) WithinSavepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	state, ok := ctx.Value(txKey{}).(*txState)
+	if !ok {
+		return t.WithinTx(ctx, fn)
+	}
+
+	state.savepoints++
+	name := fmt.Sprintf("sp_%d", state.savepoints)
+
+	if _, err := state.tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("failed to create savepoint: %w", err)
+	}
+
+	if err := fn(ctx); err != nil {
+		if _, rbErr := state.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rbErr != nil {
+			return fmt.Errorf("%w (rollback to savepoint failed: %v)", err, rbErr)
+		}
+		return err
+	}
+
+	if _, err := state.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("failed to release savepoint: %w", err)
+	}
+	return nil
+}
+
+// conn returns the transaction carried by ctx, or db outside a transaction
+func conn(ctx context.Context, db DBTX) DBTX {
+	if state, ok := ctx.Value(txKey{}).(*txState); ok {
+		return state.tx
+	}
+	return db
+}
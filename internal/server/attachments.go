@@ -0,0 +1,91 @@
+package server
+
+import (
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/StEvseeva/cleany/internal/service"
+	"github.com/labstack/echo/v4"
+)
+
+// GetCleaningOrdersIdAttachments returns the files attached to a cleaning order
+func (s *Server) GetCleaningOrdersIdAttachments(ctx echo.Context, id int) error {
+	attachments, err := s.service.GetAttachments(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, attachments)
+}
+
+// PostCleaningOrdersIdAttachments attaches an uploaded file to a cleaning order
+func (s *Server) PostCleaningOrdersIdAttachments(ctx echo.Context, id int) error {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	upload := &service.AttachmentUpload{
+		Filename: fileHeader.Filename,
+	}
+	if description := ctx.FormValue("description"); description != "" {
+		upload.Description = &description
+	}
+	if value := ctx.FormValue("cleaner_id"); value != "" {
+		cleanerID, err := strconv.Atoi(value)
+		if err != nil {
+			return ctx.JSON(http.StatusBadRequest, map[string]string{"error": "cleaner_id must be an integer"})
+		}
+		upload.CleanerId = &cleanerID
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	defer file.Close()
+	upload.Content = file
+
+	attachment, err := s.service.UploadAttachment(ctx.Request().Context(), id, upload)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	return ctx.JSON(http.StatusCreated, attachment)
+}
+
+// GetCleaningOrdersIdAttachmentsAttachmentId downloads an attached file
+func (s *Server) GetCleaningOrdersIdAttachmentsAttachmentId(ctx echo.Context, id int, attachmentId int) error {
+	attachment, content, err := s.service.OpenAttachment(ctx.Request().Context(), id, attachmentId)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	defer content.Close()
+
+	header := ctx.Response().Header()
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+	header.Set(echo.HeaderContentLength, strconv.FormatInt(attachment.Size, 10))
+	return ctx.Stream(http.StatusOK, attachment.ContentType, content)
+}
+
+// GetCleaningOrdersIdAttachmentsAttachmentIdThumbnail downloads the thumbnail
+// of an attached image
+func (s *Server) GetCleaningOrdersIdAttachmentsAttachmentIdThumbnail(ctx echo.Context, id int, attachmentId int) error {
+	content, err := s.service.OpenAttachmentThumbnail(ctx.Request().Context(), id, attachmentId)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	defer content.Close()
+
+	return ctx.Stream(http.StatusOK, "image/jpeg", content)
+}
+
+// DeleteCleaningOrdersIdAttachmentsAttachmentId removes an attached file
+func (s *Server) DeleteCleaningOrdersIdAttachmentsAttachmentId(ctx echo.Context, id int, attachmentId int) error {
+	err := s.service.DeleteAttachment(ctx.Request().Context(), id, attachmentId)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -4,12 +4,17 @@ import (
 	"net/http"
 
 	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
 	"github.com/labstack/echo/v4"
 )
 
 // GetBookings returns all bookings
-func (s *Server) GetBookings(ctx echo.Context) error {
-	bookings, err := s.service.GetAllBookings(ctx.Request().Context())
+func (s *Server) GetBookings(ctx echo.Context, params models.GetBookingsParams) error {
+	filter := repository.BookingFilter{
+		IncludeDeleted: params.IncludeDeleted != nil && *params.IncludeDeleted,
+		Guest:          params.Guest,
+	}
+	bookings, err := s.service.GetAllBookings(ctx.Request().Context(), filter)
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
@@ -25,9 +30,10 @@ func (s *Server) PostBookings(ctx echo.Context) error {
 
 	booking, err := s.service.CreateBooking(ctx.Request().Context(), &req)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
 	}
 
+	setETag(ctx, booking.Version)
 	return ctx.JSON(http.StatusCreated, booking)
 }
 
@@ -42,26 +48,145 @@ func (s *Server) DeleteBookingsId(ctx echo.Context, id int) error {
 }
 
 // GetBookingsId returns a booking by ID
-func (s *Server) GetBookingsId(ctx echo.Context, id int) error {
-	booking, err := s.service.GetBooking(ctx.Request().Context(), id)
+func (s *Server) GetBookingsId(ctx echo.Context, id int, params models.GetBookingsIdParams) error {
+	booking, err := s.service.GetBooking(ctx.Request().Context(), id, params.IncludeDeleted != nil && *params.IncludeDeleted)
 	if err != nil {
 		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
 	}
 
+	setETag(ctx, booking.Version)
 	return ctx.JSON(http.StatusOK, booking)
 }
 
 // PutBookingsId updates a booking by ID
-func (s *Server) PutBookingsId(ctx echo.Context, id int) error {
+func (s *Server) PutBookingsId(ctx echo.Context, id int, params models.PutBookingsIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
 	var req models.BookingUpdateRequest
 	if err := ctx.Bind(&req); err != nil {
 		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
-	booking, err := s.service.UpdateBooking(ctx.Request().Context(), id, &req)
+	booking, err := s.service.UpdateBooking(ctx.Request().Context(), id, &req, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, booking.Version)
+	return ctx.JSON(http.StatusOK, booking)
+}
+
+// PatchBookingsId applies a JSON merge patch to a booking by ID
+func (s *Server) PatchBookingsId(ctx echo.Context, id int, params models.PatchBookingsIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
 	if err != nil {
 		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
+	patch, err := readMergePatch(ctx)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	booking, err := s.service.PatchBooking(ctx.Request().Context(), id, patch, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, booking.Version)
+	return ctx.JSON(http.StatusOK, booking)
+}
+
+// PostBookingsIdRestore restores a deleted booking by ID
+func (s *Server) PostBookingsIdRestore(ctx echo.Context, id int) error {
+	booking, err := s.service.RestoreBooking(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, booking.Version)
+	return ctx.JSON(http.StatusOK, booking)
+}
+
+// PostBookingsIdCancel cancels a booking by ID
+func (s *Server) PostBookingsIdCancel(ctx echo.Context, id int) error {
+	var req models.BookingCancelRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	response, err := s.service.CancelBooking(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, response.Booking.Version)
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// PostBookingsIdCheckIn records the check-in of a booking by ID
+func (s *Server) PostBookingsIdCheckIn(ctx echo.Context, id int) error {
+	var req models.BookingEventRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	booking, err := s.service.CheckInBooking(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, booking.Version)
+	return ctx.JSON(http.StatusOK, booking)
+}
+
+// PostBookingsIdCheckOut records the check-out of a booking by ID
+func (s *Server) PostBookingsIdCheckOut(ctx echo.Context, id int) error {
+	var req models.BookingEventRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	response, err := s.service.CheckOutBooking(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, response.Booking.Version)
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// PutBookingsIdServicePreferences sets the service preferences of a booking by ID
+func (s *Server) PutBookingsIdServicePreferences(ctx echo.Context, id int, params models.PutBookingsIdServicePreferencesParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	var req models.ServicePreferences
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	booking, err := s.service.SetServicePreferences(ctx.Request().Context(), id, &req, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, booking.Version)
+	return ctx.JSON(http.StatusOK, booking)
+}
+
+// PostBookingsIdAnonymize erases the personal data of the guest of a booking by ID
+func (s *Server) PostBookingsIdAnonymize(ctx echo.Context, id int) error {
+	booking, err := s.service.AnonymizeBooking(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, booking.Version)
 	return ctx.JSON(http.StatusOK, booking)
 }
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// GetChecklistTemplates returns the checklist templates
+func (s *Server) GetChecklistTemplates(ctx echo.Context, params models.GetChecklistTemplatesParams) error {
+	items, err := s.service.GetChecklistTemplateItems(ctx.Request().Context(), params.CleaningType)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, items)
+}
+
+// PostChecklistTemplates adds an item to the checklist of a cleaning type
+func (s *Server) PostChecklistTemplates(ctx echo.Context) error {
+	var req models.ChecklistTemplateItemCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	item, err := s.service.CreateChecklistTemplateItem(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	return ctx.JSON(http.StatusCreated, item)
+}
+
+// DeleteChecklistTemplatesId removes an item from the checklist of a cleaning type
+func (s *Server) DeleteChecklistTemplatesId(ctx echo.Context, id int) error {
+	err := s.service.DeleteChecklistTemplateItem(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetCleaningOrdersIdChecklist returns the checklist of a cleaning order
+func (s *Server) GetCleaningOrdersIdChecklist(ctx echo.Context, id int) error {
+	items, err := s.service.GetOrderChecklist(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, items)
+}
+
+// PutCleaningOrdersIdChecklistItemId ticks or unticks a checklist item
+func (s *Server) PutCleaningOrdersIdChecklistItemId(ctx echo.Context, id int, itemId int) error {
+	var req models.ChecklistItemCheckRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	item, err := s.service.CheckOrderChecklistItem(ctx.Request().Context(), id, itemId, &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	return ctx.JSON(http.StatusOK, item)
+}
@@ -8,8 +8,8 @@ import (
 )
 
 // GetCleaners returns all cleaners
-func (s *Server) GetCleaners(ctx echo.Context) error {
-	cleaners, err := s.service.GetAllCleaners(ctx.Request().Context())
+func (s *Server) GetCleaners(ctx echo.Context, params models.GetCleanersParams) error {
+	cleaners, err := s.service.GetAllCleaners(ctx.Request().Context(), params.IncludeDeleted != nil && *params.IncludeDeleted)
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
@@ -28,6 +28,7 @@ func (s *Server) PostCleaners(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
+	setETag(ctx, cleaner.Version)
 	return ctx.JSON(http.StatusCreated, cleaner)
 }
 
@@ -42,26 +43,65 @@ func (s *Server) DeleteCleanersId(ctx echo.Context, id int) error {
 }
 
 // GetCleanersId returns a cleaner by ID
-func (s *Server) GetCleanersId(ctx echo.Context, id int) error {
-	cleaner, err := s.service.GetCleaner(ctx.Request().Context(), id)
+func (s *Server) GetCleanersId(ctx echo.Context, id int, params models.GetCleanersIdParams) error {
+	cleaner, err := s.service.GetCleaner(ctx.Request().Context(), id, params.IncludeDeleted != nil && *params.IncludeDeleted)
 	if err != nil {
 		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
 	}
 
+	setETag(ctx, cleaner.Version)
 	return ctx.JSON(http.StatusOK, cleaner)
 }
 
 // PutCleanersId updates a cleaner by ID
-func (s *Server) PutCleanersId(ctx echo.Context, id int) error {
+func (s *Server) PutCleanersId(ctx echo.Context, id int, params models.PutCleanersIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
 	var req models.CleanerUpdateRequest
 	if err := ctx.Bind(&req); err != nil {
 		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
-	cleaner, err := s.service.UpdateCleaner(ctx.Request().Context(), id, &req)
+	cleaner, err := s.service.UpdateCleaner(ctx.Request().Context(), id, &req, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, cleaner.Version)
+	return ctx.JSON(http.StatusOK, cleaner)
+}
+
+// PatchCleanersId applies a JSON merge patch to a cleaner by ID
+func (s *Server) PatchCleanersId(ctx echo.Context, id int, params models.PatchCleanersIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
 	if err != nil {
 		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
+	patch, err := readMergePatch(ctx)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	cleaner, err := s.service.PatchCleaner(ctx.Request().Context(), id, patch, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, cleaner.Version)
+	return ctx.JSON(http.StatusOK, cleaner)
+}
+
+// PostCleanersIdRestore restores a deleted cleaner by ID
+func (s *Server) PostCleanersIdRestore(ctx echo.Context, id int) error {
+	cleaner, err := s.service.RestoreCleaner(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, cleaner.Version)
 	return ctx.JSON(http.StatusOK, cleaner)
 }
@@ -2,14 +2,30 @@ package server
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
 	"github.com/labstack/echo/v4"
 )
 
-// GetCleaningOrders returns all cleaning orders
-func (s *Server) GetCleaningOrders(ctx echo.Context) error {
-	orders, err := s.service.GetAllCleaningOrders(ctx.Request().Context())
+// GetCleaningOrders returns all cleaning orders, optionally only those of
+// rooms in a zone, floor or building
+func (s *Server) GetCleaningOrders(ctx echo.Context, params models.GetCleaningOrdersParams) error {
+	filter := repository.CleaningOrderFilter{
+		ZoneID:     params.ZoneId,
+		FloorID:    params.FloorId,
+		BuildingID: params.BuildingId,
+	}
+	var sortBy string
+	if params.Sort != nil {
+		sortBy = string(*params.Sort)
+	}
+	if !validOrderSort(sortBy) {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": "sort must be id, cleaning_ts or priority"})
+	}
+
+	orders, err := s.service.GetAllCleaningOrders(ctx.Request().Context(), filter, sortBy)
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
@@ -25,9 +41,10 @@ func (s *Server) PostCleaningOrders(ctx echo.Context) error {
 
 	order, err := s.service.CreateCleaningOrder(ctx.Request().Context(), &req)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
 	}
 
+	setETag(ctx, order.Version)
 	return ctx.JSON(http.StatusCreated, order)
 }
 
@@ -48,21 +65,66 @@ func (s *Server) GetCleaningOrdersId(ctx echo.Context, id int) error {
 		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
 	}
 
+	setETag(ctx, order.Version)
 	return ctx.JSON(http.StatusOK, order)
 }
 
 // PutCleaningOrdersId updates a cleaning order by ID
-func (s *Server) PutCleaningOrdersId(ctx echo.Context, id int) error {
+func (s *Server) PutCleaningOrdersId(ctx echo.Context, id int, params models.PutCleaningOrdersIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
 	var req models.CleaningOrderUpdateRequest
 	if err := ctx.Bind(&req); err != nil {
 		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
-	order, err := s.service.UpdateCleaningOrder(ctx.Request().Context(), id, &req)
+	order, err := s.service.UpdateCleaningOrder(ctx.Request().Context(), id, &req, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, order.Version)
+	return ctx.JSON(http.StatusOK, order)
+}
+
+// PatchCleaningOrdersId applies a JSON merge patch to a cleaning order by ID
+func (s *Server) PatchCleaningOrdersId(ctx echo.Context, id int, params models.PatchCleaningOrdersIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	patch, err := readMergePatch(ctx)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	order, err := s.service.PatchCleaningOrder(ctx.Request().Context(), id, patch, ifMatch)
 	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, order.Version)
+	return ctx.JSON(http.StatusOK, order)
+}
+
+// PutCleaningOrdersIdPriority sets or clears the manual priority of a
+// cleaning order
+func (s *Server) PutCleaningOrdersIdPriority(ctx echo.Context, id int) error {
+	var req models.CleaningOrderPriorityRequest
+	if err := ctx.Bind(&req); err != nil {
 		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
+	order, err := s.service.SetCleaningOrderPriority(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, order.Version)
 	return ctx.JSON(http.StatusOK, order)
 }
 
@@ -75,7 +137,7 @@ func (s *Server) PostCleaningOrdersIdCleaners(ctx echo.Context, id int) error {
 
 	err := s.service.AssignCleaner(ctx.Request().Context(), id, &req)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
 	}
 
 	return ctx.JSON(http.StatusOK, map[string]string{"message": "cleaner assigned"})
@@ -92,10 +154,76 @@ func (s *Server) DeleteCleaningOrdersIdCleanersCleanerId(ctx echo.Context, id in
 }
 
 // PutCleanersId updates a cleaner by ID
-func (s *Server) GetCleanersIdCleaningOrders(ctx echo.Context, id int) error {
-	orders, err := s.service.GetAllCleaningOrdersByCleanerId(ctx.Request().Context(), id)
+func (s *Server) GetCleanersIdCleaningOrders(ctx echo.Context, id int, params models.GetCleanersIdCleaningOrdersParams) error {
+	var sortBy string
+	if params.Sort != nil {
+		sortBy = string(*params.Sort)
+	}
+	if !validOrderSort(sortBy) {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": "sort must be id, cleaning_ts or priority"})
+	}
+
+	orders, err := s.service.GetAllCleaningOrdersByCleanerId(ctx.Request().Context(), id, sortBy)
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 	return ctx.JSON(http.StatusOK, orders)
 }
+
+// GetCleanersIdRoute returns the suggested sequence of the open orders of a
+// cleaner for a day
+func (s *Server) GetCleanersIdRoute(ctx echo.Context, id int, params models.GetCleanersIdRouteParams) error {
+	var day *time.Time
+	if params.Date != nil {
+		day = &params.Date.Time
+	}
+
+	route, err := s.service.GetCleanerRoute(ctx.Request().Context(), id, day)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, route)
+}
+
+// PostCleaningOrdersBulk runs a batch of cleaning order operations
+func (s *Server) PostCleaningOrdersBulk(ctx echo.Context) error {
+	var req models.CleaningOrderBulkRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	response, err := s.service.BulkCleaningOrders(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	if response.Mode == string(models.CleaningOrderBulkRequestModeAtomic) && response.Failed > 0 {
+		return ctx.JSON(http.StatusUnprocessableEntity, response)
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// PostCleaningOrdersIdDnd records a Do Not Disturb sign for a cleaning order
+func (s *Server) PostCleaningOrdersIdDnd(ctx echo.Context, id int) error {
+	var req models.DndSkipRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	response, err := s.service.SkipCleaningOrderForDnd(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// validOrderSort reports whether a list of cleaning orders can be sorted by
+// the key, the empty key keeps the default order
+func validOrderSort(key string) bool {
+	switch key {
+	case "", "id", "cleaning_ts", "priority":
+		return true
+	}
+	return false
+}
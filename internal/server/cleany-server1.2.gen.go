@@ -13,6 +13,7 @@ import (
 	"path"
 	"strings"
 
+	. "github.com/StEvseeva/cleany/internal/models"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
@@ -22,7 +23,7 @@ import (
 type ServerInterface interface {
 	// List all bookings
 	// (GET /bookings)
-	GetBookings(ctx echo.Context) error
+	GetBookings(ctx echo.Context, params GetBookingsParams) error
 	// Create a new booking
 	// (POST /bookings)
 	PostBookings(ctx echo.Context) error
@@ -31,13 +32,58 @@ type ServerInterface interface {
 	DeleteBookingsId(ctx echo.Context, id int) error
 	// Get booking by ID
 	// (GET /bookings/{id})
-	GetBookingsId(ctx echo.Context, id int) error
+	GetBookingsId(ctx echo.Context, id int, params GetBookingsIdParams) error
+	// Partially update booking (JSON merge patch)
+	// (PATCH /bookings/{id})
+	PatchBookingsId(ctx echo.Context, id int, params PatchBookingsIdParams) error
 	// Update booking
 	// (PUT /bookings/{id})
-	PutBookingsId(ctx echo.Context, id int) error
+	PutBookingsId(ctx echo.Context, id int, params PutBookingsIdParams) error
+	// Erase the personal data of the guest
+	// (POST /bookings/{id}/anonymize)
+	PostBookingsIdAnonymize(ctx echo.Context, id int) error
+	// Cancel booking
+	// (POST /bookings/{id}/cancel)
+	PostBookingsIdCancel(ctx echo.Context, id int) error
+	// Record guest check-in
+	// (POST /bookings/{id}/check_in)
+	PostBookingsIdCheckIn(ctx echo.Context, id int) error
+	// Record guest check-out
+	// (POST /bookings/{id}/check_out)
+	PostBookingsIdCheckOut(ctx echo.Context, id int) error
+	// Restore deleted booking
+	// (POST /bookings/{id}/restore)
+	PostBookingsIdRestore(ctx echo.Context, id int) error
+	// Set the service preferences of a booking
+	// (PUT /bookings/{id}/service_preferences)
+	PutBookingsIdServicePreferences(ctx echo.Context, id int, params PutBookingsIdServicePreferencesParams) error
+	// List buildings
+	// (GET /buildings)
+	GetBuildings(ctx echo.Context) error
+	// Add a building
+	// (POST /buildings)
+	PostBuildings(ctx echo.Context) error
+	// Delete a building
+	// (DELETE /buildings/{id})
+	DeleteBuildingsId(ctx echo.Context, id int) error
+	// Get building by ID
+	// (GET /buildings/{id})
+	GetBuildingsId(ctx echo.Context, id int) error
+	// Update a building (JSON merge patch)
+	// (PATCH /buildings/{id})
+	PatchBuildingsId(ctx echo.Context, id int, params PatchBuildingsIdParams) error
+	// List checklist template items
+	// (GET /checklist_templates)
+	GetChecklistTemplates(ctx echo.Context, params GetChecklistTemplatesParams) error
+	// Add an item to the checklist of a cleaning type
+	// (POST /checklist_templates)
+	PostChecklistTemplates(ctx echo.Context) error
+	// Remove an item from the checklist of a cleaning type
+	// (DELETE /checklist_templates/{id})
+	DeleteChecklistTemplatesId(ctx echo.Context, id int) error
 	// List all cleaners
 	// (GET /cleaners)
-	GetCleaners(ctx echo.Context) error
+	GetCleaners(ctx echo.Context, params GetCleanersParams) error
 	// Create a new cleaner
 	// (POST /cleaners)
 	PostCleaners(ctx echo.Context) error
@@ -46,37 +92,253 @@ type ServerInterface interface {
 	DeleteCleanersId(ctx echo.Context, id int) error
 	// Get cleaner by ID
 	// (GET /cleaners/{id})
-	GetCleanersId(ctx echo.Context, id int) error
+	GetCleanersId(ctx echo.Context, id int, params GetCleanersIdParams) error
+	// Partially update cleaner (JSON merge patch)
+	// (PATCH /cleaners/{id})
+	PatchCleanersId(ctx echo.Context, id int, params PatchCleanersIdParams) error
 	// Update cleaner
 	// (PUT /cleaners/{id})
-	PutCleanersId(ctx echo.Context, id int) error
+	PutCleanersId(ctx echo.Context, id int, params PutCleanersIdParams) error
 	// Get all cleaning orders by cleaner ID
 	// (GET /cleaners/{id}/cleaning_orders)
-	GetCleanersIdCleaningOrders(ctx echo.Context, id int) error
+	GetCleanersIdCleaningOrders(ctx echo.Context, id int, params GetCleanersIdCleaningOrdersParams) error
+	// Restore deleted cleaner
+	// (POST /cleaners/{id}/restore)
+	PostCleanersIdRestore(ctx echo.Context, id int) error
+	// Suggested sequence of the open cleaning orders of a cleaner for a day
+	// (GET /cleaners/{id}/route)
+	GetCleanersIdRoute(ctx echo.Context, id int, params GetCleanersIdRouteParams) error
 	// List all cleaning orders
 	// (GET /cleaning_orders)
-	GetCleaningOrders(ctx echo.Context) error
+	GetCleaningOrders(ctx echo.Context, params GetCleaningOrdersParams) error
 	// Create a new cleaning order
 	// (POST /cleaning_orders)
 	PostCleaningOrders(ctx echo.Context) error
+	// Run a batch of cleaning order operations in a single transaction
+	// (POST /cleaning_orders/bulk)
+	PostCleaningOrdersBulk(ctx echo.Context) error
 	// Delete cleaning order
 	// (DELETE /cleaning_orders/{id})
 	DeleteCleaningOrdersId(ctx echo.Context, id int) error
 	// Get cleaning order by ID
 	// (GET /cleaning_orders/{id})
 	GetCleaningOrdersId(ctx echo.Context, id int) error
+	// Partially update cleaning order (JSON merge patch)
+	// (PATCH /cleaning_orders/{id})
+	PatchCleaningOrdersId(ctx echo.Context, id int, params PatchCleaningOrdersIdParams) error
 	// Update cleaning order
 	// (PUT /cleaning_orders/{id})
-	PutCleaningOrdersId(ctx echo.Context, id int) error
+	PutCleaningOrdersId(ctx echo.Context, id int, params PutCleaningOrdersIdParams) error
+	// List files attached to a cleaning order
+	// (GET /cleaning_orders/{id}/attachments)
+	GetCleaningOrdersIdAttachments(ctx echo.Context, id int) error
+	// Attach a file to a cleaning order
+	// (POST /cleaning_orders/{id}/attachments)
+	PostCleaningOrdersIdAttachments(ctx echo.Context, id int) error
+	// Remove an attached file
+	// (DELETE /cleaning_orders/{id}/attachments/{attachmentId})
+	DeleteCleaningOrdersIdAttachmentsAttachmentId(ctx echo.Context, id int, attachmentId int) error
+	// Download an attached file
+	// (GET /cleaning_orders/{id}/attachments/{attachmentId})
+	GetCleaningOrdersIdAttachmentsAttachmentId(ctx echo.Context, id int, attachmentId int) error
+	// Download the thumbnail of an attached image
+	// (GET /cleaning_orders/{id}/attachments/{attachmentId}/thumbnail)
+	GetCleaningOrdersIdAttachmentsAttachmentIdThumbnail(ctx echo.Context, id int, attachmentId int) error
+	// Get the checklist of a cleaning order
+	// (GET /cleaning_orders/{id}/checklist)
+	GetCleaningOrdersIdChecklist(ctx echo.Context, id int) error
+	// Tick or untick a checklist item
+	// (PUT /cleaning_orders/{id}/checklist/{itemId})
+	PutCleaningOrdersIdChecklistItemId(ctx echo.Context, id int, itemId int) error
 	// Assign cleaner to cleaning order
 	// (POST /cleaning_orders/{id}/cleaners)
 	PostCleaningOrdersIdCleaners(ctx echo.Context, id int) error
 	// Remove cleaner from cleaning order
 	// (DELETE /cleaning_orders/{id}/cleaners/{cleanerId})
 	DeleteCleaningOrdersIdCleanersCleanerId(ctx echo.Context, id int, cleanerId int) error
+	// Get the consumption recorded for a cleaning order
+	// (GET /cleaning_orders/{id}/consumption)
+	GetCleaningOrdersIdConsumption(ctx echo.Context, id int) error
+	// Record what a cleaning order consumed
+	// (POST /cleaning_orders/{id}/consumption)
+	PostCleaningOrdersIdConsumption(ctx echo.Context, id int) error
+	// Record a Do Not Disturb sign at the door
+	// (POST /cleaning_orders/{id}/dnd)
+	PostCleaningOrdersIdDnd(ctx echo.Context, id int) error
+	// List inspections of a cleaning order
+	// (GET /cleaning_orders/{id}/inspections)
+	GetCleaningOrdersIdInspections(ctx echo.Context, id int) error
+	// Inspect a done cleaning order
+	// (POST /cleaning_orders/{id}/inspections)
+	PostCleaningOrdersIdInspections(ctx echo.Context, id int) error
+	// Set or clear the manual priority of a cleaning order
+	// (PUT /cleaning_orders/{id}/priority)
+	PutCleaningOrdersIdPriority(ctx echo.Context, id int) error
+	// List floors
+	// (GET /floors)
+	GetFloors(ctx echo.Context, params GetFloorsParams) error
+	// Add a floor
+	// (POST /floors)
+	PostFloors(ctx echo.Context) error
+	// Delete a floor
+	// (DELETE /floors/{id})
+	DeleteFloorsId(ctx echo.Context, id int) error
+	// Get floor by ID
+	// (GET /floors/{id})
+	GetFloorsId(ctx echo.Context, id int) error
+	// Update a floor (JSON merge patch)
+	// (PATCH /floors/{id})
+	PatchFloorsId(ctx echo.Context, id int, params PatchFloorsIdParams) error
+	// List inspection checklist items
+	// (GET /inspection_items)
+	GetInspectionItems(ctx echo.Context) error
+	// Add an inspection checklist item
+	// (POST /inspection_items)
+	PostInspectionItems(ctx echo.Context) error
+	// Remove an inspection checklist item
+	// (DELETE /inspection_items/{id})
+	DeleteInspectionItemsId(ctx echo.Context, id int) error
+	// Get inspection by ID
+	// (GET /inspections/{id})
+	GetInspectionsId(ctx echo.Context, id int) error
+	// List the default consumption of cleaning types
+	// (GET /inventory/defaults)
+	GetInventoryDefaults(ctx echo.Context, params GetInventoryDefaultsParams) error
+	// Set how much of an item a cleaning type consumes
+	// (POST /inventory/defaults)
+	PostInventoryDefaults(ctx echo.Context) error
+	// Remove an item from the default consumption of a cleaning type
+	// (DELETE /inventory/defaults/{id})
+	DeleteInventoryDefaultsId(ctx echo.Context, id int) error
+	// List inventory items
+	// (GET /inventory/items)
+	GetInventoryItems(ctx echo.Context, params GetInventoryItemsParams) error
+	// Add an inventory item
+	// (POST /inventory/items)
+	PostInventoryItems(ctx echo.Context) error
+	// Get inventory item by ID
+	// (GET /inventory/items/{id})
+	GetInventoryItemsId(ctx echo.Context, id int) error
+	// Update an inventory item (JSON merge patch)
+	// (PATCH /inventory/items/{id})
+	PatchInventoryItemsId(ctx echo.Context, id int, params PatchInventoryItemsIdParams) error
+	// Active items whose total stock is below their minimum
+	// (GET /inventory/low_stock)
+	GetInventoryLowStock(ctx echo.Context) error
+	// List stock movements, newest first
+	// (GET /inventory/movements)
+	GetInventoryMovements(ctx echo.Context, params GetInventoryMovementsParams) error
+	// Record a delivery, a stocktaking correction or a consumption
+	// (POST /inventory/movements)
+	PostInventoryMovements(ctx echo.Context) error
+	// Stock levels per item and storage location
+	// (GET /inventory/stock)
+	GetInventoryStock(ctx echo.Context, params GetInventoryStockParams) error
+	// List lost and found items
+	// (GET /lost_items)
+	GetLostItems(ctx echo.Context, params GetLostItemsParams) error
+	// Register a found item
+	// (POST /lost_items)
+	PostLostItems(ctx echo.Context) error
+	// Get lost and found item by ID
+	// (GET /lost_items/{id})
+	GetLostItemsId(ctx echo.Context, id int) error
+	// Update a lost and found item (JSON merge patch)
+	// (PATCH /lost_items/{id})
+	PatchLostItemsId(ctx echo.Context, id int, params PatchLostItemsIdParams) error
+	// List maintenance tickets
+	// (GET /maintenance_tickets)
+	GetMaintenanceTickets(ctx echo.Context, params GetMaintenanceTicketsParams) error
+	// Report a maintenance issue
+	// (POST /maintenance_tickets)
+	PostMaintenanceTickets(ctx echo.Context) error
+	// Get maintenance ticket by ID
+	// (GET /maintenance_tickets/{id})
+	GetMaintenanceTicketsId(ctx echo.Context, id int) error
+	// Update a maintenance ticket (JSON merge patch)
+	// (PATCH /maintenance_tickets/{id})
+	PatchMaintenanceTicketsId(ctx echo.Context, id int, params PatchMaintenanceTicketsIdParams) error
+	// List the properties the user may access
+	// (GET /properties)
+	GetProperties(ctx echo.Context) error
+	// Add a property
+	// (POST /properties)
+	PostProperties(ctx echo.Context) error
+	// Get property by ID
+	// (GET /properties/{id})
+	GetPropertiesId(ctx echo.Context, id int) error
+	// Update a property (JSON merge patch)
+	// (PATCH /properties/{id})
+	PatchPropertiesId(ctx echo.Context, id int, params PatchPropertiesIdParams) error
+	// List the users authorized for a property
+	// (GET /properties/{id}/users)
+	GetPropertiesIdUsers(ctx echo.Context, id int) error
+	// Revoke the authorization of a user for a property
+	// (DELETE /properties/{id}/users/{user})
+	DeletePropertiesIdUsersUser(ctx echo.Context, id int, user string) error
+	// Authorize a user for a property
+	// (PUT /properties/{id}/users/{user})
+	PutPropertiesIdUsersUser(ctx echo.Context, id int, user string) error
+	// List recurrence rules
+	// (GET /recurrence_rules)
+	GetRecurrenceRules(ctx echo.Context) error
+	// Add a recurring cleaning of a room or zone
+	// (POST /recurrence_rules)
+	PostRecurrenceRules(ctx echo.Context) error
+	// Delete a recurrence rule
+	// (DELETE /recurrence_rules/{id})
+	DeleteRecurrenceRulesId(ctx echo.Context, id int) error
+	// Get recurrence rule by ID
+	// (GET /recurrence_rules/{id})
+	GetRecurrenceRulesId(ctx echo.Context, id int) error
+	// Update a recurrence rule (JSON merge patch)
+	// (PATCH /recurrence_rules/{id})
+	PatchRecurrenceRulesId(ctx echo.Context, id int, params PatchRecurrenceRulesIdParams) error
+	// Cleaning orders of a day rolled up by floor or zone
+	// (GET /reports/board)
+	GetReportsBoard(ctx echo.Context, params GetReportsBoardParams) error
+	// Inspection results per cleaner
+	// (GET /reports/cleaner_quality)
+	GetReportsCleanerQuality(ctx echo.Context, params GetReportsCleanerQualityParams) error
+	// Cleaning orders per cleaner and day
+	// (GET /reports/cleaner_workload)
+	GetReportsCleanerWorkload(ctx echo.Context, params GetReportsCleanerWorkloadParams) error
+	// Average time the cleanings took
+	// (GET /reports/cleaning_time)
+	GetReportsCleaningTime(ctx echo.Context, params GetReportsCleaningTimeParams) error
+	// Consumption per item
+	// (GET /reports/consumption)
+	GetReportsConsumption(ctx echo.Context, params GetReportsConsumptionParams) error
+	// Housekeeping cost per occupied room night
+	// (GET /reports/cost_per_night)
+	GetReportsCostPerNight(ctx echo.Context, params GetReportsCostPerNightParams) error
+	// Projected cleaning workload per day against the scheduled shifts
+	// (GET /reports/forecast)
+	GetReportsForecast(ctx echo.Context, params GetReportsForecastParams) error
+	// Occupancy rate of the rooms
+	// (GET /reports/occupancy)
+	GetReportsOccupancy(ctx echo.Context, params GetReportsOccupancyParams) error
+	// Share of the cleaning orders done in time
+	// (GET /reports/on_time)
+	GetReportsOnTime(ctx echo.Context, params GetReportsOnTimeParams) error
+	// List room types
+	// (GET /room_types)
+	GetRoomTypes(ctx echo.Context) error
+	// Add a room type
+	// (POST /room_types)
+	PostRoomTypes(ctx echo.Context) error
+	// Delete a room type
+	// (DELETE /room_types/{id})
+	DeleteRoomTypesId(ctx echo.Context, id int) error
+	// Get room type by ID
+	// (GET /room_types/{id})
+	GetRoomTypesId(ctx echo.Context, id int) error
+	// Update a room type (JSON merge patch)
+	// (PATCH /room_types/{id})
+	PatchRoomTypesId(ctx echo.Context, id int, params PatchRoomTypesIdParams) error
 	// List all rooms
 	// (GET /rooms)
-	GetRooms(ctx echo.Context) error
+	GetRooms(ctx echo.Context, params GetRoomsParams) error
 	// Create a new room
 	// (POST /rooms)
 	PostRooms(ctx echo.Context) error
@@ -85,10 +347,58 @@ type ServerInterface interface {
 	DeleteRoomsId(ctx echo.Context, id int) error
 	// Get room by ID
 	// (GET /rooms/{id})
-	GetRoomsId(ctx echo.Context, id int) error
+	GetRoomsId(ctx echo.Context, id int, params GetRoomsIdParams) error
+	// Partially update room (JSON merge patch)
+	// (PATCH /rooms/{id})
+	PatchRoomsId(ctx echo.Context, id int, params PatchRoomsIdParams) error
 	// Update room
 	// (PUT /rooms/{id})
-	PutRoomsId(ctx echo.Context, id int) error
+	PutRoomsId(ctx echo.Context, id int, params PutRoomsIdParams) error
+	// List out-of-order and out-of-service periods of a room
+	// (GET /rooms/{id}/blocks)
+	GetRoomsIdBlocks(ctx echo.Context, id int) error
+	// Put a room out of order or out of service
+	// (POST /rooms/{id}/blocks)
+	PostRoomsIdBlocks(ctx echo.Context, id int) error
+	// Remove an out-of-order or out-of-service period
+	// (DELETE /rooms/{id}/blocks/{blockId})
+	DeleteRoomsIdBlocksBlockId(ctx echo.Context, id int, blockId int) error
+	// Restore deleted room
+	// (POST /rooms/{id}/restore)
+	PostRoomsIdRestore(ctx echo.Context, id int) error
+	// Set room housekeeping status
+	// (PUT /rooms/{id}/status)
+	PutRoomsIdStatus(ctx echo.Context, id int) error
+	// List shifts
+	// (GET /shifts)
+	GetShifts(ctx echo.Context, params GetShiftsParams) error
+	// Schedule a shift of a cleaner
+	// (POST /shifts)
+	PostShifts(ctx echo.Context) error
+	// Delete a shift
+	// (DELETE /shifts/{id})
+	DeleteShiftsId(ctx echo.Context, id int) error
+	// Get shift by ID
+	// (GET /shifts/{id})
+	GetShiftsId(ctx echo.Context, id int) error
+	// Update a shift (JSON merge patch)
+	// (PATCH /shifts/{id})
+	PatchShiftsId(ctx echo.Context, id int, params PatchShiftsIdParams) error
+	// List zones
+	// (GET /zones)
+	GetZones(ctx echo.Context, params GetZonesParams) error
+	// Add a zone
+	// (POST /zones)
+	PostZones(ctx echo.Context) error
+	// Delete a zone
+	// (DELETE /zones/{id})
+	DeleteZonesId(ctx echo.Context, id int) error
+	// Get zone by ID
+	// (GET /zones/{id})
+	GetZonesId(ctx echo.Context, id int) error
+	// Update a zone (JSON merge patch)
+	// (PATCH /zones/{id})
+	PatchZonesId(ctx echo.Context, id int, params PatchZonesIdParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -100,8 +410,24 @@ type ServerInterfaceWrapper struct {
 func (w *ServerInterfaceWrapper) GetBookings(ctx echo.Context) error {
 	var err error
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBookingsParams
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", ctx.QueryParams(), &params.IncludeDeleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_deleted: %s", err))
+	}
+
+	// ------------- Optional query parameter "guest" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "guest", ctx.QueryParams(), &params.Guest)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter guest: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetBookings(ctx)
+	err = w.Handler.GetBookings(ctx, params)
 	return err
 }
 
@@ -141,8 +467,53 @@ func (w *ServerInterfaceWrapper) GetBookingsId(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBookingsIdParams
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", ctx.QueryParams(), &params.IncludeDeleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_deleted: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetBookingsId(ctx, id, params)
+	return err
+}
+
+// PatchBookingsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchBookingsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchBookingsIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetBookingsId(ctx, id)
+	err = w.Handler.PatchBookingsId(ctx, id, params)
 	return err
 }
 
@@ -157,31 +528,65 @@ func (w *ServerInterfaceWrapper) PutBookingsId(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutBookingsIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutBookingsId(ctx, id)
+	err = w.Handler.PutBookingsId(ctx, id, params)
 	return err
 }
 
-// GetCleaners converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCleaners(ctx echo.Context) error {
+// PostBookingsIdAnonymize converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBookingsIdAnonymize(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCleaners(ctx)
+	err = w.Handler.PostBookingsIdAnonymize(ctx, id)
 	return err
 }
 
-// PostCleaners converts echo context to params.
-func (w *ServerInterfaceWrapper) PostCleaners(ctx echo.Context) error {
+// PostBookingsIdCancel converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBookingsIdCancel(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostCleaners(ctx)
+	err = w.Handler.PostBookingsIdCancel(ctx, id)
 	return err
 }
 
-// DeleteCleanersId converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteCleanersId(ctx echo.Context) error {
+// PostBookingsIdCheckIn converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBookingsIdCheckIn(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -192,12 +597,12 @@ func (w *ServerInterfaceWrapper) DeleteCleanersId(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteCleanersId(ctx, id)
+	err = w.Handler.PostBookingsIdCheckIn(ctx, id)
 	return err
 }
 
-// GetCleanersId converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCleanersId(ctx echo.Context) error {
+// PostBookingsIdCheckOut converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBookingsIdCheckOut(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -208,12 +613,12 @@ func (w *ServerInterfaceWrapper) GetCleanersId(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCleanersId(ctx, id)
+	err = w.Handler.PostBookingsIdCheckOut(ctx, id)
 	return err
 }
 
-// PutCleanersId converts echo context to params.
-func (w *ServerInterfaceWrapper) PutCleanersId(ctx echo.Context) error {
+// PostBookingsIdRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBookingsIdRestore(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -224,12 +629,12 @@ func (w *ServerInterfaceWrapper) PutCleanersId(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutCleanersId(ctx, id)
+	err = w.Handler.PostBookingsIdRestore(ctx, id)
 	return err
 }
 
-// GetCleanersIdCleaningOrders converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCleanersIdCleaningOrders(ctx echo.Context) error {
+// PutBookingsIdServicePreferences converts echo context to params.
+func (w *ServerInterfaceWrapper) PutBookingsIdServicePreferences(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -239,31 +644,51 @@ func (w *ServerInterfaceWrapper) GetCleanersIdCleaningOrders(ctx echo.Context) e
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutBookingsIdServicePreferencesParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCleanersIdCleaningOrders(ctx, id)
+	err = w.Handler.PutBookingsIdServicePreferences(ctx, id, params)
 	return err
 }
 
-// GetCleaningOrders converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCleaningOrders(ctx echo.Context) error {
+// GetBuildings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBuildings(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCleaningOrders(ctx)
+	err = w.Handler.GetBuildings(ctx)
 	return err
 }
 
-// PostCleaningOrders converts echo context to params.
-func (w *ServerInterfaceWrapper) PostCleaningOrders(ctx echo.Context) error {
+// PostBuildings converts echo context to params.
+func (w *ServerInterfaceWrapper) PostBuildings(ctx echo.Context) error {
 	var err error
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostCleaningOrders(ctx)
+	err = w.Handler.PostBuildings(ctx)
 	return err
 }
 
-// DeleteCleaningOrdersId converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteCleaningOrdersId(ctx echo.Context) error {
+// DeleteBuildingsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteBuildingsId(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -274,12 +699,12 @@ func (w *ServerInterfaceWrapper) DeleteCleaningOrdersId(ctx echo.Context) error
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteCleaningOrdersId(ctx, id)
+	err = w.Handler.DeleteBuildingsId(ctx, id)
 	return err
 }
 
-// GetCleaningOrdersId converts echo context to params.
-func (w *ServerInterfaceWrapper) GetCleaningOrdersId(ctx echo.Context) error {
+// GetBuildingsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBuildingsId(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -290,12 +715,12 @@ func (w *ServerInterfaceWrapper) GetCleaningOrdersId(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetCleaningOrdersId(ctx, id)
+	err = w.Handler.GetBuildingsId(ctx, id)
 	return err
 }
 
-// PutCleaningOrdersId converts echo context to params.
-func (w *ServerInterfaceWrapper) PutCleaningOrdersId(ctx echo.Context) error {
+// PatchBuildingsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchBuildingsId(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -305,13 +730,60 @@ func (w *ServerInterfaceWrapper) PutCleaningOrdersId(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchBuildingsIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutCleaningOrdersId(ctx, id)
+	err = w.Handler.PatchBuildingsId(ctx, id, params)
 	return err
 }
 
-// PostCleaningOrdersIdCleaners converts echo context to params.
-func (w *ServerInterfaceWrapper) PostCleaningOrdersIdCleaners(ctx echo.Context) error {
+// GetChecklistTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) GetChecklistTemplates(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetChecklistTemplatesParams
+	// ------------- Optional query parameter "cleaning_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cleaning_type", ctx.QueryParams(), &params.CleaningType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cleaning_type: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetChecklistTemplates(ctx, params)
+	return err
+}
+
+// PostChecklistTemplates converts echo context to params.
+func (w *ServerInterfaceWrapper) PostChecklistTemplates(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostChecklistTemplates(ctx)
+	return err
+}
+
+// DeleteChecklistTemplatesId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteChecklistTemplatesId(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -322,12 +794,39 @@ func (w *ServerInterfaceWrapper) PostCleaningOrdersIdCleaners(ctx echo.Context)
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostCleaningOrdersIdCleaners(ctx, id)
+	err = w.Handler.DeleteChecklistTemplatesId(ctx, id)
 	return err
 }
 
-// DeleteCleaningOrdersIdCleanersCleanerId converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteCleaningOrdersIdCleanersCleanerId(ctx echo.Context) error {
+// GetCleaners converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCleaners(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCleanersParams
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", ctx.QueryParams(), &params.IncludeDeleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_deleted: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCleaners(ctx, params)
+	return err
+}
+
+// PostCleaners converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCleaners(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCleaners(ctx)
+	return err
+}
+
+// DeleteCleanersId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCleanersId(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -337,39 +836,110 @@ func (w *ServerInterfaceWrapper) DeleteCleaningOrdersIdCleanersCleanerId(ctx ech
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
-	// ------------- Path parameter "cleanerId" -------------
-	var cleanerId int
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCleanersId(ctx, id)
+	return err
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "cleanerId", ctx.Param("cleanerId"), &cleanerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+// GetCleanersId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCleanersId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cleanerId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCleanersIdParams
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", ctx.QueryParams(), &params.IncludeDeleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_deleted: %s", err))
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteCleaningOrdersIdCleanersCleanerId(ctx, id, cleanerId)
+	err = w.Handler.GetCleanersId(ctx, id, params)
 	return err
 }
 
-// GetRooms converts echo context to params.
-func (w *ServerInterfaceWrapper) GetRooms(ctx echo.Context) error {
+// PatchCleanersId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchCleanersId(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchCleanersIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetRooms(ctx)
+	err = w.Handler.PatchCleanersId(ctx, id, params)
 	return err
 }
 
-// PostRooms converts echo context to params.
-func (w *ServerInterfaceWrapper) PostRooms(ctx echo.Context) error {
+// PutCleanersId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutCleanersId(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutCleanersIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostRooms(ctx)
+	err = w.Handler.PutCleanersId(ctx, id, params)
 	return err
 }
 
-// DeleteRoomsId converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteRoomsId(ctx echo.Context) error {
+// GetCleanersIdCleaningOrders converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCleanersIdCleaningOrders(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -379,13 +949,22 @@ func (w *ServerInterfaceWrapper) DeleteRoomsId(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCleanersIdCleaningOrdersParams
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteRoomsId(ctx, id)
+	err = w.Handler.GetCleanersIdCleaningOrders(ctx, id, params)
 	return err
 }
 
-// GetRoomsId converts echo context to params.
-func (w *ServerInterfaceWrapper) GetRoomsId(ctx echo.Context) error {
+// PostCleanersIdRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCleanersIdRestore(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -396,12 +975,12 @@ func (w *ServerInterfaceWrapper) GetRoomsId(ctx echo.Context) error {
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetRoomsId(ctx, id)
+	err = w.Handler.PostCleanersIdRestore(ctx, id)
 	return err
 }
 
-// PutRoomsId converts echo context to params.
-func (w *ServerInterfaceWrapper) PutRoomsId(ctx echo.Context) error {
+// GetCleanersIdRoute converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCleanersIdRoute(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "id" -------------
 	var id int
@@ -411,8 +990,1998 @@ func (w *ServerInterfaceWrapper) PutRoomsId(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCleanersIdRouteParams
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", ctx.QueryParams(), &params.Date)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCleanersIdRoute(ctx, id, params)
+	return err
+}
+
+// GetCleaningOrders converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCleaningOrders(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCleaningOrdersParams
+	// ------------- Optional query parameter "zone_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "zone_id", ctx.QueryParams(), &params.ZoneId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter zone_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "floor_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "floor_id", ctx.QueryParams(), &params.FloorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter floor_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "building_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "building_id", ctx.QueryParams(), &params.BuildingId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter building_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", ctx.QueryParams(), &params.Sort)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sort: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCleaningOrders(ctx, params)
+	return err
+}
+
+// PostCleaningOrders converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCleaningOrders(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCleaningOrders(ctx)
+	return err
+}
+
+// PostCleaningOrdersBulk converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCleaningOrdersBulk(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCleaningOrdersBulk(ctx)
+	return err
+}
+
+// DeleteCleaningOrdersId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCleaningOrdersId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCleaningOrdersId(ctx, id)
+	return err
+}
+
+// GetCleaningOrdersId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCleaningOrdersId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCleaningOrdersId(ctx, id)
+	return err
+}
+
+// PatchCleaningOrdersId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchCleaningOrdersId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchCleaningOrdersIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchCleaningOrdersId(ctx, id, params)
+	return err
+}
+
+// PutCleaningOrdersId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutCleaningOrdersId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutCleaningOrdersIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutCleaningOrdersId(ctx, id, params)
+	return err
+}
+
+// GetCleaningOrdersIdAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCleaningOrdersIdAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCleaningOrdersIdAttachments(ctx, id)
+	return err
+}
+
+// PostCleaningOrdersIdAttachments converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCleaningOrdersIdAttachments(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCleaningOrdersIdAttachments(ctx, id)
+	return err
+}
+
+// DeleteCleaningOrdersIdAttachmentsAttachmentId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCleaningOrdersIdAttachmentsAttachmentId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", ctx.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCleaningOrdersIdAttachmentsAttachmentId(ctx, id, attachmentId)
+	return err
+}
+
+// GetCleaningOrdersIdAttachmentsAttachmentId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCleaningOrdersIdAttachmentsAttachmentId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", ctx.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCleaningOrdersIdAttachmentsAttachmentId(ctx, id, attachmentId)
+	return err
+}
+
+// GetCleaningOrdersIdAttachmentsAttachmentIdThumbnail converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCleaningOrdersIdAttachmentsAttachmentIdThumbnail(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", ctx.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter attachmentId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCleaningOrdersIdAttachmentsAttachmentIdThumbnail(ctx, id, attachmentId)
+	return err
+}
+
+// GetCleaningOrdersIdChecklist converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCleaningOrdersIdChecklist(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCleaningOrdersIdChecklist(ctx, id)
+	return err
+}
+
+// PutCleaningOrdersIdChecklistItemId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutCleaningOrdersIdChecklistItemId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "itemId", ctx.Param("itemId"), &itemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter itemId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutCleaningOrdersIdChecklistItemId(ctx, id, itemId)
+	return err
+}
+
+// PostCleaningOrdersIdCleaners converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCleaningOrdersIdCleaners(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCleaningOrdersIdCleaners(ctx, id)
+	return err
+}
+
+// DeleteCleaningOrdersIdCleanersCleanerId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteCleaningOrdersIdCleanersCleanerId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "cleanerId" -------------
+	var cleanerId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cleanerId", ctx.Param("cleanerId"), &cleanerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cleanerId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteCleaningOrdersIdCleanersCleanerId(ctx, id, cleanerId)
+	return err
+}
+
+// GetCleaningOrdersIdConsumption converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCleaningOrdersIdConsumption(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCleaningOrdersIdConsumption(ctx, id)
+	return err
+}
+
+// PostCleaningOrdersIdConsumption converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCleaningOrdersIdConsumption(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCleaningOrdersIdConsumption(ctx, id)
+	return err
+}
+
+// PostCleaningOrdersIdDnd converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCleaningOrdersIdDnd(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCleaningOrdersIdDnd(ctx, id)
+	return err
+}
+
+// GetCleaningOrdersIdInspections converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCleaningOrdersIdInspections(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCleaningOrdersIdInspections(ctx, id)
+	return err
+}
+
+// PostCleaningOrdersIdInspections converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCleaningOrdersIdInspections(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCleaningOrdersIdInspections(ctx, id)
+	return err
+}
+
+// PutCleaningOrdersIdPriority converts echo context to params.
+func (w *ServerInterfaceWrapper) PutCleaningOrdersIdPriority(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutCleaningOrdersIdPriority(ctx, id)
+	return err
+}
+
+// GetFloors converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFloors(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetFloorsParams
+	// ------------- Optional query parameter "building_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "building_id", ctx.QueryParams(), &params.BuildingId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter building_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFloors(ctx, params)
+	return err
+}
+
+// PostFloors converts echo context to params.
+func (w *ServerInterfaceWrapper) PostFloors(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostFloors(ctx)
+	return err
+}
+
+// DeleteFloorsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteFloorsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteFloorsId(ctx, id)
+	return err
+}
+
+// GetFloorsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetFloorsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetFloorsId(ctx, id)
+	return err
+}
+
+// PatchFloorsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchFloorsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchFloorsIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchFloorsId(ctx, id, params)
+	return err
+}
+
+// GetInspectionItems converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInspectionItems(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInspectionItems(ctx)
+	return err
+}
+
+// PostInspectionItems converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInspectionItems(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostInspectionItems(ctx)
+	return err
+}
+
+// DeleteInspectionItemsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteInspectionItemsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteInspectionItemsId(ctx, id)
+	return err
+}
+
+// GetInspectionsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInspectionsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInspectionsId(ctx, id)
+	return err
+}
+
+// GetInventoryDefaults converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInventoryDefaults(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInventoryDefaultsParams
+	// ------------- Optional query parameter "cleaning_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cleaning_type", ctx.QueryParams(), &params.CleaningType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cleaning_type: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInventoryDefaults(ctx, params)
+	return err
+}
+
+// PostInventoryDefaults converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInventoryDefaults(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostInventoryDefaults(ctx)
+	return err
+}
+
+// DeleteInventoryDefaultsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteInventoryDefaultsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteInventoryDefaultsId(ctx, id)
+	return err
+}
+
+// GetInventoryItems converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInventoryItems(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInventoryItemsParams
+	// ------------- Optional query parameter "include_inactive" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_inactive", ctx.QueryParams(), &params.IncludeInactive)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_inactive: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInventoryItems(ctx, params)
+	return err
+}
+
+// PostInventoryItems converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInventoryItems(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostInventoryItems(ctx)
+	return err
+}
+
+// GetInventoryItemsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInventoryItemsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInventoryItemsId(ctx, id)
+	return err
+}
+
+// PatchInventoryItemsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchInventoryItemsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchInventoryItemsIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchInventoryItemsId(ctx, id, params)
+	return err
+}
+
+// GetInventoryLowStock converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInventoryLowStock(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInventoryLowStock(ctx)
+	return err
+}
+
+// GetInventoryMovements converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInventoryMovements(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInventoryMovementsParams
+	// ------------- Optional query parameter "item_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "item_id", ctx.QueryParams(), &params.ItemId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter item_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "location" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "location", ctx.QueryParams(), &params.Location)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter location: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInventoryMovements(ctx, params)
+	return err
+}
+
+// PostInventoryMovements converts echo context to params.
+func (w *ServerInterfaceWrapper) PostInventoryMovements(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostInventoryMovements(ctx)
+	return err
+}
+
+// GetInventoryStock converts echo context to params.
+func (w *ServerInterfaceWrapper) GetInventoryStock(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInventoryStockParams
+	// ------------- Optional query parameter "item_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "item_id", ctx.QueryParams(), &params.ItemId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter item_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "location" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "location", ctx.QueryParams(), &params.Location)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter location: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetInventoryStock(ctx, params)
+	return err
+}
+
+// GetLostItems converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLostItems(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLostItemsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "room_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "room_id", ctx.QueryParams(), &params.RoomId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter room_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "booking_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "booking_id", ctx.QueryParams(), &params.BookingId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter booking_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "due" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "due", ctx.QueryParams(), &params.Due)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter due: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLostItems(ctx, params)
+	return err
+}
+
+// PostLostItems converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLostItems(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLostItems(ctx)
+	return err
+}
+
+// GetLostItemsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLostItemsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLostItemsId(ctx, id)
+	return err
+}
+
+// PatchLostItemsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchLostItemsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchLostItemsIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchLostItemsId(ctx, id, params)
+	return err
+}
+
+// GetMaintenanceTickets converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMaintenanceTickets(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMaintenanceTicketsParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "room_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "room_id", ctx.QueryParams(), &params.RoomId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter room_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMaintenanceTickets(ctx, params)
+	return err
+}
+
+// PostMaintenanceTickets converts echo context to params.
+func (w *ServerInterfaceWrapper) PostMaintenanceTickets(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostMaintenanceTickets(ctx)
+	return err
+}
+
+// GetMaintenanceTicketsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMaintenanceTicketsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMaintenanceTicketsId(ctx, id)
+	return err
+}
+
+// PatchMaintenanceTicketsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchMaintenanceTicketsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchMaintenanceTicketsIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchMaintenanceTicketsId(ctx, id, params)
+	return err
+}
+
+// GetProperties converts echo context to params.
+func (w *ServerInterfaceWrapper) GetProperties(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetProperties(ctx)
+	return err
+}
+
+// PostProperties converts echo context to params.
+func (w *ServerInterfaceWrapper) PostProperties(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostProperties(ctx)
+	return err
+}
+
+// GetPropertiesId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPropertiesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPropertiesId(ctx, id)
+	return err
+}
+
+// PatchPropertiesId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchPropertiesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchPropertiesIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchPropertiesId(ctx, id, params)
+	return err
+}
+
+// GetPropertiesIdUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPropertiesIdUsers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPropertiesIdUsers(ctx, id)
+	return err
+}
+
+// DeletePropertiesIdUsersUser converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePropertiesIdUsersUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "user" -------------
+	var user string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user", ctx.Param("user"), &user, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeletePropertiesIdUsersUser(ctx, id, user)
+	return err
+}
+
+// PutPropertiesIdUsersUser converts echo context to params.
+func (w *ServerInterfaceWrapper) PutPropertiesIdUsersUser(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "user" -------------
+	var user string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user", ctx.Param("user"), &user, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutPropertiesIdUsersUser(ctx, id, user)
+	return err
+}
+
+// GetRecurrenceRules converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRecurrenceRules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRecurrenceRules(ctx)
+	return err
+}
+
+// PostRecurrenceRules converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRecurrenceRules(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRecurrenceRules(ctx)
+	return err
+}
+
+// DeleteRecurrenceRulesId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteRecurrenceRulesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteRecurrenceRulesId(ctx, id)
+	return err
+}
+
+// GetRecurrenceRulesId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRecurrenceRulesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRecurrenceRulesId(ctx, id)
+	return err
+}
+
+// PatchRecurrenceRulesId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchRecurrenceRulesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchRecurrenceRulesIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchRecurrenceRulesId(ctx, id, params)
+	return err
+}
+
+// GetReportsBoard converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportsBoard(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsBoardParams
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", ctx.QueryParams(), &params.Date)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter date: %s", err))
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", ctx.QueryParams(), &params.GroupBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_by: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReportsBoard(ctx, params)
+	return err
+}
+
+// GetReportsCleanerQuality converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportsCleanerQuality(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsCleanerQualityParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReportsCleanerQuality(ctx, params)
+	return err
+}
+
+// GetReportsCleanerWorkload converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportsCleanerWorkload(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsCleanerWorkloadParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", ctx.QueryParams(), &params.GroupBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_by: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReportsCleanerWorkload(ctx, params)
+	return err
+}
+
+// GetReportsCleaningTime converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportsCleaningTime(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsCleaningTimeParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", ctx.QueryParams(), &params.GroupBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_by: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReportsCleaningTime(ctx, params)
+	return err
+}
+
+// GetReportsConsumption converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportsConsumption(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsConsumptionParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "cleaning_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cleaning_type", ctx.QueryParams(), &params.CleaningType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cleaning_type: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReportsConsumption(ctx, params)
+	return err
+}
+
+// GetReportsCostPerNight converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportsCostPerNight(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsCostPerNightParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", ctx.QueryParams(), &params.GroupBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_by: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReportsCostPerNight(ctx, params)
+	return err
+}
+
+// GetReportsForecast converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportsForecast(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsForecastParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReportsForecast(ctx, params)
+	return err
+}
+
+// GetReportsOccupancy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportsOccupancy(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsOccupancyParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", ctx.QueryParams(), &params.GroupBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_by: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReportsOccupancy(ctx, params)
+	return err
+}
+
+// GetReportsOnTime converts echo context to params.
+func (w *ServerInterfaceWrapper) GetReportsOnTime(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsOnTimeParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", ctx.QueryParams(), &params.GroupBy)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_by: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetReportsOnTime(ctx, params)
+	return err
+}
+
+// GetRoomTypes converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRoomTypes(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRoomTypes(ctx)
+	return err
+}
+
+// PostRoomTypes converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRoomTypes(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRoomTypes(ctx)
+	return err
+}
+
+// DeleteRoomTypesId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteRoomTypesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteRoomTypesId(ctx, id)
+	return err
+}
+
+// GetRoomTypesId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRoomTypesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRoomTypesId(ctx, id)
+	return err
+}
+
+// PatchRoomTypesId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchRoomTypesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchRoomTypesIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchRoomTypesId(ctx, id, params)
+	return err
+}
+
+// GetRooms converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRooms(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRoomsParams
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", ctx.QueryParams(), &params.IncludeDeleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_deleted: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRooms(ctx, params)
+	return err
+}
+
+// PostRooms converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRooms(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRooms(ctx)
+	return err
+}
+
+// DeleteRoomsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteRoomsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteRoomsId(ctx, id)
+	return err
+}
+
+// GetRoomsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRoomsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRoomsIdParams
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", ctx.QueryParams(), &params.IncludeDeleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_deleted: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRoomsId(ctx, id, params)
+	return err
+}
+
+// PatchRoomsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchRoomsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchRoomsIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchRoomsId(ctx, id, params)
+	return err
+}
+
+// PutRoomsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutRoomsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutRoomsIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutRoomsId(ctx, id, params)
+	return err
+}
+
+// GetRoomsIdBlocks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRoomsIdBlocks(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRoomsIdBlocks(ctx, id)
+	return err
+}
+
+// PostRoomsIdBlocks converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRoomsIdBlocks(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRoomsIdBlocks(ctx, id)
+	return err
+}
+
+// DeleteRoomsIdBlocksBlockId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteRoomsIdBlocksBlockId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "blockId" -------------
+	var blockId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "blockId", ctx.Param("blockId"), &blockId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter blockId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteRoomsIdBlocksBlockId(ctx, id, blockId)
+	return err
+}
+
+// PostRoomsIdRestore converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRoomsIdRestore(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRoomsIdRestore(ctx, id)
+	return err
+}
+
+// PutRoomsIdStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) PutRoomsIdStatus(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutRoomsIdStatus(ctx, id)
+	return err
+}
+
+// GetShifts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetShifts(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetShiftsParams
+	// ------------- Optional query parameter "cleaner_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cleaner_id", ctx.QueryParams(), &params.CleanerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cleaner_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetShifts(ctx, params)
+	return err
+}
+
+// PostShifts converts echo context to params.
+func (w *ServerInterfaceWrapper) PostShifts(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostShifts(ctx)
+	return err
+}
+
+// DeleteShiftsId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteShiftsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteShiftsId(ctx, id)
+	return err
+}
+
+// GetShiftsId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetShiftsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetShiftsId(ctx, id)
+	return err
+}
+
+// PatchShiftsId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchShiftsId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchShiftsIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PatchShiftsId(ctx, id, params)
+	return err
+}
+
+// GetZones converts echo context to params.
+func (w *ServerInterfaceWrapper) GetZones(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetZonesParams
+	// ------------- Optional query parameter "floor_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "floor_id", ctx.QueryParams(), &params.FloorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter floor_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetZones(ctx, params)
+	return err
+}
+
+// PostZones converts echo context to params.
+func (w *ServerInterfaceWrapper) PostZones(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostZones(ctx)
+	return err
+}
+
+// DeleteZonesId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteZonesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteZonesId(ctx, id)
+	return err
+}
+
+// GetZonesId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetZonesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetZonesId(ctx, id)
+	return err
+}
+
+// PatchZonesId converts echo context to params.
+func (w *ServerInterfaceWrapper) PatchZonesId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchZonesIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutRoomsId(ctx, id)
+	err = w.Handler.PatchZonesId(ctx, id, params)
 	return err
 }
 
@@ -448,52 +3017,364 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/bookings", wrapper.PostBookings)
 	router.DELETE(baseURL+"/bookings/:id", wrapper.DeleteBookingsId)
 	router.GET(baseURL+"/bookings/:id", wrapper.GetBookingsId)
+	router.PATCH(baseURL+"/bookings/:id", wrapper.PatchBookingsId)
 	router.PUT(baseURL+"/bookings/:id", wrapper.PutBookingsId)
+	router.POST(baseURL+"/bookings/:id/anonymize", wrapper.PostBookingsIdAnonymize)
+	router.POST(baseURL+"/bookings/:id/cancel", wrapper.PostBookingsIdCancel)
+	router.POST(baseURL+"/bookings/:id/check_in", wrapper.PostBookingsIdCheckIn)
+	router.POST(baseURL+"/bookings/:id/check_out", wrapper.PostBookingsIdCheckOut)
+	router.POST(baseURL+"/bookings/:id/restore", wrapper.PostBookingsIdRestore)
+	router.PUT(baseURL+"/bookings/:id/service_preferences", wrapper.PutBookingsIdServicePreferences)
+	router.GET(baseURL+"/buildings", wrapper.GetBuildings)
+	router.POST(baseURL+"/buildings", wrapper.PostBuildings)
+	router.DELETE(baseURL+"/buildings/:id", wrapper.DeleteBuildingsId)
+	router.GET(baseURL+"/buildings/:id", wrapper.GetBuildingsId)
+	router.PATCH(baseURL+"/buildings/:id", wrapper.PatchBuildingsId)
+	router.GET(baseURL+"/checklist_templates", wrapper.GetChecklistTemplates)
+	router.POST(baseURL+"/checklist_templates", wrapper.PostChecklistTemplates)
+	router.DELETE(baseURL+"/checklist_templates/:id", wrapper.DeleteChecklistTemplatesId)
 	router.GET(baseURL+"/cleaners", wrapper.GetCleaners)
 	router.POST(baseURL+"/cleaners", wrapper.PostCleaners)
 	router.DELETE(baseURL+"/cleaners/:id", wrapper.DeleteCleanersId)
 	router.GET(baseURL+"/cleaners/:id", wrapper.GetCleanersId)
+	router.PATCH(baseURL+"/cleaners/:id", wrapper.PatchCleanersId)
 	router.PUT(baseURL+"/cleaners/:id", wrapper.PutCleanersId)
 	router.GET(baseURL+"/cleaners/:id/cleaning_orders", wrapper.GetCleanersIdCleaningOrders)
+	router.POST(baseURL+"/cleaners/:id/restore", wrapper.PostCleanersIdRestore)
+	router.GET(baseURL+"/cleaners/:id/route", wrapper.GetCleanersIdRoute)
 	router.GET(baseURL+"/cleaning_orders", wrapper.GetCleaningOrders)
 	router.POST(baseURL+"/cleaning_orders", wrapper.PostCleaningOrders)
+	router.POST(baseURL+"/cleaning_orders/bulk", wrapper.PostCleaningOrdersBulk)
 	router.DELETE(baseURL+"/cleaning_orders/:id", wrapper.DeleteCleaningOrdersId)
 	router.GET(baseURL+"/cleaning_orders/:id", wrapper.GetCleaningOrdersId)
+	router.PATCH(baseURL+"/cleaning_orders/:id", wrapper.PatchCleaningOrdersId)
 	router.PUT(baseURL+"/cleaning_orders/:id", wrapper.PutCleaningOrdersId)
+	router.GET(baseURL+"/cleaning_orders/:id/attachments", wrapper.GetCleaningOrdersIdAttachments)
+	router.POST(baseURL+"/cleaning_orders/:id/attachments", wrapper.PostCleaningOrdersIdAttachments)
+	router.DELETE(baseURL+"/cleaning_orders/:id/attachments/:attachmentId", wrapper.DeleteCleaningOrdersIdAttachmentsAttachmentId)
+	router.GET(baseURL+"/cleaning_orders/:id/attachments/:attachmentId", wrapper.GetCleaningOrdersIdAttachmentsAttachmentId)
+	router.GET(baseURL+"/cleaning_orders/:id/attachments/:attachmentId/thumbnail", wrapper.GetCleaningOrdersIdAttachmentsAttachmentIdThumbnail)
+	router.GET(baseURL+"/cleaning_orders/:id/checklist", wrapper.GetCleaningOrdersIdChecklist)
+	router.PUT(baseURL+"/cleaning_orders/:id/checklist/:itemId", wrapper.PutCleaningOrdersIdChecklistItemId)
 	router.POST(baseURL+"/cleaning_orders/:id/cleaners", wrapper.PostCleaningOrdersIdCleaners)
 	router.DELETE(baseURL+"/cleaning_orders/:id/cleaners/:cleanerId", wrapper.DeleteCleaningOrdersIdCleanersCleanerId)
+	router.GET(baseURL+"/cleaning_orders/:id/consumption", wrapper.GetCleaningOrdersIdConsumption)
+	router.POST(baseURL+"/cleaning_orders/:id/consumption", wrapper.PostCleaningOrdersIdConsumption)
+	router.POST(baseURL+"/cleaning_orders/:id/dnd", wrapper.PostCleaningOrdersIdDnd)
+	router.GET(baseURL+"/cleaning_orders/:id/inspections", wrapper.GetCleaningOrdersIdInspections)
+	router.POST(baseURL+"/cleaning_orders/:id/inspections", wrapper.PostCleaningOrdersIdInspections)
+	router.PUT(baseURL+"/cleaning_orders/:id/priority", wrapper.PutCleaningOrdersIdPriority)
+	router.GET(baseURL+"/floors", wrapper.GetFloors)
+	router.POST(baseURL+"/floors", wrapper.PostFloors)
+	router.DELETE(baseURL+"/floors/:id", wrapper.DeleteFloorsId)
+	router.GET(baseURL+"/floors/:id", wrapper.GetFloorsId)
+	router.PATCH(baseURL+"/floors/:id", wrapper.PatchFloorsId)
+	router.GET(baseURL+"/inspection_items", wrapper.GetInspectionItems)
+	router.POST(baseURL+"/inspection_items", wrapper.PostInspectionItems)
+	router.DELETE(baseURL+"/inspection_items/:id", wrapper.DeleteInspectionItemsId)
+	router.GET(baseURL+"/inspections/:id", wrapper.GetInspectionsId)
+	router.GET(baseURL+"/inventory/defaults", wrapper.GetInventoryDefaults)
+	router.POST(baseURL+"/inventory/defaults", wrapper.PostInventoryDefaults)
+	router.DELETE(baseURL+"/inventory/defaults/:id", wrapper.DeleteInventoryDefaultsId)
+	router.GET(baseURL+"/inventory/items", wrapper.GetInventoryItems)
+	router.POST(baseURL+"/inventory/items", wrapper.PostInventoryItems)
+	router.GET(baseURL+"/inventory/items/:id", wrapper.GetInventoryItemsId)
+	router.PATCH(baseURL+"/inventory/items/:id", wrapper.PatchInventoryItemsId)
+	router.GET(baseURL+"/inventory/low_stock", wrapper.GetInventoryLowStock)
+	router.GET(baseURL+"/inventory/movements", wrapper.GetInventoryMovements)
+	router.POST(baseURL+"/inventory/movements", wrapper.PostInventoryMovements)
+	router.GET(baseURL+"/inventory/stock", wrapper.GetInventoryStock)
+	router.GET(baseURL+"/lost_items", wrapper.GetLostItems)
+	router.POST(baseURL+"/lost_items", wrapper.PostLostItems)
+	router.GET(baseURL+"/lost_items/:id", wrapper.GetLostItemsId)
+	router.PATCH(baseURL+"/lost_items/:id", wrapper.PatchLostItemsId)
+	router.GET(baseURL+"/maintenance_tickets", wrapper.GetMaintenanceTickets)
+	router.POST(baseURL+"/maintenance_tickets", wrapper.PostMaintenanceTickets)
+	router.GET(baseURL+"/maintenance_tickets/:id", wrapper.GetMaintenanceTicketsId)
+	router.PATCH(baseURL+"/maintenance_tickets/:id", wrapper.PatchMaintenanceTicketsId)
+	router.GET(baseURL+"/properties", wrapper.GetProperties)
+	router.POST(baseURL+"/properties", wrapper.PostProperties)
+	router.GET(baseURL+"/properties/:id", wrapper.GetPropertiesId)
+	router.PATCH(baseURL+"/properties/:id", wrapper.PatchPropertiesId)
+	router.GET(baseURL+"/properties/:id/users", wrapper.GetPropertiesIdUsers)
+	router.DELETE(baseURL+"/properties/:id/users/:user", wrapper.DeletePropertiesIdUsersUser)
+	router.PUT(baseURL+"/properties/:id/users/:user", wrapper.PutPropertiesIdUsersUser)
+	router.GET(baseURL+"/recurrence_rules", wrapper.GetRecurrenceRules)
+	router.POST(baseURL+"/recurrence_rules", wrapper.PostRecurrenceRules)
+	router.DELETE(baseURL+"/recurrence_rules/:id", wrapper.DeleteRecurrenceRulesId)
+	router.GET(baseURL+"/recurrence_rules/:id", wrapper.GetRecurrenceRulesId)
+	router.PATCH(baseURL+"/recurrence_rules/:id", wrapper.PatchRecurrenceRulesId)
+	router.GET(baseURL+"/reports/board", wrapper.GetReportsBoard)
+	router.GET(baseURL+"/reports/cleaner_quality", wrapper.GetReportsCleanerQuality)
+	router.GET(baseURL+"/reports/cleaner_workload", wrapper.GetReportsCleanerWorkload)
+	router.GET(baseURL+"/reports/cleaning_time", wrapper.GetReportsCleaningTime)
+	router.GET(baseURL+"/reports/consumption", wrapper.GetReportsConsumption)
+	router.GET(baseURL+"/reports/cost_per_night", wrapper.GetReportsCostPerNight)
+	router.GET(baseURL+"/reports/forecast", wrapper.GetReportsForecast)
+	router.GET(baseURL+"/reports/occupancy", wrapper.GetReportsOccupancy)
+	router.GET(baseURL+"/reports/on_time", wrapper.GetReportsOnTime)
+	router.GET(baseURL+"/room_types", wrapper.GetRoomTypes)
+	router.POST(baseURL+"/room_types", wrapper.PostRoomTypes)
+	router.DELETE(baseURL+"/room_types/:id", wrapper.DeleteRoomTypesId)
+	router.GET(baseURL+"/room_types/:id", wrapper.GetRoomTypesId)
+	router.PATCH(baseURL+"/room_types/:id", wrapper.PatchRoomTypesId)
 	router.GET(baseURL+"/rooms", wrapper.GetRooms)
 	router.POST(baseURL+"/rooms", wrapper.PostRooms)
 	router.DELETE(baseURL+"/rooms/:id", wrapper.DeleteRoomsId)
 	router.GET(baseURL+"/rooms/:id", wrapper.GetRoomsId)
+	router.PATCH(baseURL+"/rooms/:id", wrapper.PatchRoomsId)
 	router.PUT(baseURL+"/rooms/:id", wrapper.PutRoomsId)
+	router.GET(baseURL+"/rooms/:id/blocks", wrapper.GetRoomsIdBlocks)
+	router.POST(baseURL+"/rooms/:id/blocks", wrapper.PostRoomsIdBlocks)
+	router.DELETE(baseURL+"/rooms/:id/blocks/:blockId", wrapper.DeleteRoomsIdBlocksBlockId)
+	router.POST(baseURL+"/rooms/:id/restore", wrapper.PostRoomsIdRestore)
+	router.PUT(baseURL+"/rooms/:id/status", wrapper.PutRoomsIdStatus)
+	router.GET(baseURL+"/shifts", wrapper.GetShifts)
+	router.POST(baseURL+"/shifts", wrapper.PostShifts)
+	router.DELETE(baseURL+"/shifts/:id", wrapper.DeleteShiftsId)
+	router.GET(baseURL+"/shifts/:id", wrapper.GetShiftsId)
+	router.PATCH(baseURL+"/shifts/:id", wrapper.PatchShiftsId)
+	router.GET(baseURL+"/zones", wrapper.GetZones)
+	router.POST(baseURL+"/zones", wrapper.PostZones)
+	router.DELETE(baseURL+"/zones/:id", wrapper.DeleteZonesId)
+	router.GET(baseURL+"/zones/:id", wrapper.GetZonesId)
+	router.PATCH(baseURL+"/zones/:id", wrapper.PatchZonesId)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xZX2/bNhD/KgK3R61ytz0MektSoDNQYEGKPRVBwEhnm61EqiSVwTD83QdSov5YEkWl",
-	"lhwDfbPJ4/Hud7/7Q/uAIpZmjAKVAoUHJKIdpFh/vGXsG6Fb9THjLAMuCeiNaAfRtydCn4ojG8ZTLFGI",
-	"YizhN0lSQD6S+wxQiITkSsXRLw+xXE46tc1BFPLlFqEStsDVHon71zlj6VP/ptqF7znhEKPwi9JQiz9W",
-	"17PnrxBJpaqE4I4DlvAA35U1bxYPd7+NpN8y/cQmCx7/ZvFPPNQVdwlgCrwLwRA3KU6hsVM7InI+sNfH",
-	"WS1aH7KYNkLdH7fH3ZR/eNwHVVTsPg1BNrTOlLoJed64p3F4zN6x1Lcaf2JGQ9hy7UhyvS5i/ZcRuh0I",
-	"ynOR6INBicrj01K8OqR3enyIWOFy98KY0eaRZ8aUMhs/KJMgHPOp4W5pxOMYZiPEuCIAHYHqYtT2YhSx",
-	"EWL/REypfmAs7WITg4h6rd8kjPEpdbOP/YWSIWtGiD7dtBMT7LePkOZVt59cpJYI3TCjj5NMEkZRiG7u",
-	"196GcS/FFG8J3Xo7JiHxTAw9ZQlWsmpYkEQmSu3fWsYw3/sM/IVE4N3cr5GPXoCLQvf7d6t3K93HMqA4",
-	"IyhEf+glH2VY7rR3Qckg/WUL2v/qznWMQvQR5K2RUbCKjFFRQPP7aqU7FKMSqD6KsywhkT4cfBXKCjPv",
-	"65FFQqoP/sphg0L0S1C/DILyWRCYN0GNIuYc7wsQ2+B9zqMIhNjkiWfs0uiLPE0x36MQfSJCejhJvMrN",
-	"o4+yMh/bft4z0XZU8+GWxftJPjq41qb7sc1WyXM4dnB+f24b+uAst7xI2xefQFlY7WGPwn8GTi1SUSg4",
-	"kPhYUDwBCV2IP+h1A/I61kTkOAUJXKDwywERZYcip5lAw/IF1cLHb/jaSb7HDnh/dtPOuFpYeupqYWft",
-	"pD+aGYv5slqSCDGW+ASajyANLt7z3lt/0AmV9+VTvgg6s2Vpuy04ZekiwSnsiqso9ASpEGknafkysNb5",
-	"OyOzRJ03D9vz1vnKTWudbzl6fgb1PowXrvMVvF04yy2XOh9VWhoUcqzzBuRL1nnjqrXORzUTxzLjCuu8",
-	"AxEG6nyJy3idXwKd2bL0onXeEhxT56PhIJV1fjhJg+otqH+Ncir+67j1pBZvkvDuHab6AeosfUalRdVm",
-	"9PtMY6RSxMRJpUoViAnQNwG/SmzaPbgGx6EVt32fKdX7f1a7RFuucR+oyRV4zj26OtFLvikdu4rFxft2",
-	"jcJ4+2747zvm2bX28gnssTX2Wsqtvy+C28ypf/lebwteq+Nbg9hs/A6Z33r8uZbicg6YdQKYbah7I4Xe",
-	"/CNpmb2xEGRLO7XtRi9XQ4Vk04MdHMpP69fUfhP8O6NjFhb4vVqixp1zvQg5pOylA/uDXq1g33CW9gLP",
-	"GUutI92DFlhiktN/55x3gCu8s45ttX/nz+HuX0IL524BaRdCte4ykfHivOGJ4/SlIb3k0KXds45avOSa",
-	"lfVXOFdZAz4wRCksxken2RGZJ/0uOigNRcPMR3wgKuVUxKvjAviLwbut6hOLcOLF8AIJy1Kg0itkkY9y",
-	"nqAQ7aTMwiBIlNyOCRn+tVqt0PHx+H8AAAD//6aVH9rOKAAA",
+	"H4sIAAAAAAAC/+y96ZLcNpYo/CqI/Cbim4lLqWS7u++0JuaHpJLtmtE2kty+7k7fDBSJrKSLCaQBsNJp",
+	"h979xjlYCJLgknuVWn9sVZLEcnacDX9MUrFcCc64VpOnf0wWjGZM4j9ffqQ38P+MqVTmK50LPnk6+RuT",
+	"KheciDnRC0Yk06XkLCOSKVHKlE2SiUoXbEnhU71ZscnTidIy5zeTT58+JZMVlXTJtJ3jav6a6nTRngYm",
+	"d3Pc2Snh3+mC8htGckWuqWIZETwJf5/TvFBknesF+dNXX5N8TnINLytNC1haDmObPU6SCadLNnk6uZo/",
+	"MqvoW3oyueJpUWbskhVMs6y9ZPucZOYFIlkqZKbcrL+WTG6qSXPz9sy+XZs7Y3NaFnrydE4LxRK3lmsh",
+	"Cka5gaN5G4H4TGuaLpaMa/hrJcWKSZ0zfJYKrhnXMzNEa1vJJJWMapbNKH48F3IJ/5pkVLNHOl+ySdL+",
+	"prbvyJjzvGBmm5GHC6pmelEurznNi+ANv7tkkmfB7znX7IZJ+F3IjMlZ11OV/85qe8i5/sufJknk1XJV",
+	"CJqxbHa9aePxBSyDSbJeCOJeRBqDbUWG+5RMJPu1zCXLJk//AYsPVhoAI6kjwy64CZAaRn72s4nrX1iq",
+	"Ye3PBZXZe7Fu4/q6zIss5zedEEqF0vEnmeAs/mReCNEN84LdsSL+yBFAHbhv6JI5vv5dcJYQId3fOBVZ",
+	"LxgnN1KUK5aR6435NUaFCGPVnuIt/k70gmpCJSNcaJJSnrKiYFk1UEgOnCqV33CWdY4GgwCQULaIUhNK",
+	"UkMn0RFha7M8Ntwy1yAcBnbZRVx2zxZhtZVb9MZJRtwC0FoUQ7ngm2X+u+f/+mI/MG0WCthZMakEpwXJ",
+	"qKYOZTclU5osqCLXjHHCJAjlSVLxYChHJKPZW15sJk+1LFkEowZLM8moMpJl5BfFmPVfGyCANgipYceV",
+	"Llh6O8v5zGjNcWLTfCRKvf1XLIPJYnt8luqSFgTfepRzYofZY1sswzUOTgZcsNdsVvVthbpKXe42JxIs",
+	"TPcvks0nTyf/30Vl/1xYnXrxHb7k3lZx+dYlEqUQy24dxeRdnrLZSrI5k4ynTA0t5oP55F3wBWqwbEht",
+	"D8LC2lVRS0ayJeMaDSzC7pjcWAsrISWYXVQRNA87Z+nVjg5E1RJqG+oRYS+Qc9+zXx0a6/IsYysqdSnZ",
+	"DIWzlXnenDIrbBBaumBZWTBCyQ3jTAJ5228JnWsmiR8UzMm60LP8QnI+SSJ2TCXI2tZkCBT73oh9q5Xg",
+	"ikVUfyXh+2jJjlaXnF169E25vGaonP27FWi8IorYElEk9C3rhX0PdW0LOm5vkTX3QQxQ87bU54fZislc",
+	"ZHn6kICHJmgnm51Q+x1OXp9CLjf52su5EGINUPSg4eUd47oTCzG1+TGvzGsGXyeEizUILmEMzy7F2RZQ",
+	"XYv6AQX1Pw1tHAyl9nDWhlie9R+gWvu2n2+iB4x39qGjAncoNG4SwT15SINBkmuyBlVmTp1GlQ0p9Qdu",
+	"f9jj+Hjjw8JwQCx2IKyxAnwrNgtqrCJX+kqzJf7Rz2Qsi3tP7Lk0Sh2hb8MOgsSQa7YcPn26aXtX/5Et",
+	"VwXVDHYRWbnVVt0Oqe3ZQai84YoKPqvW35KVwA8yYxJ0NZzvrxkBAWK8dyXXeeFhg6dGu/uIPy5CY/WN",
+	"eprz7wXrHg3OIbU8CNtRMIz4P5zMMKBwnli7woTQ1YrxzDgynLGdDOKiZpQPgDQKzSjcDIHHjgYjD5uW",
+	"fQ5x2FyIJZt1uoL+DsI4nLFUJS2KDVkLeatqZ4oRx849tUW4DjM/1Z0qQy/YhqyZZNtqDVXKznV+DhrF",
+	"7W8L3WIJdoC1m5S0Bf67YR5TTNX7PYs1R424/Olx0O/i1u8Urc697j8eWu8Y4Tl2GcHLPdP+T0mLXG/a",
+	"c9E7JukNm6lUyHrIIhPldRhk4HiebGv1iJ+e5gXreJZztWIpsILaVnhQpbpGHU1YNXy1OCVcnJ/Q7ydp",
+	"AKsH3O9Fqdn2VAnc2ZI4sePGPOe5ioUqlc6XKAUZz5yoLKjSxrpI3NHLhw788X/cMcdEYIyw6sCf0mKF",
+	"j0BBD55cEVAftFhNqmMelZJu+jFn4WLmai6rBy8DB8Zjibau5fwo5C0E9WwIjRbF2/nk6T8GQMZWQur/",
+	"ZpvJp6SLwDK6iXiELulGhdpVkQXNLAkkRJXLJRibKyLumKy9F9X+/uGWUbwuf9UzjB6BYlRAucKGzkT/",
+	"KsxrsxWTs2DzwcxObA1Er4JJakDsnKFNZT87xHoPWHuP3Jr5uSJzIQl18YTE/glnes+c/hl7fPOYUJIx",
+	"tpryynU3J5Tc0ZRyjd9h/NIMAzQMZgSF01XB/AiFuL7eTPkkifsfu6O1x4yGeYgcIBbmbfNtPD6DZ5XR",
+	"werg6AtPttj7kko4AFtq3G33nUKL/aZnVMr8jhaRc7gL11l1AW/7MJf9zZAX0FYVgXBgUzuvlwtd0yLh",
+	"QSEX0torox3O79xHwQAzkGUyz9jOIwX+uDrc3gOrOvGUeDB5Z5eFIADN/bvH2S7LIn40e8/SUqJTl8A7",
+	"AdWAq8zEiUCjz6VYfl7usmRyl6/ivhIT9kIBqDTdgOADuCCNA6CD522CBXaj5G9X77rXEPDxwJnZ4x/w",
+	"bHWWk99ADiOTdFDCbHtUc+T6vCxu366ADixO+qzOuBNOC2KyN+KqHs8rW7FQ/YjjhVMDlVTeMN0IRgHa",
+	"zO4T6/UglGd9yxNIJYyXSzQUzWIdCCcuuD9JJnaMn5OuI/9WWwSwG4OybV2sxmGt0xJdiozVXFMTqsUy",
+	"TyeJ36f/4ZopPWPzuZA6ujXhSGO8Wd5DXkN2ejDbSBB0BUX7jpEOPq3NSqbKQu+x0/c4QHubYOKnKWMZ",
+	"G3Mqx/WFnwSnSLfEseBB/DeBw6QUssdp3bA753OW6lbQPO7V4xn7LeKes25Zp988np0T1nrkeji0fWjS",
+	"VJcqZF5xG0JK3earVc3J33GsN2vGefyoo8D7g2f7+m6/zVmRmdOHVV7u5Dw3T24ZW8G2c0nuaFGy7U3q",
+	"+2Oqdtlhn4Yg2PJjNbwRv9FUFxvntq0gkhBrVaFktxoW1PJNfsd49TRXU57fcCGtx6I6L+1yhrn/AB/I",
+	"DOjxTDSdJcacCLc8yA7vApu76Zk3T4xBVRchCSnlDeOazHOJczpONj9Pkskiv1lMYM9ySYtJMinEOqqm",
+	"oovpVJCrztW+phwSA90LCeFlUWBqr+VgLcASNQ4OsVyVxlZlW6wdRqTgHI3bx4N803JDfeGbCN/Es5VB",
+	"8BovltK4QSd+7z+bQUbMwVx9zhe9zHlZ32blqO9ytb1mVJXSuhu6T6VdLrPm1B2uMMFVucQpL50Ve7gQ",
+	"fK5ZNwZ/LSnXUeFgFmXCw/Zw6sPsIgwveylno7yjg0FBZNitMVhQlDxagNo7uD4aOmOCS3vtyJBwtEak",
+	"d5GdjvYumn5Rz2g0NRepQ3dPYskocnGvJMSkJ9yxjuqNXA8HoSoo2rgTfhasw+9yELgdFNIL2kKkNJ5g",
+	"YckP7V6AmD19EvdFmHsRk/qHAmQnvAaoTul3TL7Jbxb6cBEVEdPQMJUDRr8MNYoOowccFtZThuNCcmla",
+	"rnKWEXxfxQKw7pWZfWW0pmp+GJfclzz7cJuvRsanu7O65qLkhvU6XTeSKZv0PtMi4iu3D+simd7QnBMK",
+	"yR+56q37GGGY+c12uSGqJWZb5j9XB9i98qbbp+Bq9d9irdb25XeDRXSN4zBMQwwBJqTk+a+lKT+zZ383",
+	"XxTHn2eaTQhiB7ctHLgI0QE9P4jFESWP/ZootonoaoVkKVX6kkZSSK5pQXlas0QbMoGuaArnR3hDEbcA",
+	"Yj9ICGc3FFSBiYuVHGSppvN5R41kasfrnvG1eeBEtGdgohb5XA/Hs6MyTbmzFA5iDAyIBCf7ZHJ0mTPv",
+	"pPgl5rJTvdmM3RCpMkOyUtaU+crP1DMBosPXMIUDI7ZcOZIBL8mEqXT1iQRrm+iQmNwC8/ZmytlvKWMZ",
+	"yTXY40shQdBT7vH12E0706JgEqhsysNzOjdOTPQawsjDnkKLB7/VFuQi5FXLDmhSewCbGOd8Fz/iv+us",
+	"YE2I4MWmamZwvfHhQ8azlcg50m+dBdmyXsBe0Rf7TTPJKcTu57HgIlSbGHLwxSPOoeumBaHJGbpQ3r3+",
+	"ECPigvKbkt6wGBnDqMDs7h2bi8A4jCfL2HCdCqMnYLyoO9sCBeFiiAN9DCK4u/LpYJG8uTRuQv8IUgEi",
+	"s75CO4jYmiI++LvKNAvImRsvlGRixTj+A+ku6i1LxXLpGmUcpJFC3p+w1xFs6G+D0ErZqxUk4uZm/SNs",
+	"G86pUNYTx3Gpjg3MMbCJUUdQiY43s3w87aiEPIFT0VdPnkyS4RzJgT4MFUyDLEOzrMRRVrX3wTYM1aaH",
+	"HAd9RDOAaD6bWxnjWckTaBJjAi1ADzQ4wOqJivxNm5SACUZR/+HJoomxAEN98bpq4HiNCSDzritDaKB1",
+	"yRHT610did0my/pS7Herylqz+HHXsFmsisMxwBZJ7naSxMF5GEUDHDKEk07Yh9u17PHV4FY6k9tb9NrF",
+	"ytt7//Ch28bg8apbgnf6aOwn8W3dMa6F3OzCLAjVWbfz6oMWkt6wylmVVo4yPAk0DGlsyKLpLeMud2o0",
+	"By5zPlNapLfx7CTHXYVYw9ET36xS/rTQtID10KLwi1Xw/jWDL8Cpsd05enu+xyUdg+udA7S+hh94jpU6",
+	"zvuXMwP9VJTcDG6twlWqJsnnWY9jfbwV6URo2suxLRwJNZ4alG5tJmoBqkHcVpg92c6z48nAfj6JYjZe",
+	"9dNaZWzbr4QpTu3spRBlhuf1tFaTJgiuypUU1/S62JBCKO1ZZRTJp4VQY9KcHe/5k52QJMvVSuzTpmhI",
+	"X9kJZnjyiOQFuSCqW1xWMpPmi9/Rwh9ZtnO0wlsl3+4AYr4Y6oJW+ZRHI6jTjuo8TYZnkr4oE/hS4I/1",
+	"Ik8XFZJBbuI6Ry3P0UPUAf7jQtTHXVCsbtVikmwZ1m4nWxncT6olTDzBZFGzWxkV2y8/PpN2QOG8kZ17",
+	"eAak3mS3LaS4E2dDHuGBXI3BbogBV3aH/rhYT5IduHZXFtu2QcowFTadfv24jCNk/QGUYFzH9FrYNQW6",
+	"hdLsjp5+RJMRx2wZjkeIQ4c2Sm/E9TWFOTnlKfuYp7csQrG2JyCL5Wn5j9E/zCQKtwKTLsBONiMmp+kT",
+	"OqYgeEtVoFTJttQF0LRFzI1PrD3jf9ucU1tnUmpfJxe0iDBgM4aGEsVdtEtEvcLG6YNCrMNkN5v8ZpPh",
+	"4m6YlZB6RO9S92IFmJGq0exgL12CsuS6EOltFI1vS/1IzB8ZMJr+WGHVkz8AzYUM4Dtu+dupY+v3yvls",
+	"JcWNZEpNKhBE4a9zXbDPWQmbDQbUGqjdGq/UhMIWerclwQYUcCjOtpYx/Tqvzfv9EYukkQNr367490xs",
+	"3csH/flGYf3ciEqFLvJv0JR5LYb9t5AOQ3m6OVi60IjMnGQioyUHb+2nVfzBg6Q7EwhBW83V6ACIv4cj",
+	"KedrxXa/w77WcPh29pDdSTyJ6C0/aLqr4DOUW11NkTHcds3mJobsesJkHRQ50K/ZpnR1fu4QOLLE3K29",
+	"H15YUBH23dq215Z5uJVV5L4ZYvQR/bl2iFcMBA9H99Ia0wMriL71tL9KevuKOVdqRC1kGerrg4Rx/qn6",
+	"2TmYDundHgDv2evOreAHFW0ktMNZo+GPjxzP7FT9Kw6Hsd8MhoKravH3ZcFiDj+Jb2BeR62RhNG9vnlE",
+	"owdFK+OkitU06cs8wWL1qj6dcNFKL2r2CRxVLtKdh+v6mISJy7Fkfqydwd3WWpG2seTWns3wdNWpLRb0",
+	"jpme8/4L6KKixbDbdPeWDruVtkgZpYr3374gf/7zn/5MpCcfxJ+J3n/7/uX/kH+9fHb16qeE/Pjy5X/D",
+	"/1+/ffPx+1c/ESGn/KeXz96/+unfEnL15uPL93979iohz3+6fPYT/A/fC/+N4H/x9oc3H4Hafnjz8epV",
+	"MuUY/YGJ/tNM8B84wH++fpt8/B7ew0d2zv9w0/znN1M+ibopqdQq6ub6NpdKQ+q5yq8LhrnWZr8JybXB",
+	"FRBNRjfOUloIzQrzABkjt6UF1g9TjTC+i9ID6sDQ3/0A+YplnZ1mTFcEIwl2aYrQqD4x1Bvid5eAWV1E",
+	"blfC2ld+1ysiextM7lcut68o6GefcRS9RcncIE7jOHMng3g7EDjNWDWGbxIp1jaR0tyWwwrv1cGXZ9Ae",
+	"NF8yrrCCXRHFdAuBQ+3ahrFm/EnxRWd0k7jGJVg8C7+4Ra4Zu4WHS8H1oilYOkM/8GvvVS/IjngOdOwI",
+	"nxAtRJwl24gA7t29oalRu/t3M4Up4gEOVw8x/ngyPnsCVw9JGvwGXBYHTp1oYrDPZVhf5PeiVOyWsRWY",
+	"OOYlT1m3edUIUISuTlDc9gfbcd55P0NEGVEJEjDntdTrLJfojnMJgD6TrO2Zs3/aWSY/dwLjM9SQISxX",
+	"BU1tsgvYGkiqxpyw3IH1F+GNVP+/GnsZk7ney747XhUKsXxe2EjV/ucexrO41fWyokFDYwkRK8YfmU7N",
+	"W98I0M3LQO01l/oQJeq277PjnpRhd/52SrPf8Y0b8aupmzxDR0CH1IEj9emwdVSs9Bj7H+BRcyu73kER",
+	"IquOnS4kjEg13VaHDeuI8ZaYmaZr8R9QjQz0S23HsLrVwmBlTk/bHljQR2tjNWzsJeN5NHl3ggeRa6oX",
+	"urxOyDUtUsE3CbnNdbpgnGntCtrjxnOzaoBKRrsKI+EZnBfVryWVjCyZlkyNqRNIJtcsU109N00ZUiRy",
+	"Dl4Pc4dI1dkj9OLMrecBbLsl3aCHosfFUcz6vSvt66jsRaeCz/Mb00jCOlwGXCpDvtDIBY222NSexp2x",
+	"Zs+ZKuc3cOg08AWbR5V53EB2Vx8N7rV1R9Kum93SsERsHc26/Bycyp4jLDda7kkCKbCl8QNCZcjzHEqY",
+	"7cXFwUTAMPNukzHeZIYxhQo1BOCiu8A6oDbOofki63Sdx3for1L1X9+ib3p82UU0GPwx7A3MfO2uFsRM",
+	"HZQSYr9e293XnfqC7u8rX4FZX1zYJ+kg/YHDguhxUBkq/XM2X309bqu1bsTKeedckTb3IIH1Kbo0Dpcp",
+	"95/gAEIvwmsVVWL6ycKjlh5QyZRrqm5jrV7D9lq+QaeFaHCTnrHkAUIwUNzUHTxljCe7dc4zsZ4xnvVb",
+	"+TUSaVQjf//909evewb3S+qzvXeaYLwpG15xbc/ALT9j7SQ18SxsGTAmySK36nWKinoKTkbzYtOqinzX",
+	"IiirKdETaP6pF7nMQkega2/MBWdAf7U+KM07QRWhxRqa/PuGB3WfjV0WSwUmBPH4YSvj2SzeFfMS1sKD",
+	"HEdEIckEM7dSrynHus9rRrJc6VJet09YB6XTCI8aeuqI3mB5mtZMwsj/9x9PHn398z+ePPrrz0//8eTR",
+	"n80//+XgtH6aRXb42eOUDW0atr+ehPFsqyaA2wcvEcBbzfGg7dranSZ+7x7QWxiziNG9rhXaHr2HQ2Pf",
+	"VS9tsET3D+nqr1w7nB2bofVm5O90pBqbfx+Ulfg5h3EOe34t7pgrBN7fg7tTt8Wme08yVzxAs19KpZem",
+	"l2pQjhtVO72YAFrbr1TL9K4N1rBlJ0Dk+kori/Q26FsEFmEwthrpsB8iAOtnHHD41uhgQAacGpGH7S/Y",
+	"SQVbtLSMNBO0O4/B9u+2rUyjMz3eN7Vtb7XPM+HPw2L73D8A7gC99kN6XOZfc4XtpcAXOZ9H6i+fvbtC",
+	"5l5STm9AmBhbrUqd87cdPJ7yKX+J0HWeOnN5JZA1rzyX5F/NEHAtjO2+9m8JUawwfTjgQGnM/P/zyPkI",
+	"H11lZMEodtvucEX60Suf5OMpf89SITNznRYedCvgYv092OxYnPSYgMPhInjse01NuXtTpWKFw/rCDAg9",
+	"w168qLVHJvLs3VVACE8nXz1+8viJvYuC01U+eTr5Bn9CU3eBqL6wZ2j848bUknnwXmWTp5PvmH7u3oEP",
+	"JV0yzaTqTCyvXrm44mlRZuzSJh58SpqYBrp3x3gFyUzKHW+AaBKCLbYSgo2mAHmusVbQNysVXNOcK/S+",
+	"JwQ7cANUUqrM7YKTp5NfSyY3jhKf2nvDk4lJgY/RMobLTW9KhMzXT55MsP8H11bt09WqyI0AvfjFukyq",
+	"8Ub1x7FgjbTFaZa1TD6UacqUmpcFcetCjoPr46jcTJ5OXuVKY26cR6jJ5o5g9J1QIUot4zwX2WarPY7Y",
+	"WuM+mrqMAGn3qQXnrw69hhg47SPnzm+A0qyaUMLZ2oETX/HMcvFHnn2qcnDaIDYU74B8lbU5B+kS2LAi",
+	"SxONrsEnQqOVXmgT6Z+6my+YlWYJyTVJKQdvAVoa0l91nWP94qqUNy2ImO1UsEgGRcWRtpxsLXL25eQ9",
+	"KSyjmk6SiVEkuADU+h0D29cu8J1Pn+pI+I5VHuDrDbm6RA6nOl1EWBx+Pj8u5q9xeQYJY6TMkskb9gg3",
+	"9b/auGjnT//vb/79LwQ/IvhR4x64hEhGs0eYkmivkAG9am9pmERNkiEBdRLyMbGcjFwfhIySyZ+++joe",
+	"7nATLKgy2fRLkeVzLIjLQbuivzG/Y5w4u6JOlO+o1DleWm7MTj/gv/7Xh7dvQtT8G5JrGdNHpX6ApLoT",
+	"rusxui/0tjW9/VCjsohWvqBc8M0y/92cIKNpB2/QtkTDMdUkY5rmhUoIutYwyxxWETE1QXZIthR3LEum",
+	"3PVW9V/87eodmRf0Bt+7ZSv9mKB9WxU++B2iibugasoLNtcugOiAE97Kaiz/bgPuKnvmN3waI+MkBPmd",
+	"iXFQTQmT1DRr2p0a+wwjfxyb4Jt/7bv7EqgW3kekbZhuEOdLWKnLe+voNBwjWYPsbnp9TeFIG1KIJw8k",
+	"PvOXwgRXWF7my0SqxntTXvXjhs57Ls5VxVBs1nFPGU4y5TmaVzjsHZNZCUTN1GNyWd3HEJD/1bxO7oQW",
+	"oJA3U+5qW3NOqE0oauU7xUJtQYyt5AVTqno8C68axfL9Yd55YSB/NMY53skKF35eReLW4M+j3ecsR66T",
+	"/bnxeSUkLTWFw9cPcvh7n7JAMpzlvJv3nDcH6JimGi45S11uBjCGcQ6tCsq5udEMFobUP0R6MMoVf4C0",
+	"9/KOcR2Q3plsFp8iIxFFZ1URzyOa22n1lvQjlfBrEKwhNvuFI7NuuhWl3oFwsQCpl3KJS5qo7r1XQe5T",
+	"NQy2E62pIr1oy/EpzxVBq8mFIOoLIauiVNEvwWtBNyPEOIz3ttRfmGmU3LbQ6pPcLzyGPHcdw5AKzAAh",
+	"ozwCND7IJO6lBpdYB1fII31U9N6+/hmZ0e+di+9gBzuz7v6DHd4pYlPl8WoR4G0ASS1zkRaFWKtOsnoj",
+	"nM/Sj23b2ufKOr86icwVf9W6tdlecS6/q0VVCKnmnDGysjUzs1UjR62MXjnC0VpupyMZK5ybbtXmZOmN",
+	"26SmRaacZqb9KFmik6vKeMLJo/Ix9KlEcuo+Ty9LZKP3x8cSrMoWPt9va6UmgY/o0oFaZWRKVxAbwAnz",
+	"R+qsaMO3/QFL/9JJ4nh2tgMG8qpd9kfxavs8go1ixz9vHM+DN3LAtM98JO8woZZnWQZ0V00cEt7Y4J97",
+	"/6zRPwefzIfgJ3/qfXGcFeeAQZTOiwJZHxM+VDxwGMIyGebak0HsyWmJdH/jayTm2nFD99K4wOGREXGv",
+	"I4d27/c8dNhDbj6Wc3Ky6zMS3Ot7Bn4qSRKNMIKgTl07x5lmy1VBbb50l9Tx3R8/+rfjNN9IImrWupw5",
+	"mai1DWxmeTiLxEOVOKiaK9pCA6X7Uh5/gLEhAldHi06lNZWZirtLo8g5hqUTBd9ZzZ4OhLYRCL93ZDKh",
+	"HcMNFkLXG+LR5GbXbtzv4p+IyRNxF8E3aLKz33KlG3c+xV3ixj5oY/mcNhPC04Z5O+0lfKlL477Hjz3k",
+	"fbhtBOyD63A7BZZ7Z99szNPIJbPaAyc5ejj1Ho8CSB1FZpjhzyslHHgjLlzzaEySY+pHCWhw5DnHAfmc",
+	"LOu2eogkx7Qi2CEO/KdIchxBYQdOcrQYGHdWOT8uznZUsXC63yeVHvJxB5X0IGTUc/hwExwsydENuF2S",
+	"4wMk1Z1wfdYkx8+B3n6oUVlEK1/4w2d1p4JVVh0dTcDTL7HVAcslgW2VAAp3mQm5LjWas7C0u3xl8hjF",
+	"fMp9TCAWZarpQleP9NZ1HD8KiUeagTt5CJZ1Um1JCalNWsFSKE3M1SsOGtj7taM8CD6sHehdaWajP7IK",
+	"78iJdFo7nX3toH4YK/s7FhjZwTHueuMp++oyRpWj4u0VwTzUeHuPiPHx9oPJmIHQuJunHhrvjWz3iBUp",
+	"Ss06hcnlln1/QMzYLsuYa8SNNGl3//G9WCEurx6TH3O9MBmoilXdj1xnJjsKprgKhc3ZuI0+eCeHTWUV",
+	"nD0mbysnBBZUaio15I9W99YEQc8wHbvRVMS0I6kl8065WDGuHhPTjITyzHWKgi/MnFUfqcoP4EtJqwQr",
+	"SE/wNa39ghabaJ1Gvl525wITLTIalr52SFPbfqaadaAxzSlY10BwrHDs4EI7VqcX6EN5c8MUIF6BKQSK",
+	"39IOEE1LulYuISax+pkCDwVcGlX3cTLp0cKR+ttqAcFtVblybXFiSHVdorbU1rHZhJ3N9ZCKTRcUk+89",
+	"n9udj0jGp3SPd5j1i01yeJuk7vmr2GaEAzBkhqOdetwk53cGVnDvcNh44I32DPovotLo4rosbrvzkE13",
+	"Bo8bcL/d0SK3MUqjgynfwO/sN5bCseQxeeteRx2KGp/7x+YyCfh6g532sXKLYCI4gt2s9anhJyCaKTc7",
+	"daaGOcWrxItbc8HkEoDplDge943JpB6TZ3zKzVdJ8DLeVoevwLjS7CWlHJaDtgPYI5Sb9bhFZE6hopl0",
+	"DQfyBCd1LzrPZWmPq36SbMrRySN4yh6TK06oFss8hdOlAeGc5kUpGZGiKBS5pumtudYC7ohxM8GNAtdM",
+	"6Rmbz4XU5mP4EGaqQZ2o23y16q5AqzPXcyCCEzAYzHNO10J9HT2lL+ipcySbEMWwKuxRxQiSKWjLg/bF",
+	"11+fZ5XPDAUhbSDZAFfR9LZ3vfXTRckJtQOIeUNehBQVVnppSbmiqXauj5ZI2SL04Cnw7AGIats+26oz",
+	"wBCI1GSkLfcAs6K2VUjHCCRUo4+PJxwb5vc+quCB9gCCC330VXP5HorOevIiG/M0E6wdZIJMADSovXPC",
+	"XYQ66F2u5jhsUKMad4fYxkNkmz0I7vxxji+kv3d8ZeBsY1pKaE3TBR4PxrterrJnwWf3UXOPOstXm9j5",
+	"IN/nP6uQ2eVGw5P/PC+YIgYPJpGQRuyo7jzEJctyakrQ8CI8zdKmRxZghuewZQkFGO4WkCkPsP8Ya9ZY",
+	"hjmfcCwkelEurznNCxh3STPTlfS/3r38LiHv3nyHA3539e2U50t6w9S4s9SJSKdLPC7LQucrKvUFuGof",
+	"ocSoEU5fh+Pu68zLVSGoLUgGfEZ7wdY+j94BYe7P9E7k65xTdJQNtKOE737eyVw5nH8m5KU273ybF8yT",
+	"+A5cA3Lzm9jluwUSfUHlDRZwU25nMSS9pL/NVP674dOv/hzpPlLjHZjQckEz2RQHJRSRG2fRMRL24o/q",
+	"j6tdToEB7zwLRjqWRRIZhdanPfRhs9rVYJpq8OpwsqoXr8gsW5xLPy+I9ylRkWqmHyktGV3W+X5YIMUZ",
+	"3k20BwYvxZqDaI3gcAeGu/AqbUdbJySBj36sz4MWUItf/LJiN3tjH2yEynzYCv+uVwIX4QBxmsDSe/cS",
+	"BhkDIsHt9FCJt9W3IQWfzf9wjV4TynH72KuSZj/j9ztbG91VOTCkV/13F3/Axq0+HXuIr0HgpPI8dxOe",
+	"v09LDQj4x5lO/DGi7KgGsqGjsdQnpClUGa79fdE65Ge2M3dXD66PeYp3qpdcw79o4/zfR7tBLczYEK/N",
+	"QjxW/uFx82bvSQDZLqQv4d+EQNsnAPzZR1W12EJQ+Tw0+6+dTH+H/BdujBOKrDSY81j1Ld7cj1nwPncI",
+	"nBrjAR/cK7KNkg8+e7Bqvn6Xz5k1fAVQ33LLpoGNdXS9xR61fKMXtrlLfRyXXhDcAYNpUblWQas2POcb",
+	"nyyBW3GB2wHScEvGgnFbQAXAxBDnWIfWSehlN8k8LqWpWr+XzTF6OaysPg4Vv4iQ2h6mQqw52xpuJW1S",
+	"rqVxlvWIo8xchtTtyY1EF6gi04lNViGPyOWby+nEJBEHPbVm6JDibD3lIkzQwywc59yqWuRinhBm5VhV",
+	"51KL4X2btcvkaH/uJc8ekkFyybMPt/nqRG0J/WzdeSoIR5eQtAWtpu0c3YNZtpbUKbkU5I3Q5NJcvknQ",
+	"BqJGqmdC9Clfe1E/Xl62hfK9Cj57sMq32sQ5A0sBBuJH62REe1WTGQXfm4u+aIr309VPOua2p2q+KV9R",
+	"pZiCDHpIbDXRUPjNNGZVqTAtr+GPNctvFphKvqDS3G8KH2dTjp9BJMr8EExg9tNqqbr0zcQhH3rK7Qcs",
+	"S2xlRq0tAXSEZSv/OlGa6hLnczmDnE15fUyS5VJvUHqyHIeUDOsk8I2KN01OJqFTLtkjnNU+jMlcsq3I",
+	"PQmTHF70Vss+60kw5M6Ik8E/3dqA2EUSu572DQa2qyDUiOrRRx6fH9/VtPNHe784JUvKoXGy+yLoRV/1",
+	"8WzWQk15tBgK+Nbk+ydBTQLcGWE7pS7ym4UxOSBjGXkRti6XtOjo8Nkk+XduYw/N9+H24DbwT5dTs7sW",
+	"g+6Z1tAxR7smyXY6jG3Dvh6741vzxpgaIjOYMaoPWFxzEksEt3nAMhUL2N7KFA/aY/AUDn5W9WFBGol5",
+	"FsKr/sN265wX3tbGf45NIjeYOGfyuIHJUIdO89a49pwIgKA3J5TrdbbmnDvy7xcDDzD9fIAKjyK2W2hq",
+	"efsMckYlox8V8vc5CR1hdL9zzzuJyxkJ8xMSWU/OrFnG3m03zTBdPTerU+fMq9wucVIdHq7w1dM6Gw7c",
+	"FrPaeDOxuV//x4BwzMPk2dtZNjGwUx/LLmDHiXCwa2XYoTRj6LWh6AixpW7Wz1L3Eg01s2xg9rPpZDkW",
+	"9hXYhwXAQzQqRntGUPJ3Qr16r89WCKBuDQYD7DvGtZCbCxfH6we3ffvSvfwg+xtXQSu7jwNK8SAkWgvE",
+	"hgWtMJXq8wevCpoy4+P8taRcw+E7b9TUmeiqu+IIZ7KfoIt4yj+GwVlzK4yN4a5NRMq6A9bWReUfB8vG",
+	"+7YGI7QxsjiKg6eFuPOmuEToqE039hFRTO8mOMEtsxBrsiyNUet6ATe6/noa6GLskYfYFjLPqXYc8IY0",
+	"j3tv2zbKHZwabahcQXSEXWpfdRZZr9fLtmC1YZsqWSLnpFRdbXPM7alslnMTIqqJVbutyVO8u9TLtmsh",
+	"YFunC8sFUDiooWzHHWcdN1BxHOM4mOTMtnEN5gOm8QGdZryBlijPjLDnQmw9SJNuDPyPcpLvVSLGAgwR",
+	"FHEbNbiRaRd+1sLcggz/UFqsSKnc/XcwVIKJX4tcmcE7L62FeU6C4fvsjzIAu8/uqEEadm6p/GS03OOU",
+	"wkXs65NqSq8e75QTaIVYzxTky42SZ6/EGpPrTuKicpMdTu8+M3IAZ4cKXBQFmhYEIQAsf80KsbY9gZc5",
+	"z5dlSwUsbWLhONPptX971BkTljamwV7s20IYePceTVvN+fwCTbE2xeCpu9o4V9jfsqsJoRTL7kaWj+yX",
+	"267AdyHtn1yL7ad+QDnUbXvR0KinvgRSN5nStklib7F/dfoOLh4y7WVhTNeZ0dJPYlOaObuhwC9TDtnS",
+	"wdnCtIRb0g00BXBvmZTq7JdSaVNGPXTKDjnjKFefhng4qzXboIg2Bbhnw7lDVyNSjSlETnPIu0sINSjW",
+	"FC8kTYWULhUO8+grnDal3Hid4BTCfZBup2PwV+yOFYfhbhyPFDCgIitm88mBxZQWkt5UnGmQVAilh4NK",
+	"r4QpiRupeEwaY7ShqmngjVyqS8nxn1muVkKxLNZRtQOBUoidkW8vvN2x4a3ZQJVPqq2CN5ugBbF9l2Mz",
+	"Z2XU1XtiH4RD5gHVCVCRae2JBdQjrq4zd5Tz4Op063ZCZSIUizdMM4UHotRTXqu4ua7uXIY/C8CL6TBT",
+	"5c3qBfX9ya1hYJqdI4usqTKrt5nELnBVGmXk0YvWDOTUAkZywR9XHNSlpULuOYZycuOfVS9VVNVxwJfs",
+	"JleayX18LB2K7L0Qy8TRQNLqkhktVGgoO7M2Qs3jwF1ToXfQU+Px/ACdNIPoO49/JiJYRjlpjAYCYeL0",
+	"DPFM7KI9nvULRu9snMgqyQ5HzbER/MVHcywS/vzcM1HW6HLRLCkQGac8ZTNoFMB096VGP3DJlCju8GIO",
+	"fLV208j1xic/P45dpPG6muqjnWlfm1GsGEeLaraS4kYyZbOacJGHNhpPYoK1gHRAWyzAtUNgtymGZZyi",
+	"1DMxn1XWFJpLeJGjhocgAsxDc8ujXriR8b4Yh4ku8ydKEcewg1oTndUgiuC4jVPzZP/gU69htIs9tBLS",
+	"VOhUxJQrVbJOeTJoHbXJ4AGaSdsg9Shqxo7dZzO1BcCgyfQeWRhIhLpPGLfll6LUj8T8kaGdFZO5yMJj",
+	"VWLLD7G9QW4PWMreb4E3RH1Y5zrFpgk1QWNfFLKayow+5Sajo2wqoqiAgQ2djLTus4FmYHS/TbRR7ONs",
+	"NX1SNuqx1+xC9rbYIozZZbDVe8B2idR31VunsFrsdJsDpwZWe8U/S8UkxgMojtDXkKXYEJpBZE1pSbWQ",
+	"ynyXZcGYj6M2SQNyh7dFHKzOaoJUCGsjyD07jO3xTZxzEJeurS2vIyuWhE5W1YprXDBoXVQIfYBWxSg8",
+	"7S8H+5AEjINYQqYL+XLTGT/yS+uzRtwogzZIFzunC8pvWD9Hw4hHp4D7rPwdlO+3+u+jc6f1V6eh906h",
+	"tAWt95gMfhd7Gw1+pGFTwfRfKJUFV9S708VlhdPG+HnUsxMy2A/qqE0gT2XJwDb26o9zbBKLW0yII0JL",
+	"vRAy/913sutVnoYuLv6A//WWbXVRiGR34pb5We1tuh1lWi1agf+csF1kaaYbHKcn2B/rFh3u3QIkOzwh",
+	"tD6sI7qumxt+G8QRvFHDk0ngxzGbpJLEm7R0GtduLV2C4l2pPwPkH17nGVETcRYFyK4QfQbp8sxjtoNS",
+	"QKhIZi7/TtlMlkX/6fS9f/c9vnoKwV6f84AH1WrjxGy8N7uh6kCEbrKyYKRcYaa4bYH4OAAkQl1wQsGG",
+	"MReymrZimmVEQmcyQtd0k5CCaiaJ4EyBMT01NYSbcGnuu+mkyxkfQ8rhT7/1Wc56Bm5SRJsC3tdRe5gD",
+	"8ZNYUSrezIuTRA+/BpGYW+c99iC3MRwjpLkxPMqGo2qxK6KKkCcmx7jLwrDhF4QW4Q/XLm7Kq0tnmMR3",
+	"zWyZ6ahnW2r5Au4p77ANGiR4zlq6JuqHusQ03++8M8R1f2mIjb4+MOcCy5MzstpRfLtjkQTeiQZ+Bp0U",
+	"x+Ejaaqb8crtevIb+j6MyOiMfpyGcO6z9wPAc789H8Os4fwf8sws0uPUwFf3dmg0N9jl15AYg1YX14LK",
+	"rN/OxBef43sDdcWXdOMuiF8IzQosCkHFmhAtoJFkPidimWtjAESTeE1+b0e1yGR0Ngpesz+73sSrlCew",
+	"pknic2BMG7HE/PzzmapREMTvxXqMXf2WMyLFGnPQcfHOgjGNPK3oRHGZgXFbyVJs9znFa/6tWMTLzfFb",
+	"ZGmONtHad2kww4Oc/R375U6GLDCkRCEJosAiKSDVem9I27kYaMNe4V6uQFDXNlUnWXdn468lLWwn1AHi",
+	"fWG++B/7wQAVvxAlr7fNOVe5VcdKHnrZVQMdBzlG1rr6mgZIK+ZTceIEtBbyFu4Z63TnIvxNuAb4JbwT",
+	"GEk37PVsB1XGyjBtc/WCbaZ8zSSruvMn9T4oRK+Db1MzIfgGroVexIySFlX/6HYxQNbf5lJZafDNE/i/",
+	"qgjJ5vQPSud+uh5D0q+oW8RIhaDFfhNe5kvGlfXSmbSatUq8gTg4f1SROL2RUfh9zRhUVS0FRxPQDn02",
+	"PdKgix00Cu55UM6bfKLENQ/By1m/+ctfkLQSvGzDvuhB2K8IAn5FdZPRTYRvsatTvmSdTGtySpbMKhZz",
+	"EQKwmyy5Mo1YcuAxZAd7cXejLVgC92xUrwY3a8C4+Zxw4UtY7AhAz/6SmTVVpq88y3ABj8lbKzAkM9Bg",
+	"WVNOkLqYsHbUlDcMqREiIec3H410/yIPtpMHrmPYMaQCpkfbdmS1yc4rJyyx3Fsh8eyOYV0lMkBYsaaI",
+	"FuK2ISDG3QfmmKXvWqeYIVarqz6vTdheyhGNwnipZtpeSNhyLvTewJI2q64l3d9mfYZQRvLGsI0ajOyL",
+	"h5sErPRsxeSMQ2CiU8V9KJcKQx7AD8JcrAr/rpSdamkSl1xMeTblWX6XZ0yBrrJqSKRpucpZZtzhOL2q",
+	"XTOF/JkYrIObjSo3MH5KebohZhsDKgryA5l8gxv8oqK2UFGj5t5HMZ1NEQUkcW8V0feiVOyWsZXpzqA0",
+	"zh1hmzpHA7WmVOleczUqNH3ZtWpysAy95GBqGvtRlAVUXuPpEcoD3OeJq6EvDUMqArsynpYV3gCygXrv",
+	"DNtuUskyezBdMLLMealZJQgW+bwSC/7UCm6mKc/opp/zv3WgGMv1+/oSD8f6nSuhBLgJH2N3okPLhtPc",
+	"IWIRc0k32/IewEbMA+ocyYfAcCEn/vVrZMQGz72TAsIJ4aU1zmfjZ6c3NOc2c6vSeYZS66zo9VQnL77E",
+	"G9CQi4PYcJ37rIuGqor3TXdbwoWe8spHZNmv1iQhFeC+J7lOzLkRnufc+2mJkFNuNRwwJN7jhMZlPqBV",
+	"3/q9fVGpX1SquvD0cG/16dvKasR4QcUlTbbl/f6eZzy49rJkzqBF/nqUe4Rz9puO8uQcmC5yN5q9UnCd",
+	"Kz8m4744DynHenq82PF+HnM2EjZyZ+5Pc1dyZiVL6u5ke6urCsLb9u5B+HfB5lgnPCAA+Befz73j/qR1",
+	"tj23b9iQyb0VCh/cDaE6YhUjA4G2BEI3IsJBuj9TUojlR3zpJDmSdrZDZkeCmGq18I9kH9Y2eoS8Qzv+",
+	"eTMOPXwjCVAOUEe5pM2joUl8I/vce/ScNSfPg2gwG8+/OXxv23u8Q9Td2XbHAp9fV+peBcxkmHMfYrre",
+	"KDo9Tv5RDHPt5Dz/1lBa3jOilrQomCQpXdEUL+bEAgYADsM+UHV/A/stVxolN2fK5Fxj0ioBT49Nu1PV",
+	"GBsfPw+kveVgc/5ZU5mp7hS9I5PJvU7Oc1i85xl6Pdzgc/POwBV9+Xj+/f2T8vxQnel4eO4ZMGIiJYFD",
+	"dGnu7Lh0ov7nU1lAB7R+4H55eywcMn6Oafic3ejpVCTxa+fMem2nGGm+d4S2hcFyfmPFl0HkeGQGD7dk",
+	"tk+s6ZhlemCvSnnDOkoFpCXJXu46n/Y4MJfuTEn7yd24hTHqrtgzw/+s2vv+K+5Bpb23vu7Vwbuq33dU",
+	"6pwWxYaURhHjaDEN7Kt0W8W2D40wt0euQeRWeu0LdVXGXUyzXlwXIr0dNueusufmxQfb6QG2gXvYq81D",
+	"l+bv7dRQa5xng2Xwg2LyLk9duE5VcbyeVlftHnyKIA5r59pQaMf7Xp0Iq8cRBLjqs1u5lpoinZps+NUZ",
+	"u7sQzjuIpxq2rzVeFdL9bamng6Mv/sD/X21hPBtaeG4+O2GXhms/46GNcouIoQsy7WvD92PWGFlI93ed",
+	"j1sIsUcAmHfgTHiVvbfvPkQXYrzO1x5/DqMeY9h7I9y5y0xiM4Jy5e5xDjGJq6m930KX7T/99I8hS+uD",
+	"efGByU6z6n8CU2prkfvBnQMXYf6cJQekEpuq02MsfTBvDMS2zf0xQYZarnz5V0/iMZO7XlVjpmI8gx2d",
+	"NAc8nB8b/cIKHvxlbLCfQ17CZlPA+pyGnrKOcqsZDH7e28wMSCMghAeHacXy1/jZCqFPIN2toCtFKMdk",
+	"GvdzLYG0KTFsNg2h1du09q4VGSO9mAbH53RjGmgPxVvNW4M9T5Rjk35p+QAjpgPUehTd1Q90cGIaGhzl",
+	"xTwq5O+zGxNhdL/9mJ3E5ewjdUIiO5Tc7PFtmQ/2jlyaYbqilpAD32u5/R1fGGO44VDebnP9OKI2FDy7",
+	"HxfNwPYOaLEYcPYaLA6gx7BXYOyzmisGnG3wwe9HyeiqeovAv8YaFIiDc9oTCI8hcwJfGpu5BYLaZG9V",
+	"PWFARngARQyR3y3x9/L+AzRDeqnwKPqhiaqWDQKgHszSei3sFTP4thZed9gGPoUSPvUKcGt+FnNscYCu",
+	"ms4Eq2Oi8j7bNabG6z6bNV3E6qya309GtD22CC5ib1MER4laIvA+k3dx6+KVSOHaYLiyWazw5m7z7iSZ",
+	"lLKYPJ0stF49vbiAi5uLhVD66b8/efJk8unnT/9vAGRXqF5emAEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
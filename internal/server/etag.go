@@ -0,0 +1,87 @@
+package server
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/StEvseeva/cleany/internal/service"
+	"github.com/StEvseeva/cleany/internal/storage"
+	"github.com/labstack/echo/v4"
+)
+
+// mergePatchMIME is the media type of JSON merge patch documents (RFC 7386)
+const mergePatchMIME = "application/merge-patch+json"
+
+// errUnsupportedPatchType is returned for PATCH bodies of other media types
+var errUnsupportedPatchType = fmt.Errorf("content type must be %s", mergePatchMIME)
+
+// setETag sets the ETag header from a resource version
+func setETag(ctx echo.Context, version *int) {
+	if version == nil {
+		return
+	}
+	ctx.Response().Header().Set("ETag", strconv.Quote(strconv.Itoa(*version)))
+}
+
+// parseIfMatch returns the version required by an If-Match header, nil if
+// the header is missing or "*"
+func parseIfMatch(header *string) (*int, error) {
+	if header == nil {
+		return nil, nil
+	}
+	value := strings.TrimSpace(*header)
+	if value == "" || value == "*" {
+		return nil, nil
+	}
+	if strings.Contains(value, ",") {
+		return nil, fmt.Errorf("If-Match with several entity tags is not supported")
+	}
+
+	tag := strings.TrimPrefix(value, "W/")
+	unquoted, err := strconv.Unquote(tag)
+	if err != nil {
+		unquoted = tag
+	}
+	version, err := strconv.Atoi(unquoted)
+	if err != nil {
+		return nil, fmt.Errorf("invalid If-Match header %q", value)
+	}
+	return &version, nil
+}
+
+// readMergePatch reads a JSON merge patch from the request body
+func readMergePatch(ctx echo.Context) ([]byte, error) {
+	if contentType := ctx.Request().Header.Get(echo.HeaderContentType); contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || (mediaType != mergePatchMIME && mediaType != echo.MIMEApplicationJSON) {
+			return nil, errUnsupportedPatchType
+		}
+	}
+	return io.ReadAll(ctx.Request().Body)
+}
+
+// errorStatus returns the status code of a failed change
+func errorStatus(err error) int {
+	if errors.Is(err, service.ErrPreconditionFailed) {
+		return http.StatusPreconditionFailed
+	}
+	if errors.Is(err, service.ErrConflict) {
+		return http.StatusConflict
+	}
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, storage.ErrNotFound) {
+		return http.StatusNotFound
+	}
+	if errors.Is(err, errUnsupportedPatchType) || errors.Is(err, service.ErrUnsupportedType) {
+		return http.StatusUnsupportedMediaType
+	}
+	if errors.Is(err, service.ErrTooLarge) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusBadRequest
+}
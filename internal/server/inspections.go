@@ -0,0 +1,84 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// GetCleaningOrdersIdInspections returns the inspections of a cleaning order
+func (s *Server) GetCleaningOrdersIdInspections(ctx echo.Context, id int) error {
+	inspections, err := s.service.GetInspectionsByOrder(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, inspections)
+}
+
+// PostCleaningOrdersIdInspections records the inspection of a done cleaning order
+func (s *Server) PostCleaningOrdersIdInspections(ctx echo.Context, id int) error {
+	var req models.InspectionCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	inspection, err := s.service.CreateInspection(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	return ctx.JSON(http.StatusCreated, inspection)
+}
+
+// GetInspectionsId returns an inspection by ID
+func (s *Server) GetInspectionsId(ctx echo.Context, id int) error {
+	inspection, err := s.service.GetInspection(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, inspection)
+}
+
+// GetInspectionItems returns the inspection checklist
+func (s *Server) GetInspectionItems(ctx echo.Context) error {
+	items, err := s.service.GetInspectionItems(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, items)
+}
+
+// PostInspectionItems adds an item to the inspection checklist
+func (s *Server) PostInspectionItems(ctx echo.Context) error {
+	var req models.InspectionItemCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	item, err := s.service.CreateInspectionItem(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	return ctx.JSON(http.StatusCreated, item)
+}
+
+// DeleteInspectionItemsId removes an item from the inspection checklist
+func (s *Server) DeleteInspectionItemsId(ctx echo.Context, id int) error {
+	err := s.service.DeleteInspectionItem(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetReportsCleanerQuality returns inspection results per cleaner
+func (s *Server) GetReportsCleanerQuality(ctx echo.Context, params models.GetReportsCleanerQualityParams) error {
+	report, err := s.service.GetCleanerQualityReport(ctx.Request().Context(), params.From, params.To)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, report)
+}
@@ -0,0 +1,184 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
+	"github.com/labstack/echo/v4"
+)
+
+// GetInventoryItems returns inventory items
+func (s *Server) GetInventoryItems(ctx echo.Context, params models.GetInventoryItemsParams) error {
+	includeInactive := params.IncludeInactive != nil && *params.IncludeInactive
+
+	items, err := s.service.GetAllInventoryItems(ctx.Request().Context(), includeInactive)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, items)
+}
+
+// PostInventoryItems adds an inventory item
+func (s *Server) PostInventoryItems(ctx echo.Context) error {
+	var req models.InventoryItemCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	item, err := s.service.CreateInventoryItem(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, item.Version)
+	return ctx.JSON(http.StatusCreated, item)
+}
+
+// GetInventoryItemsId returns an inventory item by ID
+func (s *Server) GetInventoryItemsId(ctx echo.Context, id int) error {
+	item, err := s.service.GetInventoryItem(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, item.Version)
+	return ctx.JSON(http.StatusOK, item)
+}
+
+// PatchInventoryItemsId partially updates an inventory item using a JSON merge patch
+func (s *Server) PatchInventoryItemsId(ctx echo.Context, id int, params models.PatchInventoryItemsIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	patch, err := readMergePatch(ctx)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	item, err := s.service.PatchInventoryItem(ctx.Request().Context(), id, patch, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, item.Version)
+	return ctx.JSON(http.StatusOK, item)
+}
+
+// GetInventoryStock returns stock levels per item and location
+func (s *Server) GetInventoryStock(ctx echo.Context, params models.GetInventoryStockParams) error {
+	levels, err := s.service.GetStock(ctx.Request().Context(), params.ItemId, params.Location)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, levels)
+}
+
+// GetInventoryLowStock returns the items low on stock
+func (s *Server) GetInventoryLowStock(ctx echo.Context) error {
+	items, err := s.service.GetLowStock(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, items)
+}
+
+// GetInventoryMovements returns stock movements
+func (s *Server) GetInventoryMovements(ctx echo.Context, params models.GetInventoryMovementsParams) error {
+	filter := repository.StockMovementFilter{
+		ItemID:   params.ItemId,
+		Location: params.Location,
+		From:     params.From,
+		To:       params.To,
+	}
+
+	movements, err := s.service.GetStockMovements(ctx.Request().Context(), filter)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, movements)
+}
+
+// PostInventoryMovements records a stock movement
+func (s *Server) PostInventoryMovements(ctx echo.Context) error {
+	var req models.StockMovementCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	movement, err := s.service.CreateStockMovement(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	return ctx.JSON(http.StatusCreated, movement)
+}
+
+// GetInventoryDefaults returns the default consumption of cleaning types
+func (s *Server) GetInventoryDefaults(ctx echo.Context, params models.GetInventoryDefaultsParams) error {
+	defs, err := s.service.GetConsumptionDefaults(ctx.Request().Context(), params.CleaningType)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, defs)
+}
+
+// PostInventoryDefaults sets how much of an item a cleaning type consumes
+func (s *Server) PostInventoryDefaults(ctx echo.Context) error {
+	var req models.ConsumptionDefaultCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	def, err := s.service.SetConsumptionDefault(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	return ctx.JSON(http.StatusCreated, def)
+}
+
+// DeleteInventoryDefaultsId removes an item from the default consumption of a cleaning type
+func (s *Server) DeleteInventoryDefaultsId(ctx echo.Context, id int) error {
+	err := s.service.DeleteConsumptionDefault(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetCleaningOrdersIdConsumption returns the consumption of a cleaning order
+func (s *Server) GetCleaningOrdersIdConsumption(ctx echo.Context, id int) error {
+	movements, err := s.service.GetOrderConsumption(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, movements)
+}
+
+// PostCleaningOrdersIdConsumption records what a cleaning order consumed
+func (s *Server) PostCleaningOrdersIdConsumption(ctx echo.Context, id int) error {
+	var req []models.ConsumptionRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	movements, err := s.service.RecordOrderConsumption(ctx.Request().Context(), id, req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	return ctx.JSON(http.StatusCreated, movements)
+}
+
+// GetReportsConsumption returns the consumption per item
+func (s *Server) GetReportsConsumption(ctx echo.Context, params models.GetReportsConsumptionParams) error {
+	report, err := s.service.GetConsumptionReport(ctx.Request().Context(), params.From, params.To, params.CleaningType)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, report)
+}
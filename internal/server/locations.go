@@ -0,0 +1,229 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// GetBuildings returns all buildings
+func (s *Server) GetBuildings(ctx echo.Context) error {
+	buildings, err := s.service.GetAllBuildings(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, buildings)
+}
+
+// PostBuildings adds a building
+func (s *Server) PostBuildings(ctx echo.Context) error {
+	var req models.BuildingCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	building, err := s.service.CreateBuilding(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, building.Version)
+	return ctx.JSON(http.StatusCreated, building)
+}
+
+// GetBuildingsId returns a building by ID
+func (s *Server) GetBuildingsId(ctx echo.Context, id int) error {
+	building, err := s.service.GetBuilding(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, building.Version)
+	return ctx.JSON(http.StatusOK, building)
+}
+
+// PatchBuildingsId partially updates a building using a JSON merge patch
+func (s *Server) PatchBuildingsId(ctx echo.Context, id int, params models.PatchBuildingsIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	patch, err := readMergePatch(ctx)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	building, err := s.service.PatchBuilding(ctx.Request().Context(), id, patch, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, building.Version)
+	return ctx.JSON(http.StatusOK, building)
+}
+
+// DeleteBuildingsId deletes a building by ID
+func (s *Server) DeleteBuildingsId(ctx echo.Context, id int) error {
+	if err := s.service.DeleteBuilding(ctx.Request().Context(), id); err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetFloors returns all floors
+func (s *Server) GetFloors(ctx echo.Context, params models.GetFloorsParams) error {
+	floors, err := s.service.GetAllFloors(ctx.Request().Context(), params.BuildingId)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, floors)
+}
+
+// PostFloors adds a floor
+func (s *Server) PostFloors(ctx echo.Context) error {
+	var req models.FloorCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	floor, err := s.service.CreateFloor(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, floor.Version)
+	return ctx.JSON(http.StatusCreated, floor)
+}
+
+// GetFloorsId returns a floor by ID
+func (s *Server) GetFloorsId(ctx echo.Context, id int) error {
+	floor, err := s.service.GetFloor(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, floor.Version)
+	return ctx.JSON(http.StatusOK, floor)
+}
+
+// PatchFloorsId partially updates a floor using a JSON merge patch
+func (s *Server) PatchFloorsId(ctx echo.Context, id int, params models.PatchFloorsIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	patch, err := readMergePatch(ctx)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	floor, err := s.service.PatchFloor(ctx.Request().Context(), id, patch, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, floor.Version)
+	return ctx.JSON(http.StatusOK, floor)
+}
+
+// DeleteFloorsId deletes a floor by ID
+func (s *Server) DeleteFloorsId(ctx echo.Context, id int) error {
+	if err := s.service.DeleteFloor(ctx.Request().Context(), id); err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetZones returns all zones
+func (s *Server) GetZones(ctx echo.Context, params models.GetZonesParams) error {
+	zones, err := s.service.GetAllZones(ctx.Request().Context(), params.FloorId)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, zones)
+}
+
+// PostZones adds a zone
+func (s *Server) PostZones(ctx echo.Context) error {
+	var req models.ZoneCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	zone, err := s.service.CreateZone(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, zone.Version)
+	return ctx.JSON(http.StatusCreated, zone)
+}
+
+// GetZonesId returns a zone by ID
+func (s *Server) GetZonesId(ctx echo.Context, id int) error {
+	zone, err := s.service.GetZone(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, zone.Version)
+	return ctx.JSON(http.StatusOK, zone)
+}
+
+// PatchZonesId partially updates a zone using a JSON merge patch
+func (s *Server) PatchZonesId(ctx echo.Context, id int, params models.PatchZonesIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	patch, err := readMergePatch(ctx)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	zone, err := s.service.PatchZone(ctx.Request().Context(), id, patch, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, zone.Version)
+	return ctx.JSON(http.StatusOK, zone)
+}
+
+// DeleteZonesId deletes a zone by ID
+func (s *Server) DeleteZonesId(ctx echo.Context, id int) error {
+	if err := s.service.DeleteZone(ctx.Request().Context(), id); err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
+
+// GetReportsBoard returns the cleaning orders of a day rolled up by floor or zone
+func (s *Server) GetReportsBoard(ctx echo.Context, params models.GetReportsBoardParams) error {
+	byZone := true
+	if params.GroupBy != nil {
+		switch *params.GroupBy {
+		case models.GetReportsBoardParamsGroupByZone:
+		case models.GetReportsBoardParamsGroupByFloor:
+			byZone = false
+		default:
+			return ctx.JSON(http.StatusBadRequest, map[string]string{"error": "group_by must be floor or zone"})
+		}
+	}
+
+	var day *time.Time
+	if params.Date != nil {
+		day = &params.Date.Time
+	}
+
+	board, err := s.service.GetBoard(ctx.Request().Context(), day, byZone)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, board)
+}
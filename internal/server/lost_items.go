@@ -0,0 +1,80 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
+	"github.com/labstack/echo/v4"
+)
+
+// GetLostItems returns lost and found items
+func (s *Server) GetLostItems(ctx echo.Context, params models.GetLostItemsParams) error {
+	filter := repository.LostItemFilter{
+		RoomID:    params.RoomId,
+		BookingID: params.BookingId,
+	}
+	if params.Status != nil {
+		value := string(*params.Status)
+		filter.Status = &value
+	}
+	if params.Due != nil && *params.Due {
+		now := time.Now()
+		filter.DueBefore = &now
+	}
+
+	items, err := s.service.GetAllLostItems(ctx.Request().Context(), filter)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, items)
+}
+
+// PostLostItems registers a found item
+func (s *Server) PostLostItems(ctx echo.Context) error {
+	var req models.LostItemCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	item, err := s.service.CreateLostItem(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, item.Version)
+	return ctx.JSON(http.StatusCreated, item)
+}
+
+// GetLostItemsId returns a lost and found item by ID
+func (s *Server) GetLostItemsId(ctx echo.Context, id int) error {
+	item, err := s.service.GetLostItem(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, item.Version)
+	return ctx.JSON(http.StatusOK, item)
+}
+
+// PatchLostItemsId partially updates a lost and found item using a JSON merge patch
+func (s *Server) PatchLostItemsId(ctx echo.Context, id int, params models.PatchLostItemsIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	patch, err := readMergePatch(ctx)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	item, err := s.service.PatchLostItem(ctx.Request().Context(), id, patch, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, item.Version)
+	return ctx.JSON(http.StatusOK, item)
+}
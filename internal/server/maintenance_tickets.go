@@ -0,0 +1,71 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// GetMaintenanceTickets returns maintenance tickets
+func (s *Server) GetMaintenanceTickets(ctx echo.Context, params models.GetMaintenanceTicketsParams) error {
+	var status *string
+	if params.Status != nil {
+		value := string(*params.Status)
+		status = &value
+	}
+
+	tickets, err := s.service.GetAllMaintenanceTickets(ctx.Request().Context(), status, params.RoomId)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, tickets)
+}
+
+// PostMaintenanceTickets reports a maintenance issue
+func (s *Server) PostMaintenanceTickets(ctx echo.Context) error {
+	var req models.MaintenanceTicketCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	ticket, err := s.service.CreateMaintenanceTicket(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, ticket.Version)
+	return ctx.JSON(http.StatusCreated, ticket)
+}
+
+// GetMaintenanceTicketsId returns a maintenance ticket by ID
+func (s *Server) GetMaintenanceTicketsId(ctx echo.Context, id int) error {
+	ticket, err := s.service.GetMaintenanceTicket(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, ticket.Version)
+	return ctx.JSON(http.StatusOK, ticket)
+}
+
+// PatchMaintenanceTicketsId partially updates a maintenance ticket using a JSON merge patch
+func (s *Server) PatchMaintenanceTicketsId(ctx echo.Context, id int, params models.PatchMaintenanceTicketsIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	patch, err := readMergePatch(ctx)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	ticket, err := s.service.PatchMaintenanceTicket(ctx.Request().Context(), id, patch, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, ticket.Version)
+	return ctx.JSON(http.StatusOK, ticket)
+}
@@ -0,0 +1,226 @@
+package server
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
+	"github.com/labstack/echo/v4"
+)
+
+// propertyHeader selects the property a request works on
+const propertyHeader = "X-Property-Id"
+
+// userKey is the echo context key of the authenticated user
+const userKey = "user"
+
+// Access holds the settings of property selection and authorization
+type Access struct {
+	// DefaultProperty is used by requests without a property header
+	DefaultProperty int
+	// UserHeader is the header an authenticating proxy sets to the name of
+	// the user, authorization is disabled when empty
+	UserHeader string
+	// Admins may access every property and manage properties
+	Admins []string
+}
+
+// PropertyMiddleware authenticates the user of a request and scopes the
+// request context to the selected property, rejecting users not authorized
+// for it. The /properties endpoints and the given paths are not scoped.
+func (s *Server) PropertyMiddleware(unscoped ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if s.access.UserHeader != "" {
+				user := strings.TrimSpace(ctx.Request().Header.Get(s.access.UserHeader))
+				if user == "" {
+					return ctx.JSON(http.StatusUnauthorized, map[string]string{"error": "missing user"})
+				}
+				ctx.Set(userKey, user)
+			}
+
+			path := ctx.Path()
+			if path == "/properties" || strings.HasPrefix(path, "/properties/") || slices.Contains(unscoped, path) {
+				return next(ctx)
+			}
+
+			propertyID := s.access.DefaultProperty
+			if value := ctx.Request().Header.Get(propertyHeader); value != "" {
+				id, err := strconv.Atoi(value)
+				if err != nil {
+					return ctx.JSON(http.StatusBadRequest, map[string]string{"error": "invalid " + propertyHeader + " header"})
+				}
+				propertyID = id
+			}
+
+			allowed, err := s.canAccess(ctx, propertyID)
+			if err != nil {
+				return ctx.JSON(accessStatus(err), map[string]string{"error": err.Error()})
+			}
+			if !allowed {
+				return ctx.JSON(http.StatusForbidden, map[string]string{"error": "not authorized for this property"})
+			}
+
+			req := ctx.Request()
+			ctx.SetRequest(req.WithContext(repository.WithProperty(req.Context(), propertyID)))
+			return next(ctx)
+		}
+	}
+}
+
+// isAdmin reports whether the user of a request may manage properties,
+// everyone may when authorization is disabled
+func (s *Server) isAdmin(ctx echo.Context) bool {
+	if s.access.UserHeader == "" {
+		return true
+	}
+	user, _ := ctx.Get(userKey).(string)
+	return slices.Contains(s.access.Admins, user)
+}
+
+// canAccess reports whether the user of a request may access a property,
+// failing if the property does not exist
+func (s *Server) canAccess(ctx echo.Context, propertyID int) (bool, error) {
+	if _, err := s.service.GetProperty(ctx.Request().Context(), propertyID); err != nil {
+		return false, err
+	}
+	if s.isAdmin(ctx) {
+		return true, nil
+	}
+	user, _ := ctx.Get(userKey).(string)
+	return s.service.IsPropertyUser(ctx.Request().Context(), propertyID, user)
+}
+
+// accessStatus returns the status of a failed canAccess, 404 if the property
+// does not exist and 500 if it could not be checked
+func accessStatus(err error) int {
+	if status := errorStatus(err); status == http.StatusNotFound {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// forbidden responds that the user may not do what they requested
+func forbidden(ctx echo.Context) error {
+	return ctx.JSON(http.StatusForbidden, map[string]string{"error": "administrator required"})
+}
+
+// GetProperties returns the properties the user may access
+func (s *Server) GetProperties(ctx echo.Context) error {
+	var user *string
+	if !s.isAdmin(ctx) {
+		name, _ := ctx.Get(userKey).(string)
+		user = &name
+	}
+
+	properties, err := s.service.GetAllProperties(ctx.Request().Context(), user)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, properties)
+}
+
+// PostProperties adds a property
+func (s *Server) PostProperties(ctx echo.Context) error {
+	if !s.isAdmin(ctx) {
+		return forbidden(ctx)
+	}
+
+	var req models.PropertyCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	property, err := s.service.CreateProperty(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, property.Version)
+	return ctx.JSON(http.StatusCreated, property)
+}
+
+// GetPropertiesId returns a property by ID
+func (s *Server) GetPropertiesId(ctx echo.Context, id int) error {
+	allowed, err := s.canAccess(ctx, id)
+	if err != nil {
+		return ctx.JSON(accessStatus(err), map[string]string{"error": err.Error()})
+	}
+	if !allowed {
+		return ctx.JSON(http.StatusForbidden, map[string]string{"error": "not authorized for this property"})
+	}
+
+	property, err := s.service.GetProperty(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, property.Version)
+	return ctx.JSON(http.StatusOK, property)
+}
+
+// PatchPropertiesId partially updates a property using a JSON merge patch
+func (s *Server) PatchPropertiesId(ctx echo.Context, id int, params models.PatchPropertiesIdParams) error {
+	if !s.isAdmin(ctx) {
+		return forbidden(ctx)
+	}
+
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	patch, err := readMergePatch(ctx)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	property, err := s.service.PatchProperty(ctx.Request().Context(), id, patch, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, property.Version)
+	return ctx.JSON(http.StatusOK, property)
+}
+
+// GetPropertiesIdUsers returns the users authorized for a property
+func (s *Server) GetPropertiesIdUsers(ctx echo.Context, id int) error {
+	if !s.isAdmin(ctx) {
+		return forbidden(ctx)
+	}
+
+	users, err := s.service.GetPropertyUsers(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, users)
+}
+
+// PutPropertiesIdUsersUser authorizes a user for a property
+func (s *Server) PutPropertiesIdUsersUser(ctx echo.Context, id int, user string) error {
+	if !s.isAdmin(ctx) {
+		return forbidden(ctx)
+	}
+
+	propertyUser, err := s.service.AuthorizePropertyUser(ctx.Request().Context(), id, user)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, propertyUser)
+}
+
+// DeletePropertiesIdUsersUser revokes the authorization of a user for a property
+func (s *Server) DeletePropertiesIdUsersUser(ctx echo.Context, id int, user string) error {
+	if !s.isAdmin(ctx) {
+		return forbidden(ctx)
+	}
+
+	if err := s.service.RevokePropertyUser(ctx.Request().Context(), id, user); err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// GetRecurrenceRules returns the recurrence rules
+func (s *Server) GetRecurrenceRules(ctx echo.Context) error {
+	rules, err := s.service.GetAllRecurrenceRules(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, rules)
+}
+
+// PostRecurrenceRules adds a recurring cleaning of a room or zone
+func (s *Server) PostRecurrenceRules(ctx echo.Context) error {
+	var req models.RecurrenceRuleCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	rule, err := s.service.CreateRecurrenceRule(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, rule.Version)
+	return ctx.JSON(http.StatusCreated, rule)
+}
+
+// GetRecurrenceRulesId returns a recurrence rule by ID
+func (s *Server) GetRecurrenceRulesId(ctx echo.Context, id int) error {
+	rule, err := s.service.GetRecurrenceRule(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, rule.Version)
+	return ctx.JSON(http.StatusOK, rule)
+}
+
+// PatchRecurrenceRulesId partially updates a recurrence rule using a JSON
+// merge patch
+func (s *Server) PatchRecurrenceRulesId(ctx echo.Context, id int, params models.PatchRecurrenceRulesIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	patch, err := readMergePatch(ctx)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	rule, err := s.service.PatchRecurrenceRule(ctx.Request().Context(), id, patch, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, rule.Version)
+	return ctx.JSON(http.StatusOK, rule)
+}
+
+// DeleteRecurrenceRulesId deletes a recurrence rule by ID
+func (s *Server) DeleteRecurrenceRulesId(ctx echo.Context, id int) error {
+	if err := s.service.DeleteRecurrenceRule(ctx.Request().Context(), id); err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,142 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// GetReportsForecast returns the projected cleaning workload per day
+// against the scheduled shifts
+func (s *Server) GetReportsForecast(ctx echo.Context, params models.GetReportsForecastParams) error {
+	from, to := reportDates(params.From, params.To)
+
+	days, err := s.service.GetForecast(ctx.Request().Context(), from, to)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, days)
+}
+
+// GetReportsOccupancy returns the occupancy rate of the rooms
+func (s *Server) GetReportsOccupancy(ctx echo.Context, params models.GetReportsOccupancyParams) error {
+	var groupBy string
+	if params.GroupBy != nil {
+		switch *params.GroupBy {
+		case models.GetReportsOccupancyParamsGroupByDay, models.GetReportsOccupancyParamsGroupByWeek,
+			models.GetReportsOccupancyParamsGroupByMonth, models.GetReportsOccupancyParamsGroupByRoomType:
+			groupBy = string(*params.GroupBy)
+		default:
+			return ctx.JSON(http.StatusBadRequest, map[string]string{"error": "group_by must be day, week, month or room_type"})
+		}
+	}
+	from, to := reportDates(params.From, params.To)
+
+	report, err := s.service.GetOccupancy(ctx.Request().Context(), from, to, groupBy)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, report)
+}
+
+// GetReportsCleaningTime returns the average time the cleanings took
+func (s *Server) GetReportsCleaningTime(ctx echo.Context, params models.GetReportsCleaningTimeParams) error {
+	var groupBy string
+	if params.GroupBy != nil {
+		switch *params.GroupBy {
+		case models.GetReportsCleaningTimeParamsGroupByDay, models.GetReportsCleaningTimeParamsGroupByWeek,
+			models.GetReportsCleaningTimeParamsGroupByMonth, models.GetReportsCleaningTimeParamsGroupByRoomType,
+			models.GetReportsCleaningTimeParamsGroupByCleaningType:
+			groupBy = string(*params.GroupBy)
+		default:
+			return ctx.JSON(http.StatusBadRequest, map[string]string{"error": "group_by must be day, week, month, room_type or cleaning_type"})
+		}
+	}
+	from, to := reportDates(params.From, params.To)
+
+	report, err := s.service.GetCleaningTime(ctx.Request().Context(), from, to, groupBy)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, report)
+}
+
+// GetReportsCleanerWorkload returns the cleaning orders per cleaner and day
+func (s *Server) GetReportsCleanerWorkload(ctx echo.Context, params models.GetReportsCleanerWorkloadParams) error {
+	var groupBy string
+	if params.GroupBy != nil {
+		switch *params.GroupBy {
+		case models.GetReportsCleanerWorkloadParamsGroupByDay, models.GetReportsCleanerWorkloadParamsGroupByWeek,
+			models.GetReportsCleanerWorkloadParamsGroupByMonth, models.GetReportsCleanerWorkloadParamsGroupByCleaner:
+			groupBy = string(*params.GroupBy)
+		default:
+			return ctx.JSON(http.StatusBadRequest, map[string]string{"error": "group_by must be day, week, month or cleaner"})
+		}
+	}
+	from, to := reportDates(params.From, params.To)
+
+	report, err := s.service.GetCleanerWorkload(ctx.Request().Context(), from, to, groupBy)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, report)
+}
+
+// GetReportsOnTime returns the share of the cleaning orders done in time
+func (s *Server) GetReportsOnTime(ctx echo.Context, params models.GetReportsOnTimeParams) error {
+	var groupBy string
+	if params.GroupBy != nil {
+		switch *params.GroupBy {
+		case models.GetReportsOnTimeParamsGroupByDay, models.GetReportsOnTimeParamsGroupByWeek,
+			models.GetReportsOnTimeParamsGroupByMonth, models.GetReportsOnTimeParamsGroupByRoomType,
+			models.GetReportsOnTimeParamsGroupByCleaningType, models.GetReportsOnTimeParamsGroupByCleaner:
+			groupBy = string(*params.GroupBy)
+		default:
+			return ctx.JSON(http.StatusBadRequest, map[string]string{"error": "group_by must be day, week, month, room_type, cleaning_type or cleaner"})
+		}
+	}
+	from, to := reportDates(params.From, params.To)
+
+	report, err := s.service.GetOnTime(ctx.Request().Context(), from, to, groupBy)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, report)
+}
+
+// GetReportsCostPerNight returns the housekeeping cost per occupied room
+// night
+func (s *Server) GetReportsCostPerNight(ctx echo.Context, params models.GetReportsCostPerNightParams) error {
+	var groupBy string
+	if params.GroupBy != nil {
+		switch *params.GroupBy {
+		case models.GetReportsCostPerNightParamsGroupByDay, models.GetReportsCostPerNightParamsGroupByWeek,
+			models.GetReportsCostPerNightParamsGroupByMonth, models.GetReportsCostPerNightParamsGroupByRoomType:
+			groupBy = string(*params.GroupBy)
+		default:
+			return ctx.JSON(http.StatusBadRequest, map[string]string{"error": "group_by must be day, week, month or room_type"})
+		}
+	}
+	from, to := reportDates(params.From, params.To)
+
+	report, err := s.service.GetCostPerNight(ctx.Request().Context(), from, to, groupBy)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, report)
+}
+
+// reportDates returns the first and last day of a report period
+func reportDates(from, to *openapi_types.Date) (*time.Time, *time.Time) {
+	var first, last *time.Time
+	if from != nil {
+		first = &from.Time
+	}
+	if to != nil {
+		last = &to.Time
+	}
+	return first, last
+}
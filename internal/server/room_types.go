@@ -0,0 +1,73 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/labstack/echo/v4"
+)
+
+// GetRoomTypes returns all room types
+func (s *Server) GetRoomTypes(ctx echo.Context) error {
+	roomTypes, err := s.service.GetAllRoomTypes(ctx.Request().Context())
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, roomTypes)
+}
+
+// PostRoomTypes adds a room type
+func (s *Server) PostRoomTypes(ctx echo.Context) error {
+	var req models.RoomTypeCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	roomType, err := s.service.CreateRoomType(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, roomType.Version)
+	return ctx.JSON(http.StatusCreated, roomType)
+}
+
+// GetRoomTypesId returns a room type by ID
+func (s *Server) GetRoomTypesId(ctx echo.Context, id int) error {
+	roomType, err := s.service.GetRoomType(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, roomType.Version)
+	return ctx.JSON(http.StatusOK, roomType)
+}
+
+// PatchRoomTypesId partially updates a room type using a JSON merge patch
+func (s *Server) PatchRoomTypesId(ctx echo.Context, id int, params models.PatchRoomTypesIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	patch, err := readMergePatch(ctx)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	roomType, err := s.service.PatchRoomType(ctx.Request().Context(), id, patch, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, roomType.Version)
+	return ctx.JSON(http.StatusOK, roomType)
+}
+
+// DeleteRoomTypesId deletes a room type by ID
+func (s *Server) DeleteRoomTypesId(ctx echo.Context, id int) error {
+	if err := s.service.DeleteRoomType(ctx.Request().Context(), id); err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
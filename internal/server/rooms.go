@@ -8,8 +8,8 @@ import (
 )
 
 // GetRooms returns all rooms
-func (s *Server) GetRooms(ctx echo.Context) error {
-	rooms, err := s.service.GetAllRooms(ctx.Request().Context())
+func (s *Server) GetRooms(ctx echo.Context, params models.GetRoomsParams) error {
+	rooms, err := s.service.GetAllRooms(ctx.Request().Context(), params.IncludeDeleted != nil && *params.IncludeDeleted)
 	if err != nil {
 		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
@@ -28,6 +28,7 @@ func (s *Server) PostRooms(ctx echo.Context) error {
 		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
+	setETag(ctx, room.Version)
 	return ctx.JSON(http.StatusCreated, room)
 }
 
@@ -42,26 +43,115 @@ func (s *Server) DeleteRoomsId(ctx echo.Context, id int) error {
 }
 
 // GetRoomsId returns a room by ID
-func (s *Server) GetRoomsId(ctx echo.Context, id int) error {
-	room, err := s.service.GetRoom(ctx.Request().Context(), id)
+func (s *Server) GetRoomsId(ctx echo.Context, id int, params models.GetRoomsIdParams) error {
+	room, err := s.service.GetRoom(ctx.Request().Context(), id, params.IncludeDeleted != nil && *params.IncludeDeleted)
 	if err != nil {
 		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
 	}
 
+	setETag(ctx, room.Version)
 	return ctx.JSON(http.StatusOK, room)
 }
 
 // PutRoomsId updates a room by ID
-func (s *Server) PutRoomsId(ctx echo.Context, id int) error {
+func (s *Server) PutRoomsId(ctx echo.Context, id int, params models.PutRoomsIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
 	var req models.RoomUpdateRequest
 	if err := ctx.Bind(&req); err != nil {
 		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
-	room, err := s.service.UpdateRoom(ctx.Request().Context(), id, &req)
+	room, err := s.service.UpdateRoom(ctx.Request().Context(), id, &req, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, room.Version)
+	return ctx.JSON(http.StatusOK, room)
+}
+
+// PatchRoomsId applies a JSON merge patch to a room by ID
+func (s *Server) PatchRoomsId(ctx echo.Context, id int, params models.PatchRoomsIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
 	if err != nil {
 		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
 	}
 
+	patch, err := readMergePatch(ctx)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	room, err := s.service.PatchRoom(ctx.Request().Context(), id, patch, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, room.Version)
 	return ctx.JSON(http.StatusOK, room)
 }
+
+// PostRoomsIdRestore restores a deleted room by ID
+func (s *Server) PostRoomsIdRestore(ctx echo.Context, id int) error {
+	room, err := s.service.RestoreRoom(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, room.Version)
+	return ctx.JSON(http.StatusOK, room)
+}
+
+// PutRoomsIdStatus sets the housekeeping status of a room
+func (s *Server) PutRoomsIdStatus(ctx echo.Context, id int) error {
+	var req models.RoomStatusUpdateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	room, err := s.service.SetRoomStatus(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, room.Version)
+	return ctx.JSON(http.StatusOK, room)
+}
+
+// GetRoomsIdBlocks returns the out-of-order and out-of-service periods of a room
+func (s *Server) GetRoomsIdBlocks(ctx echo.Context, id int) error {
+	blocks, err := s.service.GetRoomBlocks(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, blocks)
+}
+
+// PostRoomsIdBlocks puts a room out of order or out of service
+func (s *Server) PostRoomsIdBlocks(ctx echo.Context, id int) error {
+	var req models.RoomBlockCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	block, err := s.service.CreateRoomBlock(ctx.Request().Context(), id, &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	return ctx.JSON(http.StatusCreated, block)
+}
+
+// DeleteRoomsIdBlocksBlockId removes an out-of-order or out-of-service period
+func (s *Server) DeleteRoomsIdBlocksBlockId(ctx echo.Context, id int, blockId int) error {
+	err := s.service.DeleteRoomBlock(ctx.Request().Context(), id, blockId)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -6,10 +6,12 @@ import (
 
 type Server struct {
 	service service.Service
+	access  Access
 }
 
-func NewServer(service service.Service) *Server {
+func NewServer(service service.Service, access Access) *Server {
 	return &Server{
 		service: service,
+		access:  access,
 	}
 }
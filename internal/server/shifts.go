@@ -0,0 +1,81 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
+	"github.com/labstack/echo/v4"
+)
+
+// GetShifts returns the shifts, optionally only those of a cleaner or of a
+// period
+func (s *Server) GetShifts(ctx echo.Context, params models.GetShiftsParams) error {
+	filter := repository.ShiftFilter{
+		CleanerID: params.CleanerId,
+		From:      params.From,
+		To:        params.To,
+	}
+
+	shifts, err := s.service.GetAllShifts(ctx.Request().Context(), filter)
+	if err != nil {
+		return ctx.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return ctx.JSON(http.StatusOK, shifts)
+}
+
+// PostShifts schedules a shift
+func (s *Server) PostShifts(ctx echo.Context) error {
+	var req models.ShiftCreateRequest
+	if err := ctx.Bind(&req); err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	shift, err := s.service.CreateShift(ctx.Request().Context(), &req)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, shift.Version)
+	return ctx.JSON(http.StatusCreated, shift)
+}
+
+// GetShiftsId returns a shift by ID
+func (s *Server) GetShiftsId(ctx echo.Context, id int) error {
+	shift, err := s.service.GetShift(ctx.Request().Context(), id)
+	if err != nil {
+		return ctx.JSON(http.StatusNotFound, map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, shift.Version)
+	return ctx.JSON(http.StatusOK, shift)
+}
+
+// PatchShiftsId partially updates a shift using a JSON merge patch
+func (s *Server) PatchShiftsId(ctx echo.Context, id int, params models.PatchShiftsIdParams) error {
+	ifMatch, err := parseIfMatch(params.IfMatch)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	patch, err := readMergePatch(ctx)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	shift, err := s.service.PatchShift(ctx.Request().Context(), id, patch, ifMatch)
+	if err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+
+	setETag(ctx, shift.Version)
+	return ctx.JSON(http.StatusOK, shift)
+}
+
+// DeleteShiftsId deletes a shift by ID
+func (s *Server) DeleteShiftsId(ctx echo.Context, id int) error {
+	if err := s.service.DeleteShift(ctx.Request().Context(), id); err != nil {
+		return ctx.JSON(errorStatus(err), map[string]string{"error": err.Error()})
+	}
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/storage"
+)
+
+// AttachmentService defines the interface for cleaning order attachment business operations
+type AttachmentService interface {
+	UploadAttachment(ctx context.Context, orderID int, upload *AttachmentUpload) (*models.Attachment, error)
+	GetAttachments(ctx context.Context, orderID int) ([]models.Attachment, error)
+	OpenAttachment(ctx context.Context, orderID, id int) (*models.Attachment, io.ReadCloser, error)
+	OpenAttachmentThumbnail(ctx context.Context, orderID, id int) (io.ReadCloser, error)
+	DeleteAttachment(ctx context.Context, orderID, id int) error
+}
+
+// AttachmentUpload is a file uploaded to a cleaning order
+type AttachmentUpload struct {
+	Filename    string
+	Description *string
+	CleanerId   *int
+	Content     io.Reader
+}
+
+// AttachmentLimits holds the restrictions of uploaded files
+type AttachmentLimits struct {
+	// MaxSize is the largest accepted file in bytes
+	MaxSize int64
+	// AllowedTypes are the accepted media types, detected from the content
+	AllowedTypes []string
+	// ThumbnailSize is the longest side of image thumbnails in pixels, zero
+	// disables thumbnails
+	ThumbnailSize int
+}
+
+// attachmentKey returns the blob store key of the content of an attachment
+func attachmentKey(attachment *models.Attachment) string {
+	return fmt.Sprintf("orders/%d/attachments/%d", attachment.OrderId, attachment.Id)
+}
+
+// thumbnailKey returns the blob store key of the thumbnail of an attachment
+func thumbnailKey(attachment *models.Attachment) string {
+	return fmt.Sprintf("orders/%d/thumbnails/%d.jpg", attachment.OrderId, attachment.Id)
+}
+
+// UploadAttachment stores a file attached to a cleaning order together with
+// a thumbnail if the file is an image
+func (s *attachmentService) UploadAttachment(ctx context.Context, orderID int, upload *AttachmentUpload) (*models.Attachment, error) {
+	ctx, span := tracer.Start(ctx, "AttachmentService.UploadAttachment")
+	defer span.End()
+
+	if _, err := s.cleaningOrderRepo.GetByID(ctx, orderID); err != nil {
+		return nil, fmt.Errorf("cleaning order not found: %w", err)
+	}
+	if upload.CleanerId != nil {
+		if _, err := s.cleanerRepo.GetByID(ctx, *upload.CleanerId); err != nil {
+			return nil, fmt.Errorf("cleaner not found: %w", err)
+		}
+	}
+
+	content, err := io.ReadAll(io.LimitReader(upload.Content, s.limits.MaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	if int64(len(content)) > s.limits.MaxSize {
+		return nil, fmt.Errorf("%w: file is larger than %d bytes", ErrTooLarge, s.limits.MaxSize)
+	}
+	if len(content) == 0 {
+		return nil, fmt.Errorf("file is empty")
+	}
+
+	contentType, _, _ := mime.ParseMediaType(http.DetectContentType(content))
+	if !slices.Contains(s.limits.AllowedTypes, contentType) {
+		return nil, fmt.Errorf("%w: %s files are not allowed", ErrUnsupportedType, contentType)
+	}
+
+	var thumbnail []byte
+	if s.limits.ThumbnailSize > 0 && thumbnailTypes[contentType] {
+		thumbnail, err = makeThumbnail(content, s.limits.ThumbnailSize)
+		if err != nil {
+			// The file is kept without a thumbnail
+			slog.WarnContext(ctx, "failed to make thumbnail", "order_id", orderID, "error", err)
+		}
+	}
+
+	attachment := &models.Attachment{
+		OrderId:      orderID,
+		Filename:     attachmentFilename(upload.Filename),
+		ContentType:  contentType,
+		Size:         int64(len(content)),
+		Description:  upload.Description,
+		UploadedBy:   upload.CleanerId,
+		HasThumbnail: thumbnail != nil,
+	}
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.attachmentRepo.Create(ctx, attachment); err != nil {
+			return fmt.Errorf("failed to create attachment: %w", err)
+		}
+		err := s.store.Put(ctx, attachmentKey(attachment), bytes.NewReader(content), int64(len(content)), contentType)
+		if err != nil {
+			return fmt.Errorf("failed to store file: %w", err)
+		}
+		if thumbnail != nil {
+			err := s.store.Put(ctx, thumbnailKey(attachment), bytes.NewReader(thumbnail), int64(len(thumbnail)), "image/jpeg")
+			if err != nil {
+				return fmt.Errorf("failed to store thumbnail: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if attachment.Id != 0 {
+			deleteAttachmentBlobs(ctx, s.store, attachment)
+		}
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "attachment uploaded",
+		"attachment_id", attachment.Id,
+		"order_id", orderID,
+		"content_type", contentType,
+		"size", attachment.Size,
+	)
+
+	return attachment, nil
+}
+
+// attachmentFilename keeps the base name of an uploaded file
+func attachmentFilename(name string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	if name == "." || name == "/" {
+		return "file"
+	}
+	if len(name) > 255 {
+		name = name[len(name)-255:]
+	}
+	return name
+}
+
+// GetAttachments retrieves the attachments of a cleaning order
+func (s *attachmentService) GetAttachments(ctx context.Context, orderID int) ([]models.Attachment, error) {
+	ctx, span := tracer.Start(ctx, "AttachmentService.GetAttachments")
+	defer span.End()
+
+	if _, err := s.cleaningOrderRepo.GetByID(ctx, orderID); err != nil {
+		return nil, fmt.Errorf("cleaning order not found: %w", err)
+	}
+
+	attachments, err := s.attachmentRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+
+	return attachments, nil
+}
+
+// OpenAttachment retrieves an attachment and opens its content, the caller
+// closes it
+func (s *attachmentService) OpenAttachment(ctx context.Context, orderID, id int) (*models.Attachment, io.ReadCloser, error) {
+	ctx, span := tracer.Start(ctx, "AttachmentService.OpenAttachment")
+	defer span.End()
+
+	attachment, err := s.attachmentRepo.GetByID(ctx, orderID, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("attachment not found: %w", err)
+	}
+
+	content, err := s.store.Get(ctx, attachmentKey(attachment))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open file: %w", err)
+	}
+
+	return attachment, content, nil
+}
+
+// OpenAttachmentThumbnail opens the JPEG thumbnail of an attached image, the
+// caller closes it
+func (s *attachmentService) OpenAttachmentThumbnail(ctx context.Context, orderID, id int) (io.ReadCloser, error) {
+	ctx, span := tracer.Start(ctx, "AttachmentService.OpenAttachmentThumbnail")
+	defer span.End()
+
+	attachment, err := s.attachmentRepo.GetByID(ctx, orderID, id)
+	if err != nil {
+		return nil, fmt.Errorf("attachment not found: %w", err)
+	}
+	if !attachment.HasThumbnail {
+		return nil, fmt.Errorf("attachment has no thumbnail: %w", storage.ErrNotFound)
+	}
+
+	content, err := s.store.Get(ctx, thumbnailKey(attachment))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open thumbnail: %w", err)
+	}
+
+	return content, nil
+}
+
+// DeleteAttachment removes an attachment and its files
+func (s *attachmentService) DeleteAttachment(ctx context.Context, orderID, id int) error {
+	ctx, span := tracer.Start(ctx, "AttachmentService.DeleteAttachment")
+	defer span.End()
+
+	attachment, err := s.attachmentRepo.GetByID(ctx, orderID, id)
+	if err != nil {
+		return fmt.Errorf("attachment not found: %w", err)
+	}
+
+	if err := s.attachmentRepo.Delete(ctx, orderID, id); err != nil {
+		return fmt.Errorf("attachment not found: %w", err)
+	}
+	deleteAttachmentBlobs(ctx, s.store, attachment)
+
+	slog.InfoContext(ctx, "attachment deleted", "attachment_id", id, "order_id", orderID)
+
+	return nil
+}
+
+// deleteAttachmentBlobs removes the files of an attachment, failures are
+// only logged since the attachment is gone either way
+func deleteAttachmentBlobs(ctx context.Context, store storage.BlobStore, attachment *models.Attachment) {
+	keys := []string{attachmentKey(attachment), thumbnailKey(attachment)}
+	for _, key := range keys {
+		if err := store.Delete(ctx, key); err != nil {
+			slog.WarnContext(ctx, "failed to delete file", "key", key, "error", err)
+		}
+	}
+}
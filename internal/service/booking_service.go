@@ -3,21 +3,35 @@ package service
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"strings"
+	"time"
 
 	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
 )
 
 // BookingService defines the interface for booking business operations
 type BookingService interface {
 	CreateBooking(ctx context.Context, req *models.BookingCreateRequest) (*models.Booking, error)
-	GetBooking(ctx context.Context, id int) (*models.Booking, error)
-	GetAllBookings(ctx context.Context) ([]models.Booking, error)
-	UpdateBooking(ctx context.Context, id int, req *models.BookingUpdateRequest) (*models.Booking, error)
+	GetBooking(ctx context.Context, id int, includeDeleted bool) (*models.Booking, error)
+	GetAllBookings(ctx context.Context, filter repository.BookingFilter) ([]models.Booking, error)
+	UpdateBooking(ctx context.Context, id int, req *models.BookingUpdateRequest, ifMatch *int) (*models.Booking, error)
+	PatchBooking(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Booking, error)
 	DeleteBooking(ctx context.Context, id int) error
+	RestoreBooking(ctx context.Context, id int) (*models.Booking, error)
+	CancelBooking(ctx context.Context, id int, req *models.BookingCancelRequest) (*models.BookingCancelResponse, error)
+	CheckInBooking(ctx context.Context, id int, req *models.BookingEventRequest) (*models.Booking, error)
+	CheckOutBooking(ctx context.Context, id int, req *models.BookingEventRequest) (*models.BookingCheckOutResponse, error)
+	SetServicePreferences(ctx context.Context, id int, prefs *models.ServicePreferences, ifMatch *int) (*models.Booking, error)
+	AnonymizeBooking(ctx context.Context, id int) (*models.Booking, error)
 }
 
 // CreateBooking creates a new booking with validation
 func (s *bookingService) CreateBooking(ctx context.Context, req *models.BookingCreateRequest) (*models.Booking, error) {
+	ctx, span := tracer.Start(ctx, "BookingService.CreateBooking")
+	defer span.End()
+
 	// Validate that the room exists
 	_, err := s.roomRepo.GetByID(ctx, req.RoomId)
 	if err != nil {
@@ -28,13 +42,28 @@ func (s *bookingService) CreateBooking(ctx context.Context, req *models.BookingC
 	if req.CheckInTs.After(req.CheckOutTs) {
 		return nil, fmt.Errorf("check-in date must be before check-out date")
 	}
+	if err := s.checkRoomAvailable(ctx, req.RoomId, req.CheckInTs, req.CheckOutTs); err != nil {
+		return nil, err
+	}
+	if err := s.checkRoomCapacity(ctx, req.RoomId, req.Guests); err != nil {
+		return nil, err
+	}
+	prefs := bookingPreferences(models.Booking{ServicePreferences: req.ServicePreferences})
+	if err := validateServicePreferences(&prefs); err != nil {
+		return nil, err
+	}
+	if err := validateGuest(req.Guest); err != nil {
+		return nil, err
+	}
 
 	// Create booking
 	booking := &models.Booking{
-		RoomId:     req.RoomId,
-		CheckInTs:  &req.CheckInTs,
-		CheckOutTs: &req.CheckOutTs,
-		Guests:     req.Guests,
+		RoomId:             req.RoomId,
+		CheckInTs:          &req.CheckInTs,
+		CheckOutTs:         &req.CheckOutTs,
+		Guests:             req.Guests,
+		ServicePreferences: &prefs,
+		Guest:              req.Guest,
 	}
 
 	err = s.bookingRepo.Create(ctx, booking)
@@ -42,6 +71,8 @@ func (s *bookingService) CreateBooking(ctx context.Context, req *models.BookingC
 		return nil, fmt.Errorf("failed to create booking: %w", err)
 	}
 
+	slog.InfoContext(ctx, "booking created", "booking_id", booking.Id, "room_id", booking.RoomId)
+
 	_, err = s.cleaningOrderService.CreateCleaningOrdersForBooking(ctx, *booking)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create cleaning orders for booking: %w", err)
@@ -50,9 +81,16 @@ func (s *bookingService) CreateBooking(ctx context.Context, req *models.BookingC
 	return booking, nil
 }
 
-// GetBooking retrieves a booking by ID
-func (s *bookingService) GetBooking(ctx context.Context, id int) (*models.Booking, error) {
-	booking, err := s.bookingRepo.GetByID(ctx, id)
+// GetBooking retrieves a booking by ID, a deleted one only if includeDeleted is set
+func (s *bookingService) GetBooking(ctx context.Context, id int, includeDeleted bool) (*models.Booking, error) {
+	ctx, span := tracer.Start(ctx, "BookingService.GetBooking")
+	defer span.End()
+
+	get := s.bookingRepo.GetByID
+	if includeDeleted {
+		get = s.bookingRepo.GetByIDWithDeleted
+	}
+	booking, err := get(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("booking not found: %w", err)
 	}
@@ -60,9 +98,12 @@ func (s *bookingService) GetBooking(ctx context.Context, id int) (*models.Bookin
 	return booking, nil
 }
 
-// GetAllBookings retrieves all bookings
-func (s *bookingService) GetAllBookings(ctx context.Context) ([]models.Booking, error) {
-	bookings, err := s.bookingRepo.GetAll(ctx)
+// GetAllBookings retrieves the bookings matching a filter
+func (s *bookingService) GetAllBookings(ctx context.Context, filter repository.BookingFilter) ([]models.Booking, error) {
+	ctx, span := tracer.Start(ctx, "BookingService.GetAllBookings")
+	defer span.End()
+
+	bookings, err := s.bookingRepo.GetAll(ctx, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get bookings: %w", err)
 	}
@@ -71,12 +112,21 @@ func (s *bookingService) GetAllBookings(ctx context.Context) ([]models.Booking,
 }
 
 // UpdateBooking updates an existing booking
-func (s *bookingService) UpdateBooking(ctx context.Context, id int, req *models.BookingUpdateRequest) (*models.Booking, error) {
+func (s *bookingService) UpdateBooking(ctx context.Context, id int, req *models.BookingUpdateRequest, ifMatch *int) (*models.Booking, error) {
+	ctx, span := tracer.Start(ctx, "BookingService.UpdateBooking")
+	defer span.End()
+
 	// Check if booking exists
 	existingBooking, err := s.bookingRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("booking not found: %w", err)
 	}
+	if err := checkVersion(ifMatch, existingBooking.Version); err != nil {
+		return nil, err
+	}
+	if existingBooking.CancelledAt != nil {
+		return nil, fmt.Errorf("%w: booking is cancelled", ErrConflict)
+	}
 
 	// Validate that the room exists
 	_, err = s.roomRepo.GetByID(ctx, req.RoomId)
@@ -88,23 +138,104 @@ func (s *bookingService) UpdateBooking(ctx context.Context, id int, req *models.
 	if req.CheckInTs.After(req.CheckOutTs) {
 		return nil, fmt.Errorf("check-in date must be before check-out date")
 	}
+	if err := s.checkRoomAvailable(ctx, req.RoomId, req.CheckInTs, req.CheckOutTs); err != nil {
+		return nil, err
+	}
+	if err := s.checkRoomCapacity(ctx, req.RoomId, req.Guests); err != nil {
+		return nil, err
+	}
+	if err := validateGuest(req.Guest); err != nil {
+		return nil, err
+	}
 
 	// Update booking
 	existingBooking.RoomId = req.RoomId
 	existingBooking.CheckInTs = &req.CheckInTs
 	existingBooking.CheckOutTs = &req.CheckOutTs
 	existingBooking.Guests = req.Guests
+	// An anonymized guest stays anonymized
+	if existingBooking.AnonymizedAt == nil {
+		existingBooking.Guest = req.Guest
+	}
 
 	err = s.bookingRepo.Update(ctx, existingBooking)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update booking: %w", err)
+		return nil, updateError("booking", err)
 	}
 
+	slog.InfoContext(ctx, "booking updated", "booking_id", existingBooking.Id)
+
 	return existingBooking, nil
 }
 
-// DeleteBooking deletes a booking by ID
+// PatchBooking applies a JSON merge patch to an existing booking
+func (s *bookingService) PatchBooking(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Booking, error) {
+	ctx, span := tracer.Start(ctx, "BookingService.PatchBooking")
+	defer span.End()
+
+	existingBooking, err := s.bookingRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("booking not found: %w", err)
+	}
+	if err := checkVersion(ifMatch, existingBooking.Version); err != nil {
+		return nil, err
+	}
+	if existingBooking.CancelledAt != nil {
+		return nil, fmt.Errorf("%w: booking is cancelled", ErrConflict)
+	}
+
+	booking, err := applyMergePatch(*existingBooking, patch, "room_id", "check_in_ts", "check_out_ts")
+	if err != nil {
+		return nil, err
+	}
+	booking.Id, booking.Version, booking.UpdatedAt, booking.DeletedAt = existingBooking.Id, existingBooking.Version, existingBooking.UpdatedAt, existingBooking.DeletedAt
+	booking.CancelledAt, booking.CancelReason = existingBooking.CancelledAt, existingBooking.CancelReason
+	booking.CheckedInAt, booking.CheckedOutAt = existingBooking.CheckedInAt, existingBooking.CheckedOutAt
+	booking.ServicePreferences, booking.AnonymizedAt = existingBooking.ServicePreferences, existingBooking.AnonymizedAt
+	if existingBooking.AnonymizedAt != nil {
+		booking.Guest = existingBooking.Guest
+	}
+	if err := validateGuest(booking.Guest); err != nil {
+		return nil, err
+	}
+
+	// Validate that the room exists
+	if booking.RoomId != existingBooking.RoomId {
+		_, err = s.roomRepo.GetByID(ctx, booking.RoomId)
+		if err != nil {
+			return nil, fmt.Errorf("room not found: %w", err)
+		}
+	}
+
+	// Validate check-in/check-out dates
+	if booking.CheckInTs == nil || booking.CheckOutTs == nil {
+		return nil, fmt.Errorf("check-in and check-out dates are required")
+	}
+	if booking.CheckInTs.After(*booking.CheckOutTs) {
+		return nil, fmt.Errorf("check-in date must be before check-out date")
+	}
+	if err := s.checkRoomAvailable(ctx, booking.RoomId, *booking.CheckInTs, *booking.CheckOutTs); err != nil {
+		return nil, err
+	}
+	if err := s.checkRoomCapacity(ctx, booking.RoomId, booking.Guests); err != nil {
+		return nil, err
+	}
+
+	err = s.bookingRepo.Update(ctx, &booking)
+	if err != nil {
+		return nil, updateError("booking", err)
+	}
+
+	slog.InfoContext(ctx, "booking patched", "booking_id", booking.Id)
+
+	return &booking, nil
+}
+
+// DeleteBooking marks a booking as deleted, it stays in the database until it is purged
 func (s *bookingService) DeleteBooking(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "BookingService.DeleteBooking")
+	defer span.End()
+
 	// Check if booking exists
 	_, err := s.bookingRepo.GetByID(ctx, id)
 	if err != nil {
@@ -116,5 +247,299 @@ func (s *bookingService) DeleteBooking(ctx context.Context, id int) error {
 		return fmt.Errorf("failed to delete booking: %w", err)
 	}
 
+	slog.InfoContext(ctx, "booking deleted", "booking_id", id)
+
 	return nil
 }
+
+// RestoreBooking restores a deleted booking
+func (s *bookingService) RestoreBooking(ctx context.Context, id int) (*models.Booking, error) {
+	ctx, span := tracer.Start(ctx, "BookingService.RestoreBooking")
+	defer span.End()
+
+	deleted, err := s.bookingRepo.GetByIDWithDeleted(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("deleted booking not found: %w", err)
+	}
+
+	// The room has to be restored first
+	_, err = s.roomRepo.GetByID(ctx, deleted.RoomId)
+	if err != nil {
+		return nil, fmt.Errorf("room not found: %w", err)
+	}
+
+	// The room may have been blocked or changed its type since
+	if deleted.CheckInTs != nil && deleted.CheckOutTs != nil {
+		if err := s.checkRoomAvailable(ctx, deleted.RoomId, *deleted.CheckInTs, *deleted.CheckOutTs); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkRoomCapacity(ctx, deleted.RoomId, deleted.Guests); err != nil {
+		return nil, err
+	}
+
+	err = s.bookingRepo.Restore(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("deleted booking not found: %w", err)
+	}
+
+	booking, err := s.bookingRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("booking not found: %w", err)
+	}
+
+	slog.InfoContext(ctx, "booking restored", "booking_id", id)
+
+	return booking, nil
+}
+
+// CancelBooking marks a booking as cancelled and cancels its future cleaning
+// orders, done orders are kept
+func (s *bookingService) CancelBooking(ctx context.Context, id int, req *models.BookingCancelRequest) (*models.BookingCancelResponse, error) {
+	ctx, span := tracer.Start(ctx, "BookingService.CancelBooking")
+	defer span.End()
+
+	if req.Reason == "" {
+		return nil, fmt.Errorf("cancellation reason is required")
+	}
+	departureCleaning := req.DepartureCleaning == nil || *req.DepartureCleaning
+
+	response := &models.BookingCancelResponse{}
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		booking, err := s.bookingRepo.GetByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("booking not found: %w", err)
+		}
+		if booking.CancelledAt != nil {
+			return fmt.Errorf("%w: booking is already cancelled", ErrConflict)
+		}
+		if booking.CheckedOutAt != nil {
+			return fmt.Errorf("%w: guest has already checked out", ErrConflict)
+		}
+		if booking.CheckOutTs != nil && !booking.CheckOutTs.After(time.Now()) {
+			return fmt.Errorf("%w: booking has already ended", ErrConflict)
+		}
+
+		if err := s.bookingRepo.Cancel(ctx, booking, req.Reason); err != nil {
+			return fmt.Errorf("failed to cancel booking: %w", err)
+		}
+
+		cancelled, departure, err := s.cleaningOrderService.CancelCleaningOrdersForBooking(ctx, *booking, req.Reason, departureCleaning)
+		if err != nil {
+			return err
+		}
+
+		response.Booking = *booking
+		response.CancelledOrders = cancelled
+		response.DepartureCleaning = departure
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "booking cancelled", "booking_id", id, "cancelled_orders", response.CancelledOrders)
+
+	return response, nil
+}
+
+// CheckInBooking records the actual check-in of the guest
+func (s *bookingService) CheckInBooking(ctx context.Context, id int, req *models.BookingEventRequest) (*models.Booking, error) {
+	ctx, span := tracer.Start(ctx, "BookingService.CheckInBooking")
+	defer span.End()
+
+	at := eventTime(req)
+
+	booking, err := s.bookingRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("booking not found: %w", err)
+	}
+	if booking.CancelledAt != nil {
+		return nil, fmt.Errorf("%w: booking is cancelled", ErrConflict)
+	}
+	if booking.CheckedInAt != nil {
+		return nil, fmt.Errorf("%w: guest has already checked in", ErrConflict)
+	}
+
+	err = s.bookingRepo.CheckIn(ctx, booking, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check in: %w", err)
+	}
+
+	slog.InfoContext(ctx, "guest checked in", "booking_id", id, "at", at)
+
+	return booking, nil
+}
+
+// CheckOutBooking records the actual check-out of the guest, cancels the
+// periodic cleanings after it and moves the general cleaning
+func (s *bookingService) CheckOutBooking(ctx context.Context, id int, req *models.BookingEventRequest) (*models.BookingCheckOutResponse, error) {
+	ctx, span := tracer.Start(ctx, "BookingService.CheckOutBooking")
+	defer span.End()
+
+	at := eventTime(req)
+
+	response := &models.BookingCheckOutResponse{}
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		booking, err := s.bookingRepo.GetByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("booking not found: %w", err)
+		}
+		if booking.CheckedInAt == nil {
+			return fmt.Errorf("%w: guest has not checked in", ErrConflict)
+		}
+		if booking.CheckedOutAt != nil {
+			return fmt.Errorf("%w: guest has already checked out", ErrConflict)
+		}
+		if at.Before(*booking.CheckedInAt) {
+			return fmt.Errorf("check-out must not be before check-in")
+		}
+
+		if err := s.bookingRepo.CheckOut(ctx, booking, at); err != nil {
+			return fmt.Errorf("failed to check out: %w", err)
+		}
+
+		if err := s.roomRepo.SetStatus(ctx, booking.RoomId, models.RoomStatusDirty); err != nil {
+			return fmt.Errorf("failed to set room status: %w", err)
+		}
+
+		cancelled, departure, err := s.cleaningOrderService.RescheduleCleaningOrdersForDeparture(ctx, *booking, at)
+		if err != nil {
+			return err
+		}
+
+		response.Booking = *booking
+		response.CancelledOrders = cancelled
+		response.DepartureCleaning = departure
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "guest checked out", "booking_id", id, "at", at)
+
+	return response, nil
+}
+
+// SetServicePreferences replaces the service preferences of a booking and
+// reschedules its pending periodic cleanings accordingly
+func (s *bookingService) SetServicePreferences(ctx context.Context, id int, prefs *models.ServicePreferences, ifMatch *int) (*models.Booking, error) {
+	ctx, span := tracer.Start(ctx, "BookingService.SetServicePreferences")
+	defer span.End()
+
+	if err := validateServicePreferences(prefs); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.bookingRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("booking not found: %w", err)
+	}
+	if err := checkVersion(ifMatch, booking.Version); err != nil {
+		return nil, err
+	}
+	if booking.CancelledAt != nil {
+		return nil, fmt.Errorf("%w: booking is cancelled", ErrConflict)
+	}
+	if booking.CheckedOutAt != nil {
+		return nil, fmt.Errorf("%w: guest has checked out", ErrConflict)
+	}
+
+	booking.ServicePreferences = prefs
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.bookingRepo.SetServicePreferences(ctx, booking); err != nil {
+			return updateError("booking", err)
+		}
+		return s.cleaningOrderService.ApplyServicePreferences(ctx, *booking)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "service preferences set", "booking_id", booking.Id, "cleaning", prefs.Cleaning)
+
+	return booking, nil
+}
+
+// AnonymizeBooking erases the personal data of the guest once the stay is
+// over, the booking itself stays for reports
+func (s *bookingService) AnonymizeBooking(ctx context.Context, id int) (*models.Booking, error) {
+	ctx, span := tracer.Start(ctx, "BookingService.AnonymizeBooking")
+	defer span.End()
+
+	booking, err := s.bookingRepo.GetByIDWithDeleted(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("booking not found: %w", err)
+	}
+	if booking.AnonymizedAt != nil {
+		return booking, nil
+	}
+	if !hasDeparted(booking, time.Now()) {
+		return nil, fmt.Errorf("%w: guest has not left yet", ErrConflict)
+	}
+
+	if err := s.bookingRepo.Anonymize(ctx, booking); err != nil {
+		return nil, fmt.Errorf("failed to anonymize booking: %w", err)
+	}
+
+	slog.InfoContext(ctx, "booking anonymized", "booking_id", booking.Id)
+
+	return booking, nil
+}
+
+// hasDeparted reports whether the guest of a booking has left at the given
+// time: checked out, past the planned check-out or cancelled
+func hasDeparted(booking *models.Booking, at time.Time) bool {
+	if booking.CancelledAt != nil || booking.CheckedOutAt != nil {
+		return true
+	}
+	return booking.CheckOutTs != nil && !booking.CheckOutTs.After(at)
+}
+
+// validateGuest checks the personal data of a guest
+func validateGuest(guest *models.Guest) error {
+	if guest == nil {
+		return nil
+	}
+	if guest.Email != nil && *guest.Email != "" && !strings.Contains(*guest.Email, "@") {
+		return fmt.Errorf("email %q is not valid", *guest.Email)
+	}
+	return nil
+}
+
+// checkRoomAvailable fails if the room is out of order during a stay
+func (s *bookingService) checkRoomAvailable(ctx context.Context, roomID int, checkIn, checkOut time.Time) error {
+	count, err := s.roomBlockRepo.CountOverlapping(ctx, roomID, models.RoomBlockKindOutOfOrder, checkIn, checkOut)
+	if err != nil {
+		return fmt.Errorf("failed to check room availability: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: room is out of order during the stay", ErrConflict)
+	}
+	return nil
+}
+
+// checkRoomCapacity fails if a booking has more guests than the type of the
+// room allows, rooms without a type take any number
+func (s *bookingService) checkRoomCapacity(ctx context.Context, roomID int, guests *int) error {
+	if guests == nil {
+		return nil
+	}
+	roomType, err := roomTypeOf(ctx, s.roomTypeRepo, roomID)
+	if err != nil {
+		return err
+	}
+	if roomType != nil && *guests > roomType.Capacity {
+		return fmt.Errorf("%d guests exceed the capacity of %d of room type %q", *guests, roomType.Capacity, roomType.Name)
+	}
+	return nil
+}
+
+// eventTime returns the time of a check-in or check-out event, now if unset
+func eventTime(req *models.BookingEventRequest) time.Time {
+	if req != nil && req.At != nil {
+		return *req.At
+	}
+	return time.Now()
+}
@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+func TestRestoreBooking(t *testing.T) {
+	checkIn := time.Date(2025, time.January, 10, 15, 0, 0, 0, time.UTC)
+	checkOut := time.Date(2025, time.January, 12, 11, 0, 0, 0, time.UTC)
+	deletedAt := time.Date(2025, time.January, 5, 9, 0, 0, 0, time.UTC)
+	double := &models.RoomType{Id: 1, Name: "double", Capacity: 2}
+
+	tests := []struct {
+		name       string
+		guests     int
+		outOfOrder bool
+		wantErr    bool
+		wantStatus error
+	}{
+		{name: "restored", guests: 2},
+		{name: "room out of order", guests: 2, outOfOrder: true, wantErr: true, wantStatus: ErrConflict},
+		{name: "guests exceed the capacity", guests: 3, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bookings := &fakeBookingRepo{bookings: map[int]*models.Booking{
+				1: {Id: 1, RoomId: 7, CheckInTs: &checkIn, CheckOutTs: &checkOut, Guests: &tt.guests, DeletedAt: &deletedAt},
+			}}
+			s := &bookingService{
+				bookingRepo:   bookings,
+				roomRepo:      &fakeRoomRepo{rooms: map[int]*models.Room{7: {Id: 7}}},
+				roomBlockRepo: &fakeRoomBlockRepo{outOfOrder: map[int]bool{7: tt.outOfOrder}},
+				roomTypeRepo:  &fakeRoomTypeRepo{byRoom: map[int]*models.RoomType{7: double}},
+			}
+
+			booking, err := s.RestoreBooking(context.Background(), 1)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RestoreBooking() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantStatus != nil && !errors.Is(err, tt.wantStatus) {
+				t.Errorf("RestoreBooking() error = %v, want %v", err, tt.wantStatus)
+			}
+			if tt.wantErr {
+				if len(bookings.restored) != 0 {
+					t.Errorf("booking restored despite the error")
+				}
+				return
+			}
+			if booking.DeletedAt != nil {
+				t.Errorf("restored booking is still deleted")
+			}
+		})
+	}
+}
+
+// bookingOrders returns a booking service with the orders of booking 1 in
+// room 7 at the given times, the last order being its general cleaning
+func bookingOrders(booking *models.Booking, times ...time.Time) (*bookingService, *fakeCleaningOrderRepo, *fakeRoomRepo) {
+	orders := &fakeCleaningOrderRepo{orders: map[int]*models.CleaningOrder{}}
+	for i, cleaningTs := range times {
+		cleaningType := "periodic"
+		if i == len(times)-1 {
+			cleaningType = "general"
+		}
+		orders.orders[i+1] = &models.CleaningOrder{Id: i + 1, BookingId: &booking.Id, CleaningTs: &cleaningTs, CleaningType: &cleaningType}
+	}
+	rooms := &fakeRoomRepo{rooms: map[int]*models.Room{7: {Id: 7}}}
+	roomTypes := &fakeRoomTypeRepo{byRoom: map[int]*models.RoomType{7: {Id: 1, Name: "double", Capacity: 2}}}
+	bookings := &fakeBookingRepo{bookings: map[int]*models.Booking{booking.Id: booking}}
+
+	s := &bookingService{
+		bookingRepo:  bookings,
+		roomRepo:     rooms,
+		roomTypeRepo: roomTypes,
+		transactor:   fakeTransactor{},
+		cleaningOrderService: &cleaningOrderService{
+			cleaningOrderRepo: orders,
+			bookingRepo:       bookings,
+			roomRepo:          rooms,
+			roomTypeRepo:      roomTypes,
+			checklistRepo:     fakeChecklistRepo{},
+			transactor:        fakeTransactor{},
+			schedule:          DefaultSchedule(),
+		},
+	}
+	return s, orders, rooms
+}
+
+func TestCancelBooking(t *testing.T) {
+	now := time.Now()
+	today := localDate(now, time.UTC)
+	yesterday := today.AddDate(0, 0, -1)
+	tomorrow := today.AddDate(0, 0, 1)
+	later := today.AddDate(0, 0, 3)
+	no := false
+
+	tests := []struct {
+		name              string
+		checkIn           time.Time
+		checkOut          time.Time
+		checkedIn         bool
+		cancelled         bool
+		departureCleaning *bool
+		wantErr           error
+		wantCancelled     []int
+		wantDeparture     bool
+	}{
+		{
+			name:      "guest in the room",
+			checkIn:   yesterday,
+			checkOut:  later,
+			checkedIn: true,
+			// The order of yesterday is left, today's order is cancelled even if overdue
+			wantCancelled: []int{2, 3, 4},
+			wantDeparture: true,
+		},
+		{
+			name:              "departure cleaning not wanted",
+			checkIn:           yesterday,
+			checkOut:          later,
+			checkedIn:         true,
+			departureCleaning: &no,
+			wantCancelled:     []int{2, 3, 4},
+		},
+		{
+			name:          "stay not started",
+			checkIn:       tomorrow,
+			checkOut:      later,
+			wantCancelled: []int{2, 3, 4},
+		},
+		{
+			name:      "already cancelled",
+			checkIn:   yesterday,
+			checkOut:  later,
+			cancelled: true,
+			wantErr:   ErrConflict,
+		},
+		{
+			name:     "already ended",
+			checkIn:  yesterday.AddDate(0, 0, -1),
+			checkOut: yesterday,
+			wantErr:  ErrConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := &models.Booking{Id: 1, RoomId: 7, CheckInTs: &tt.checkIn, CheckOutTs: &tt.checkOut}
+			if tt.checkedIn {
+				booking.CheckedInAt = &tt.checkIn
+			}
+			if tt.cancelled {
+				booking.CancelledAt = &tt.checkIn
+			}
+			s, orders, _ := bookingOrders(booking, yesterday.Add(13*time.Hour), today, tomorrow.Add(13*time.Hour), later.Add(12*time.Hour))
+
+			req := &models.BookingCancelRequest{Reason: "guest request", DepartureCleaning: tt.departureCleaning}
+			response, err := s.CancelBooking(context.Background(), 1, req)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("CancelBooking() error = %v, want %v", err, tt.wantErr)
+				}
+				if cancelled := len(orders.orders) - len(orders.pending(1)); cancelled != 0 {
+					t.Errorf("%d orders cancelled despite the error", cancelled)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if response.Booking.CancelledAt == nil || *response.Booking.CancelReason != "guest request" {
+				t.Errorf("booking not cancelled: %+v", response.Booking)
+			}
+			if response.CancelledOrders != len(tt.wantCancelled) {
+				t.Errorf("cancelled %d orders, want %d", response.CancelledOrders, len(tt.wantCancelled))
+			}
+			for _, id := range tt.wantCancelled {
+				if orders.orders[id].CancelledAt == nil {
+					t.Errorf("order %d not cancelled", id)
+				}
+			}
+			if orders.orders[1].CancelledAt != nil {
+				t.Errorf("order of yesterday cancelled")
+			}
+			if (response.DepartureCleaning != nil) != tt.wantDeparture {
+				t.Fatalf("departure cleaning %+v, want %v", response.DepartureCleaning, tt.wantDeparture)
+			}
+			if tt.wantDeparture {
+				order := orders.orders[response.DepartureCleaning.Id]
+				if *order.CleaningType != "general" || order.CleaningTs.Before(now.Add(time.Hour)) || order.Cost != 200 {
+					t.Errorf("departure cleaning %s at %v for %d", *order.CleaningType, order.CleaningTs, order.Cost)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckOutBooking(t *testing.T) {
+	checkIn := time.Date(2025, time.January, 10, 15, 0, 0, 0, time.UTC)
+	checkOut := time.Date(2025, time.January, 14, 11, 0, 0, 0, time.UTC)
+	departedAt := time.Date(2025, time.January, 12, 9, 30, 0, 0, time.UTC)
+	day := func(d int) time.Time { return time.Date(2025, time.January, d, 13, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name          string
+		checkedIn     *time.Time
+		at            time.Time
+		generalDone   bool
+		wantErr       bool
+		wantStatus    error
+		wantDeparture int
+	}{
+		{name: "general cleaning moved", checkedIn: &checkIn, at: departedAt, wantDeparture: 4},
+		// The orders of the booking are 1 to 4, the new one is 5
+		{name: "general cleaning created when none is pending", checkedIn: &checkIn, at: departedAt, generalDone: true, wantDeparture: 5},
+		{name: "not checked in", at: departedAt, wantErr: true, wantStatus: ErrConflict},
+		{name: "check-out before check-in", checkedIn: &checkIn, at: checkIn.Add(-time.Hour), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := &models.Booking{Id: 1, RoomId: 7, CheckInTs: &checkIn, CheckOutTs: &checkOut, CheckedInAt: tt.checkedIn}
+			s, orders, rooms := bookingOrders(booking, day(11), day(12), day(13), checkOut.Add(time.Hour))
+			if tt.generalDone {
+				done := true
+				orders.orders[4].Done = &done
+			}
+
+			response, err := s.CheckOutBooking(context.Background(), 1, &models.BookingEventRequest{At: &tt.at})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckOutBooking() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantStatus != nil && !errors.Is(err, tt.wantStatus) {
+				t.Errorf("CheckOutBooking() error = %v, want %v", err, tt.wantStatus)
+			}
+			if tt.wantErr {
+				if rooms.rooms[7].Status != nil {
+					t.Errorf("room status set despite the error")
+				}
+				return
+			}
+
+			if !response.Booking.CheckedOutAt.Equal(tt.at) {
+				t.Errorf("checked out at %v, want %v", response.Booking.CheckedOutAt, tt.at)
+			}
+			if status := rooms.rooms[7].Status; status == nil || *status != models.RoomStatusDirty {
+				t.Errorf("room status %v, want %s", status, models.RoomStatusDirty)
+			}
+			// The periodic cleanings of the day of departure and after it are cancelled
+			if response.CancelledOrders != 2 || orders.orders[1].CancelledAt != nil ||
+				orders.orders[2].CancelledAt == nil || orders.orders[3].CancelledAt == nil {
+				t.Errorf("cancelled %d orders, want the orders 2 and 3", response.CancelledOrders)
+			}
+			if response.DepartureCleaning == nil || response.DepartureCleaning.Id != tt.wantDeparture {
+				t.Fatalf("departure cleaning %+v, want order %d", response.DepartureCleaning, tt.wantDeparture)
+			}
+			if want := tt.at.Add(time.Hour); !response.DepartureCleaning.CleaningTs.Equal(want) {
+				t.Errorf("departure cleaning at %v, want %v", response.DepartureCleaning.CleaningTs, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// ChecklistService defines the interface for cleaning checklist business operations
+type ChecklistService interface {
+	CreateChecklistTemplateItem(ctx context.Context, req *models.ChecklistTemplateItemCreateRequest) (*models.ChecklistTemplateItem, error)
+	GetChecklistTemplateItems(ctx context.Context, cleaningType *string) ([]models.ChecklistTemplateItem, error)
+	DeleteChecklistTemplateItem(ctx context.Context, id int) error
+	GetOrderChecklist(ctx context.Context, orderID int) ([]models.OrderChecklistItem, error)
+	CheckOrderChecklistItem(ctx context.Context, orderID, itemID int, req *models.ChecklistItemCheckRequest) (*models.OrderChecklistItem, error)
+}
+
+// CreateChecklistTemplateItem adds an item to the checklist of a cleaning
+// type, orders created afterwards get it
+func (s *checklistService) CreateChecklistTemplateItem(ctx context.Context, req *models.ChecklistTemplateItemCreateRequest) (*models.ChecklistTemplateItem, error) {
+	ctx, span := tracer.Start(ctx, "ChecklistService.CreateChecklistTemplateItem")
+	defer span.End()
+
+	if req.CleaningType == "" {
+		return nil, fmt.Errorf("cleaning_type is required")
+	}
+	if req.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+
+	item := &models.ChecklistTemplateItem{
+		CleaningType: req.CleaningType,
+		Name:         req.Name,
+		Required:     true,
+	}
+	if req.Required != nil {
+		item.Required = *req.Required
+	}
+
+	err := s.checklistRepo.CreateTemplateItem(ctx, item, req.Position)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create checklist template item: %w", err)
+	}
+
+	slog.InfoContext(ctx, "checklist template item created", "item_id", item.Id, "cleaning_type", item.CleaningType)
+
+	return item, nil
+}
+
+// GetChecklistTemplateItems retrieves the checklist templates, optionally of
+// one cleaning type
+func (s *checklistService) GetChecklistTemplateItems(ctx context.Context, cleaningType *string) ([]models.ChecklistTemplateItem, error) {
+	ctx, span := tracer.Start(ctx, "ChecklistService.GetChecklistTemplateItems")
+	defer span.End()
+
+	items, err := s.checklistRepo.GetTemplateItems(ctx, cleaningType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checklist template items: %w", err)
+	}
+
+	return items, nil
+}
+
+// DeleteChecklistTemplateItem removes an item from the checklist of a
+// cleaning type, checklists of existing orders are kept
+func (s *checklistService) DeleteChecklistTemplateItem(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "ChecklistService.DeleteChecklistTemplateItem")
+	defer span.End()
+
+	err := s.checklistRepo.DeactivateTemplateItem(ctx, id)
+	if err != nil {
+		return fmt.Errorf("checklist template item not found: %w", err)
+	}
+
+	slog.InfoContext(ctx, "checklist template item deleted", "item_id", id)
+
+	return nil
+}
+
+// GetOrderChecklist retrieves the checklist of a cleaning order
+func (s *checklistService) GetOrderChecklist(ctx context.Context, orderID int) ([]models.OrderChecklistItem, error) {
+	ctx, span := tracer.Start(ctx, "ChecklistService.GetOrderChecklist")
+	defer span.End()
+
+	_, err := s.cleaningOrderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("cleaning order not found: %w", err)
+	}
+
+	items, err := s.checklistRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checklist: %w", err)
+	}
+
+	return items, nil
+}
+
+// CheckOrderChecklistItem ticks or unticks an item of the checklist of a not
+// done cleaning order
+func (s *checklistService) CheckOrderChecklistItem(ctx context.Context, orderID, itemID int, req *models.ChecklistItemCheckRequest) (*models.OrderChecklistItem, error) {
+	ctx, span := tracer.Start(ctx, "ChecklistService.CheckOrderChecklistItem")
+	defer span.End()
+
+	order, err := s.cleaningOrderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("cleaning order not found: %w", err)
+	}
+	if order.CancelledAt != nil {
+		return nil, fmt.Errorf("%w: cleaning order is cancelled", ErrConflict)
+	}
+	if isDone(order) {
+		return nil, fmt.Errorf("%w: cleaning order is done", ErrConflict)
+	}
+
+	var checkedAt *time.Time
+	var checkedBy *int
+	if req.Checked {
+		now := time.Now()
+		checkedAt = &now
+		if req.CleanerId != nil {
+			if _, err := s.cleanerRepo.GetByID(ctx, *req.CleanerId); err != nil {
+				return nil, fmt.Errorf("cleaner not found: %w", err)
+			}
+			checkedBy = req.CleanerId
+		}
+	}
+
+	item, err := s.checklistRepo.SetChecked(ctx, orderID, itemID, checkedAt, checkedBy)
+	if err != nil {
+		return nil, fmt.Errorf("checklist item not found: %w", err)
+	}
+
+	slog.InfoContext(ctx, "checklist item checked", "order_id", orderID, "item_id", itemID, "checked", req.Checked)
+
+	return item, nil
+}
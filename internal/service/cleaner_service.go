@@ -3,21 +3,28 @@ package service
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
 	"github.com/StEvseeva/cleany/internal/models"
 )
 
 // CleanerService defines the interface for cleaner business operations
 type CleanerService interface {
+	ShiftService
 	CreateCleaner(ctx context.Context, req *models.CleanerCreateRequest) (*models.Cleaner, error)
-	GetCleaner(ctx context.Context, id int) (*models.Cleaner, error)
-	GetAllCleaners(ctx context.Context) ([]models.Cleaner, error)
-	UpdateCleaner(ctx context.Context, id int, req *models.CleanerUpdateRequest) (*models.Cleaner, error)
+	GetCleaner(ctx context.Context, id int, includeDeleted bool) (*models.Cleaner, error)
+	GetAllCleaners(ctx context.Context, includeDeleted bool) ([]models.Cleaner, error)
+	UpdateCleaner(ctx context.Context, id int, req *models.CleanerUpdateRequest, ifMatch *int) (*models.Cleaner, error)
+	PatchCleaner(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Cleaner, error)
 	DeleteCleaner(ctx context.Context, id int) error
+	RestoreCleaner(ctx context.Context, id int) (*models.Cleaner, error)
 }
 
 // CreateCleaner creates a new cleaner with validation
 func (s *cleanerService) CreateCleaner(ctx context.Context, req *models.CleanerCreateRequest) (*models.Cleaner, error) {
+	ctx, span := tracer.Start(ctx, "CleanerService.CreateCleaner")
+	defer span.End()
+
 	// Validate input
 	if req.Name == "" {
 		return nil, fmt.Errorf("cleaner name is required")
@@ -26,10 +33,15 @@ func (s *cleanerService) CreateCleaner(ctx context.Context, req *models.CleanerC
 		return nil, fmt.Errorf("cleaner surname is required")
 	}
 
+	if err := s.checkHomeZone(ctx, req.HomeZoneId); err != nil {
+		return nil, err
+	}
+
 	// Create cleaner
 	cleaner := &models.Cleaner{
-		Name:    req.Name,
-		Surname: req.Surname,
+		Name:       req.Name,
+		Surname:    req.Surname,
+		HomeZoneId: req.HomeZoneId,
 	}
 
 	err := s.cleanerRepo.Create(ctx, cleaner)
@@ -37,12 +49,21 @@ func (s *cleanerService) CreateCleaner(ctx context.Context, req *models.CleanerC
 		return nil, fmt.Errorf("failed to create cleaner: %w", err)
 	}
 
+	slog.InfoContext(ctx, "cleaner created", "cleaner_id", cleaner.Id)
+
 	return cleaner, nil
 }
 
-// GetCleaner retrieves a cleaner by ID
-func (s *cleanerService) GetCleaner(ctx context.Context, id int) (*models.Cleaner, error) {
-	cleaner, err := s.cleanerRepo.GetByID(ctx, id)
+// GetCleaner retrieves a cleaner by ID, a deleted one only if includeDeleted is set
+func (s *cleanerService) GetCleaner(ctx context.Context, id int, includeDeleted bool) (*models.Cleaner, error) {
+	ctx, span := tracer.Start(ctx, "CleanerService.GetCleaner")
+	defer span.End()
+
+	get := s.cleanerRepo.GetByID
+	if includeDeleted {
+		get = s.cleanerRepo.GetByIDWithDeleted
+	}
+	cleaner, err := get(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("cleaner not found: %w", err)
 	}
@@ -50,9 +71,12 @@ func (s *cleanerService) GetCleaner(ctx context.Context, id int) (*models.Cleane
 	return cleaner, nil
 }
 
-// GetAllCleaners retrieves all cleaners
-func (s *cleanerService) GetAllCleaners(ctx context.Context) ([]models.Cleaner, error) {
-	cleaners, err := s.cleanerRepo.GetAll(ctx)
+// GetAllCleaners retrieves all cleaners, deleted ones only if includeDeleted is set
+func (s *cleanerService) GetAllCleaners(ctx context.Context, includeDeleted bool) ([]models.Cleaner, error) {
+	ctx, span := tracer.Start(ctx, "CleanerService.GetAllCleaners")
+	defer span.End()
+
+	cleaners, err := s.cleanerRepo.GetAll(ctx, includeDeleted)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cleaners: %w", err)
 	}
@@ -61,12 +85,18 @@ func (s *cleanerService) GetAllCleaners(ctx context.Context) ([]models.Cleaner,
 }
 
 // UpdateCleaner updates an existing cleaner
-func (s *cleanerService) UpdateCleaner(ctx context.Context, id int, req *models.CleanerUpdateRequest) (*models.Cleaner, error) {
+func (s *cleanerService) UpdateCleaner(ctx context.Context, id int, req *models.CleanerUpdateRequest, ifMatch *int) (*models.Cleaner, error) {
+	ctx, span := tracer.Start(ctx, "CleanerService.UpdateCleaner")
+	defer span.End()
+
 	// Check if cleaner exists
 	existingCleaner, err := s.cleanerRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("cleaner not found: %w", err)
 	}
+	if err := checkVersion(ifMatch, existingCleaner.Version); err != nil {
+		return nil, err
+	}
 
 	// Update fields if provided
 	if req.Name != nil {
@@ -81,17 +111,68 @@ func (s *cleanerService) UpdateCleaner(ctx context.Context, id int, req *models.
 		}
 		existingCleaner.Surname = *req.Surname
 	}
+	if req.HomeZoneId != nil {
+		if err := s.checkHomeZone(ctx, req.HomeZoneId); err != nil {
+			return nil, err
+		}
+		existingCleaner.HomeZoneId = req.HomeZoneId
+	}
 
 	err = s.cleanerRepo.Update(ctx, existingCleaner)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update cleaner: %w", err)
+		return nil, updateError("cleaner", err)
 	}
 
+	slog.InfoContext(ctx, "cleaner updated", "cleaner_id", existingCleaner.Id)
+
 	return existingCleaner, nil
 }
 
-// DeleteCleaner deletes a cleaner by ID
+// PatchCleaner applies a JSON merge patch to an existing cleaner
+func (s *cleanerService) PatchCleaner(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Cleaner, error) {
+	ctx, span := tracer.Start(ctx, "CleanerService.PatchCleaner")
+	defer span.End()
+
+	existingCleaner, err := s.cleanerRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("cleaner not found: %w", err)
+	}
+	if err := checkVersion(ifMatch, existingCleaner.Version); err != nil {
+		return nil, err
+	}
+
+	cleaner, err := applyMergePatch(*existingCleaner, patch, "name", "surname")
+	if err != nil {
+		return nil, err
+	}
+	cleaner.Id, cleaner.Version, cleaner.UpdatedAt, cleaner.DeletedAt = existingCleaner.Id, existingCleaner.Version, existingCleaner.UpdatedAt, existingCleaner.DeletedAt
+	cleaner.PropertyId = existingCleaner.PropertyId
+
+	if cleaner.Name == "" {
+		return nil, fmt.Errorf("cleaner name cannot be empty")
+	}
+	if cleaner.Surname == "" {
+		return nil, fmt.Errorf("cleaner surname cannot be empty")
+	}
+	if err := s.checkHomeZone(ctx, cleaner.HomeZoneId); err != nil {
+		return nil, err
+	}
+
+	err = s.cleanerRepo.Update(ctx, &cleaner)
+	if err != nil {
+		return nil, updateError("cleaner", err)
+	}
+
+	slog.InfoContext(ctx, "cleaner patched", "cleaner_id", cleaner.Id)
+
+	return &cleaner, nil
+}
+
+// DeleteCleaner marks a cleaner as deleted, it stays in the database until it is purged
 func (s *cleanerService) DeleteCleaner(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "CleanerService.DeleteCleaner")
+	defer span.End()
+
 	// Check if cleaner exists
 	_, err := s.cleanerRepo.GetByID(ctx, id)
 	if err != nil {
@@ -103,5 +184,38 @@ func (s *cleanerService) DeleteCleaner(ctx context.Context, id int) error {
 		return fmt.Errorf("failed to delete cleaner: %w", err)
 	}
 
+	slog.InfoContext(ctx, "cleaner deleted", "cleaner_id", id)
+
+	return nil
+}
+
+// RestoreCleaner restores a deleted cleaner
+func (s *cleanerService) RestoreCleaner(ctx context.Context, id int) (*models.Cleaner, error) {
+	ctx, span := tracer.Start(ctx, "CleanerService.RestoreCleaner")
+	defer span.End()
+
+	err := s.cleanerRepo.Restore(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("deleted cleaner not found: %w", err)
+	}
+
+	cleaner, err := s.cleanerRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("cleaner not found: %w", err)
+	}
+
+	slog.InfoContext(ctx, "cleaner restored", "cleaner_id", id)
+
+	return cleaner, nil
+}
+
+// checkHomeZone fails if a home zone is given that does not exist
+func (s *cleanerService) checkHomeZone(ctx context.Context, zoneID *int) error {
+	if zoneID == nil {
+		return nil
+	}
+	if _, err := s.locationRepo.GetZoneByID(ctx, *zoneID); err != nil {
+		return fmt.Errorf("zone not found: %w", err)
+	}
 	return nil
 }
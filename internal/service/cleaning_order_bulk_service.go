@@ -0,0 +1,389 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// maxBulkOperations limits the size of a bulk request
+const maxBulkOperations = 500
+
+// errBulkSkipped marks operations not executed because the batch was rolled back
+var errBulkSkipped = errors.New("skipped")
+
+// errBulkInvalid rolls back an atomic batch with operations that failed
+// validation
+var errBulkInvalid = errors.New("invalid operations")
+
+// bulkItem is a validated bulk operation ready to be executed
+type bulkItem struct {
+	index     int
+	create    *models.CleaningOrderCreateRequest
+	order     *models.CleaningOrder
+	wasDone   bool
+	cleanerID int
+}
+
+// BulkCleaningOrders runs a batch of create, update, delete and assign
+// operations in a single transaction. Every operation is validated in the
+// transaction before any is executed. Operations are then executed grouped
+// by kind, not in request order: creates and updates with one query each,
+// then assignments, then deletes. In atomic mode any failure rolls back the whole batch, in best
+// effort mode failed operations are rolled back one by one.
+func (s *cleaningOrderService) BulkCleaningOrders(ctx context.Context, req *models.CleaningOrderBulkRequest) (*models.CleaningOrderBulkResponse, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.BulkCleaningOrders")
+	defer span.End()
+
+	mode := models.CleaningOrderBulkRequestModeAtomic
+	if req.Mode != nil {
+		mode = *req.Mode
+	}
+	if mode != models.CleaningOrderBulkRequestModeAtomic && mode != models.CleaningOrderBulkRequestModeBestEffort {
+		return nil, fmt.Errorf("unknown mode %q", mode)
+	}
+	if len(req.Operations) == 0 {
+		return nil, fmt.Errorf("operations are required")
+	}
+	if len(req.Operations) > maxBulkOperations {
+		return nil, fmt.Errorf("at most %d operations are allowed", maxBulkOperations)
+	}
+
+	results := make([]models.CleaningOrderBulkResult, len(req.Operations))
+	for i, op := range req.Operations {
+		results[i] = models.CleaningOrderBulkResult{
+			Index:  i,
+			Op:     string(op.Op),
+			Status: models.CleaningOrderBulkResultStatusSkipped,
+			Id:     op.Id,
+		}
+	}
+
+	atomic := mode == models.CleaningOrderBulkRequestModeAtomic
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Validate every operation before changing anything
+		var creates, updates, assigns, deletes []bulkItem
+		targeted := map[int]bool{}
+		failed := false
+		for i, op := range req.Operations {
+			item, err := s.prepareBulkOperation(ctx, i, op, targeted)
+			if err != nil {
+				setBulkResult(&results[i], err)
+				failed = true
+				continue
+			}
+
+			switch op.Op {
+			case models.CleaningOrderBulkOperationOpCreate:
+				creates = append(creates, *item)
+			case models.CleaningOrderBulkOperationOpUpdate:
+				updates = append(updates, *item)
+			case models.CleaningOrderBulkOperationOpAssign:
+				assigns = append(assigns, *item)
+			case models.CleaningOrderBulkOperationOpDelete:
+				deletes = append(deletes, *item)
+			}
+		}
+		if failed && atomic {
+			return errBulkInvalid
+		}
+
+		groups := []struct {
+			items []bulkItem
+			run   func(ctx context.Context, items []bulkItem) error
+		}{
+			{creates, s.bulkCreate(results)},
+			{updates, s.bulkUpdate},
+			{assigns, s.bulkAssign},
+			{deletes, s.bulkDelete},
+		}
+
+		for _, group := range groups {
+			if len(group.items) == 0 {
+				continue
+			}
+			if atomic {
+				if err := group.run(ctx, group.items); err != nil {
+					for _, item := range group.items {
+						setBulkResult(&results[item.index], err)
+					}
+					return err
+				}
+				markBulkOk(results, group.items)
+				continue
+			}
+			s.runBestEffort(ctx, results, group.items, group.run)
+		}
+		return nil
+	})
+
+	if errors.Is(err, errBulkInvalid) {
+		return bulkResponse(mode, results), nil
+	}
+	if err != nil {
+		// Nothing has been committed
+		for i := range results {
+			switch {
+			case atomic && results[i].Status == models.CleaningOrderBulkResultStatusOk:
+				setBulkResult(&results[i], errBulkSkipped)
+			case !atomic && results[i].Status != models.CleaningOrderBulkResultStatusFailed:
+				setBulkResult(&results[i], err)
+			}
+		}
+	}
+
+	response := bulkResponse(mode, results)
+	slog.InfoContext(ctx, "bulk cleaning order operations executed",
+		"mode", mode, "succeeded", response.Succeeded, "failed", response.Failed)
+
+	return response, nil
+}
+
+// prepareBulkOperation validates a single operation. targeted tracks orders
+// already updated or deleted by the batch so that each is changed once.
+func (s *cleaningOrderService) prepareBulkOperation(ctx context.Context, index int, op models.CleaningOrderBulkOperation, targeted map[int]bool) (*bulkItem, error) {
+	item := &bulkItem{index: index}
+
+	if op.Op == models.CleaningOrderBulkOperationOpCreate {
+		if op.Create == nil {
+			return nil, fmt.Errorf("create requires the create object")
+		}
+		req := *op.Create
+		if err := s.prepareCreateRequest(ctx, &req); err != nil {
+			return nil, err
+		}
+		item.create = &req
+		return item, nil
+	}
+
+	if op.Id == nil {
+		return nil, fmt.Errorf("%s requires id", op.Op)
+	}
+	order, err := s.cleaningOrderRepo.GetByID(ctx, *op.Id)
+	if err != nil {
+		return nil, fmt.Errorf("cleaning order not found: %w", err)
+	}
+	item.order = order
+
+	switch op.Op {
+	case models.CleaningOrderBulkOperationOpUpdate:
+		if op.Update == nil {
+			return nil, fmt.Errorf("update requires the update object")
+		}
+		if targeted[order.Id] {
+			return nil, fmt.Errorf("cleaning order %d is changed more than once", order.Id)
+		}
+		if order.CancelledAt != nil {
+			return nil, fmt.Errorf("%w: cleaning order is cancelled", ErrConflict)
+		}
+		item.wasDone = isDone(order)
+		if err := s.applyBulkUpdate(ctx, order, op.Update); err != nil {
+			return nil, err
+		}
+		targeted[order.Id] = true
+
+	case models.CleaningOrderBulkOperationOpDelete:
+		if targeted[order.Id] {
+			return nil, fmt.Errorf("cleaning order %d is changed more than once", order.Id)
+		}
+		targeted[order.Id] = true
+
+	case models.CleaningOrderBulkOperationOpAssign:
+		if op.CleanerId == nil {
+			return nil, fmt.Errorf("assign requires cleaner_id")
+		}
+		if order.CancelledAt != nil {
+			return nil, fmt.Errorf("%w: cleaning order is cancelled", ErrConflict)
+		}
+		if _, err := s.cleanerRepo.GetByID(ctx, *op.CleanerId); err != nil {
+			return nil, fmt.Errorf("cleaner not found: %w", err)
+		}
+		item.cleanerID = *op.CleanerId
+
+	default:
+		return nil, fmt.Errorf("unknown operation %q", op.Op)
+	}
+
+	return item, nil
+}
+
+// applyBulkUpdate validates and applies the given fields to order
+func (s *cleaningOrderService) applyBulkUpdate(ctx context.Context, order *models.CleaningOrder, update *models.CleaningOrderBulkUpdate) error {
+	if update.BookingId != nil {
+		if _, err := s.bookingRepo.GetByID(ctx, *update.BookingId); err != nil {
+			return fmt.Errorf("booking not found: %w", err)
+		}
+		// The order now cleans the room of the booking
+		order.BookingId, order.RoomId, order.ZoneId = update.BookingId, nil, nil
+	}
+	if update.Cost != nil {
+		if *update.Cost < 0 {
+			return fmt.Errorf("cost must be non-negative")
+		}
+		order.Cost = *update.Cost
+	}
+	if update.CleaningTs != nil {
+		order.CleaningTs = update.CleaningTs
+	}
+	if update.CleaningType != nil {
+		order.CleaningType = update.CleaningType
+	}
+	if update.Done != nil {
+		order.Done = update.Done
+	}
+	if update.Notes != nil {
+		order.Notes = update.Notes
+	}
+	return nil
+}
+
+// runBestEffort runs a group in a savepoint and, if it fails, retries every
+// item in its own savepoint to find the failing ones
+func (s *cleaningOrderService) runBestEffort(ctx context.Context, results []models.CleaningOrderBulkResult, items []bulkItem, run func(ctx context.Context, items []bulkItem) error) {
+	err := s.transactor.WithinSavepoint(ctx, func(ctx context.Context) error {
+		return run(ctx, items)
+	})
+	if err == nil {
+		markBulkOk(results, items)
+		return
+	}
+
+	for _, item := range items {
+		single := []bulkItem{item}
+		err := s.transactor.WithinSavepoint(ctx, func(ctx context.Context) error {
+			return run(ctx, single)
+		})
+		if err != nil {
+			setBulkResult(&results[item.index], err)
+			continue
+		}
+		markBulkOk(results, single)
+	}
+}
+
+// bulkCreate returns a group runner inserting orders with CreateMany,
+// completing those created as done, and reporting the new IDs in results
+func (s *cleaningOrderService) bulkCreate(results []models.CleaningOrderBulkResult) func(ctx context.Context, items []bulkItem) error {
+	return func(ctx context.Context, items []bulkItem) error {
+		reqs := make([]models.CleaningOrderCreateRequest, 0, len(items))
+		for _, item := range items {
+			reqs = append(reqs, *item.create)
+		}
+
+		ids, err := s.cleaningOrderRepo.CreateMany(ctx, reqs)
+		if err != nil {
+			return fmt.Errorf("failed to create cleaning orders: %w", err)
+		}
+		if err := s.instantiateChecklists(ctx, ids...); err != nil {
+			return err
+		}
+		for i, item := range items {
+			if i >= len(ids) {
+				continue
+			}
+			id := ids[i]
+			results[item.index].Id = &id
+			if item.create.Done == nil || !*item.create.Done {
+				continue
+			}
+			order := &models.CleaningOrder{
+				Id:           id,
+				BookingId:    item.create.BookingId,
+				RoomId:       item.create.RoomId,
+				ZoneId:       item.create.ZoneId,
+				CleaningType: item.create.CleaningType,
+				Done:         item.create.Done,
+			}
+			if err := s.completeCleaningOrder(ctx, order); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// bulkUpdate updates orders with UpdateMany
+func (s *cleaningOrderService) bulkUpdate(ctx context.Context, items []bulkItem) error {
+	orders := make([]models.CleaningOrder, 0, len(items))
+	for _, item := range items {
+		orders = append(orders, *item.order)
+	}
+
+	if err := s.cleaningOrderRepo.UpdateMany(ctx, orders); err != nil {
+		return updateError("cleaning orders", err)
+	}
+	for _, item := range items {
+		if !item.wasDone && isDone(item.order) {
+			if err := s.completeCleaningOrder(ctx, item.order); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// bulkAssign assigns cleaners to orders
+func (s *cleaningOrderService) bulkAssign(ctx context.Context, items []bulkItem) error {
+	for _, item := range items {
+		if err := s.cleaningOrderRepo.AssignCleaner(ctx, item.order.Id, item.cleanerID); err != nil {
+			return fmt.Errorf("failed to assign cleaner: %w", err)
+		}
+	}
+	return nil
+}
+
+// bulkDelete deletes orders
+func (s *cleaningOrderService) bulkDelete(ctx context.Context, items []bulkItem) error {
+	for _, item := range items {
+		if err := s.cleaningOrderRepo.Delete(ctx, item.order.Id); err != nil {
+			return fmt.Errorf("failed to delete cleaning order: %w", err)
+		}
+	}
+	return nil
+}
+
+// markBulkOk marks the items as succeeded
+func markBulkOk(results []models.CleaningOrderBulkResult, items []bulkItem) {
+	for _, item := range items {
+		results[item.index].Status = models.CleaningOrderBulkResultStatusOk
+		results[item.index].Error = nil
+	}
+}
+
+// setBulkResult marks a result as failed with err, or as skipped for errBulkSkipped
+func setBulkResult(result *models.CleaningOrderBulkResult, err error) {
+	if errors.Is(err, errBulkSkipped) {
+		result.Status = models.CleaningOrderBulkResultStatusSkipped
+		result.Error = nil
+		if result.Op == string(models.CleaningOrderBulkOperationOpCreate) {
+			result.Id = nil
+		}
+		return
+	}
+	msg := err.Error()
+	result.Status = models.CleaningOrderBulkResultStatusFailed
+	result.Error = &msg
+	if result.Op == string(models.CleaningOrderBulkOperationOpCreate) {
+		result.Id = nil
+	}
+}
+
+// bulkResponse counts the results
+func bulkResponse(mode models.CleaningOrderBulkRequestMode, results []models.CleaningOrderBulkResult) *models.CleaningOrderBulkResponse {
+	response := &models.CleaningOrderBulkResponse{
+		Mode:    string(mode),
+		Results: results,
+	}
+	for _, result := range results {
+		switch result.Status {
+		case models.CleaningOrderBulkResultStatusOk:
+			response.Succeeded++
+		case models.CleaningOrderBulkResultStatusFailed:
+			response.Failed++
+		}
+	}
+	return response
+}
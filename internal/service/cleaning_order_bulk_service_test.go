@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+func TestBulkCleaningOrdersCancelled(t *testing.T) {
+	cleaningTs := time.Date(2025, time.January, 10, 13, 0, 0, 0, time.UTC)
+	cancelledAt := time.Date(2025, time.January, 10, 9, 0, 0, 0, time.UTC)
+	active, cancelled, cleanerID, cost := 1, 2, 7, 150
+
+	update := func(id int) models.CleaningOrderBulkOperation {
+		return models.CleaningOrderBulkOperation{
+			Op:     models.CleaningOrderBulkOperationOpUpdate,
+			Id:     &id,
+			Update: &models.CleaningOrderBulkUpdate{Cost: &cost},
+		}
+	}
+	assign := func(id int) models.CleaningOrderBulkOperation {
+		return models.CleaningOrderBulkOperation{Op: models.CleaningOrderBulkOperationOpAssign, Id: &id, CleanerId: &cleanerID}
+	}
+
+	tests := []struct {
+		name string
+		ops  []models.CleaningOrderBulkOperation
+		want []models.CleaningOrderBulkResultStatus
+	}{
+		{
+			name: "update",
+			ops:  []models.CleaningOrderBulkOperation{update(cancelled), assign(active)},
+			want: []models.CleaningOrderBulkResultStatus{
+				models.CleaningOrderBulkResultStatusFailed, models.CleaningOrderBulkResultStatusSkipped,
+			},
+		},
+		{
+			name: "assign",
+			ops:  []models.CleaningOrderBulkOperation{update(active), assign(cancelled)},
+			want: []models.CleaningOrderBulkResultStatus{
+				models.CleaningOrderBulkResultStatusSkipped, models.CleaningOrderBulkResultStatusFailed,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &cleaningOrderService{
+				cleaningOrderRepo: &fakeCleaningOrderRepo{orders: map[int]*models.CleaningOrder{
+					active:    {Id: active, CleaningTs: &cleaningTs, Cost: 100},
+					cancelled: {Id: cancelled, CleaningTs: &cleaningTs, Cost: 100, CancelledAt: &cancelledAt},
+				}},
+				cleanerRepo: &fakeCleanerRepo{cleaners: map[int]*models.Cleaner{cleanerID: {Id: cleanerID}}},
+				transactor:  fakeTransactor{},
+			}
+
+			// Atomic by default, so the valid operation is not executed either
+			response, err := s.BulkCleaningOrders(context.Background(), &models.CleaningOrderBulkRequest{Operations: tt.ops})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if response.Failed != 1 || response.Succeeded != 0 {
+				t.Errorf("failed = %d, succeeded = %d, want 1 and 0", response.Failed, response.Succeeded)
+			}
+			for i, result := range response.Results {
+				if result.Status != tt.want[i] {
+					t.Errorf("operation %d is %s, want %s", i, result.Status, tt.want[i])
+				}
+				if result.Status == models.CleaningOrderBulkResultStatusFailed &&
+					(result.Error == nil || !strings.Contains(*result.Error, "cancelled")) {
+					t.Errorf("operation %d error = %v, want the order to be cancelled", i, result.Error)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// ecoCleaningInterval is the number of days between periodic cleanings in eco mode
+const ecoCleaningInterval = 3
+
+// Cancel reasons of periodic cleanings no longer wanted by the guest
+const (
+	dndSkipReason         = "skipped - DND"
+	preferencesSkipReason = "service preferences"
+)
+
+// ApplyServicePreferences brings the pending periodic cleanings of a booking
+// from now on in line with its service preferences: orders on days without a
+// cleaning are cancelled, the others are moved to the preferred time and
+// missing ones are created. Assigned cleaners are kept.
+func (s *cleaningOrderService) ApplyServicePreferences(ctx context.Context, booking models.Booking) error {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.ApplyServicePreferences")
+	defer span.End()
+
+	roomType, err := roomTypeOf(ctx, s.roomTypeRepo, booking.RoomId)
+	if err != nil {
+		return err
+	}
+	queue, err := collectOrdersQueue(booking, roomType, s.schedule)
+	if err != nil {
+		return fmt.Errorf("failed to collect queue for cleaning orders: %w", err)
+	}
+
+	now := time.Now()
+	wanted := map[string]models.CleaningOrderCreateRequest{}
+	for _, req := range queue {
+		if *req.CleaningType == "periodic" && req.CleaningTs.After(now) {
+			wanted[localDate(req.CleaningTs, s.schedule.Location).Format(time.DateOnly)] = req
+		}
+	}
+
+	pending, err := s.cleaningOrderRepo.GetPendingByBooking(ctx, booking.Id, now, "periodic")
+	if err != nil {
+		return fmt.Errorf("failed to get cleaning orders: %w", err)
+	}
+
+	prefs := bookingPreferences(booking)
+	var moved, cancelled int
+	for i := range pending {
+		order := &pending[i]
+		day := localDate(*order.CleaningTs, s.schedule.Location)
+		req, ok := wanted[day.Format(time.DateOnly)]
+		if !ok {
+			reason := preferencesSkipReason
+			if isDndDate(prefs, day) {
+				reason = dndSkipReason
+			}
+			if err := s.cleaningOrderRepo.Cancel(ctx, order, reason); err != nil {
+				return updateError("cleaning order", err)
+			}
+			cancelled++
+			continue
+		}
+		delete(wanted, day.Format(time.DateOnly))
+		if order.CleaningTs.Equal(req.CleaningTs) {
+			continue
+		}
+		order.CleaningTs = &req.CleaningTs
+		if err := s.cleaningOrderRepo.Update(ctx, order); err != nil {
+			return updateError("cleaning order", err)
+		}
+		moved++
+	}
+
+	missing := make([]models.CleaningOrderCreateRequest, 0, len(wanted))
+	for _, req := range wanted {
+		missing = append(missing, req)
+	}
+	if len(missing) > 0 {
+		ids, err := s.cleaningOrderRepo.CreateMany(ctx, missing)
+		if err != nil {
+			return fmt.Errorf("failed to create cleaning orders: %w", err)
+		}
+		if err := s.instantiateChecklists(ctx, ids...); err != nil {
+			return err
+		}
+	}
+
+	slog.InfoContext(ctx, "service preferences applied",
+		"booking_id", booking.Id,
+		"cleaning", prefs.Cleaning,
+		"cancelled", cancelled,
+		"moved", moved,
+		"created", len(missing),
+	)
+
+	return nil
+}
+
+// SkipCleaningOrderForDnd records a Do Not Disturb sign found at the door:
+// the order is cancelled as skipped and, if asked, scheduled again for the
+// same cleaners
+func (s *cleaningOrderService) SkipCleaningOrderForDnd(ctx context.Context, id int, req *models.DndSkipRequest) (*models.DndSkipResponse, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.SkipCleaningOrderForDnd")
+	defer span.End()
+
+	order, err := s.cleaningOrderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("cleaning order not found: %w", err)
+	}
+	if isDone(order) {
+		return nil, fmt.Errorf("%w: cleaning order is done", ErrConflict)
+	}
+	if order.CancelledAt != nil {
+		return nil, fmt.Errorf("%w: cleaning order is cancelled", ErrConflict)
+	}
+	if req.CleanerId != nil {
+		if _, err := s.cleanerRepo.GetByID(ctx, *req.CleanerId); err != nil {
+			return nil, fmt.Errorf("cleaner not found: %w", err)
+		}
+	}
+	if req.RescheduleTo != nil && req.RescheduleTo.Before(time.Now()) {
+		return nil, fmt.Errorf("reschedule_to must not be in the past")
+	}
+
+	response := &models.DndSkipResponse{Skipped: *order}
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.cleaningOrderRepo.Cancel(ctx, &response.Skipped, dndSkipReason); err != nil {
+			return updateError("cleaning order", err)
+		}
+		if req.RescheduleTo == nil {
+			return nil
+		}
+
+		notes := fmt.Sprintf("rescheduled order %d after Do Not Disturb", order.Id)
+		rescheduled := &models.CleaningOrder{
+			BookingId:    order.BookingId,
+			RoomId:       order.RoomId,
+			ZoneId:       order.ZoneId,
+			CleaningTs:   req.RescheduleTo,
+			CleaningType: order.CleaningType,
+			Cost:         order.Cost,
+			Notes:        &notes,
+		}
+		if err := s.cleaningOrderRepo.Create(ctx, rescheduled); err != nil {
+			return fmt.Errorf("failed to create cleaning order: %w", err)
+		}
+		if err := s.instantiateChecklists(ctx, rescheduled.Id); err != nil {
+			return err
+		}
+		cleanerIDs, err := s.cleaningOrderRepo.GetCleanerIDs(ctx, order.Id)
+		if err != nil {
+			return fmt.Errorf("failed to get cleaners of order: %w", err)
+		}
+		for _, cleanerID := range cleanerIDs {
+			if err := s.cleaningOrderRepo.AssignCleaner(ctx, rescheduled.Id, cleanerID); err != nil {
+				return fmt.Errorf("failed to assign cleaner: %w", err)
+			}
+		}
+		response.Rescheduled = rescheduled
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "cleaning order skipped for DND",
+		"order_id", order.Id,
+		"cleaner_id", req.CleanerId,
+		"rescheduled_to", req.RescheduleTo,
+	)
+
+	return response, nil
+}
+
+// bookingPreferences returns the service preferences of a booking, daily
+// cleaning if it has none
+func bookingPreferences(booking models.Booking) models.ServicePreferences {
+	if booking.ServicePreferences == nil {
+		return models.ServicePreferences{Cleaning: models.ServicePreferencesCleaningDaily}
+	}
+	return *booking.ServicePreferences
+}
+
+// wantsPeriodicCleaning reports whether the guest wants a periodic cleaning
+// on the given date, the day-th of the stay counted from 0
+func wantsPeriodicCleaning(prefs models.ServicePreferences, date time.Time, day int) bool {
+	if isDndDate(prefs, date) {
+		return false
+	}
+	switch prefs.Cleaning {
+	case models.ServicePreferencesCleaningNone:
+		return false
+	case models.ServicePreferencesCleaningEco:
+		return (day+1)%ecoCleaningInterval == 0
+	}
+	return true
+}
+
+// isDndDate reports whether date is the Do Not Disturb day of the guest
+func isDndDate(prefs models.ServicePreferences, date time.Time) bool {
+	if prefs.DndDate == nil {
+		return false
+	}
+	y, m, d := date.Date()
+	dy, dm, dd := prefs.DndDate.Date()
+	return y == dy && m == dm && d == dd
+}
+
+// periodicCleaningTime returns the offset from local midnight of periodic
+// cleanings, the start of the preferred window if the configured time is
+// outside of it
+func periodicCleaningTime(prefs models.ServicePreferences, schedule Schedule) time.Duration {
+	if prefs.WindowStart == nil || prefs.WindowEnd == nil {
+		return schedule.PeriodicCleaningTime
+	}
+	start, err := parseClock(*prefs.WindowStart)
+	if err != nil {
+		return schedule.PeriodicCleaningTime
+	}
+	end, err := parseClock(*prefs.WindowEnd)
+	if err != nil {
+		return schedule.PeriodicCleaningTime
+	}
+	if schedule.PeriodicCleaningTime < start || schedule.PeriodicCleaningTime >= end {
+		return start
+	}
+	return schedule.PeriodicCleaningTime
+}
+
+// validateServicePreferences checks the preferences of a booking
+func validateServicePreferences(prefs *models.ServicePreferences) error {
+	switch prefs.Cleaning {
+	case models.ServicePreferencesCleaningDaily, models.ServicePreferencesCleaningEco,
+		models.ServicePreferencesCleaningNone:
+	default:
+		return fmt.Errorf("unknown cleaning preference %q", prefs.Cleaning)
+	}
+	if (prefs.WindowStart == nil) != (prefs.WindowEnd == nil) {
+		return fmt.Errorf("window_start and window_end must be given together")
+	}
+	if prefs.WindowStart == nil {
+		return nil
+	}
+	start, err := parseClock(*prefs.WindowStart)
+	if err != nil {
+		return fmt.Errorf("window_start: %w", err)
+	}
+	end, err := parseClock(*prefs.WindowEnd)
+	if err != nil {
+		return fmt.Errorf("window_end: %w", err)
+	}
+	if start >= end {
+		return fmt.Errorf("window_start must be before window_end")
+	}
+	return nil
+}
+
+// parseClock parses a HH:MM time of day into an offset from midnight, hours
+// and minutes are two digits each
+func parseClock(value string) (time.Duration, error) {
+	if len(value) != 5 || value[2] != ':' || !isDigits(value[:2]) || !isDigits(value[3:]) {
+		return 0, fmt.Errorf("%q is not a HH:MM time", value)
+	}
+	hours, _ := strconv.Atoi(value[:2])
+	minutes, _ := strconv.Atoi(value[3:])
+	if hours > 23 || minutes > 59 {
+		return 0, fmt.Errorf("%q is not a HH:MM time", value)
+	}
+	return time.Duration(hours)*time.Hour + time.Duration(minutes)*time.Minute, nil
+}
+
+// isDigits reports whether value consists of ASCII digits only
+func isDigits(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
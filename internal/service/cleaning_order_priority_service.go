@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// priorityRanks orders the priorities, urgent first
+var priorityRanks = map[models.CleaningOrderPriority]int{
+	models.CleaningOrderPriorityUrgent: 0,
+	models.CleaningOrderPriorityHigh:   1,
+	models.CleaningOrderPriorityNormal: 2,
+	models.CleaningOrderPriorityLow:    3,
+}
+
+// SetCleaningOrderPriority sets the manual priority of an order, a request
+// without priority clears it
+func (s *cleaningOrderService) SetCleaningOrderPriority(ctx context.Context, id int, req *models.CleaningOrderPriorityRequest) (*models.CleaningOrder, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.SetCleaningOrderPriority")
+	defer span.End()
+
+	var priority *models.CleaningOrderPriority
+	if req.Priority != nil {
+		p := models.CleaningOrderPriority(*req.Priority)
+		if _, ok := priorityRanks[p]; !ok {
+			return nil, fmt.Errorf("unknown priority %q", *req.Priority)
+		}
+		priority = &p
+	}
+
+	if err := s.cleaningOrderRepo.SetPriorityOverride(ctx, id, priority); err != nil {
+		return nil, fmt.Errorf("cleaning order not found: %w", err)
+	}
+
+	order, err := s.cleaningOrderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("cleaning order not found: %w", err)
+	}
+	s.prioritize(order)
+
+	slog.InfoContext(ctx, "cleaning order priority set", "order_id", id, "priority", order.Priority)
+
+	return order, nil
+}
+
+// prioritize computes the priority of orders read from the repository
+func (s *cleaningOrderService) prioritize(orders ...*models.CleaningOrder) {
+	for _, order := range orders {
+		priority := orderPriority(order, s.schedule.Location)
+		order.Priority = &priority
+	}
+}
+
+// orderPriority returns the manual priority of an order if it has one.
+// Otherwise departure cleanings of rooms with an arrival the same day are
+// urgent and orders of VIP guests high.
+func orderPriority(order *models.CleaningOrder, loc *time.Location) models.CleaningOrderPriority {
+	if order.PriorityOverride != nil {
+		return *order.PriorityOverride
+	}
+	if order.NextArrival != nil && order.CleaningTs != nil &&
+		localDate(*order.NextArrival, loc).Equal(localDate(*order.CleaningTs, loc)) {
+		return models.CleaningOrderPriorityUrgent
+	}
+	if order.Vip != nil && *order.Vip {
+		return models.CleaningOrderPriorityHigh
+	}
+	return models.CleaningOrderPriorityNormal
+}
+
+// sortCleaningOrders orders a list by id, cleaning_ts or priority, an empty
+// key keeps the order of the repository
+func sortCleaningOrders(orders []models.CleaningOrder, key string) error {
+	switch key {
+	case "":
+	case "id":
+		sort.SliceStable(orders, func(i, j int) bool { return orders[i].Id < orders[j].Id })
+	case "cleaning_ts":
+		sort.SliceStable(orders, func(i, j int) bool { return cleaningBefore(&orders[i], &orders[j]) })
+	case "priority":
+		sort.SliceStable(orders, func(i, j int) bool {
+			ri, rj := priorityRanks[*orders[i].Priority], priorityRanks[*orders[j].Priority]
+			if ri != rj {
+				return ri < rj
+			}
+			return cleaningBefore(&orders[i], &orders[j])
+		})
+	default:
+		return fmt.Errorf("unknown sort %q", key)
+	}
+	return nil
+}
+
+// cleaningBefore reports whether order a is scheduled before b, orders
+// without time last
+func cleaningBefore(a, b *models.CleaningOrder) bool {
+	if a.CleaningTs == nil || b.CleaningTs == nil {
+		return a.CleaningTs != nil
+	}
+	if !a.CleaningTs.Equal(*b.CleaningTs) {
+		return a.CleaningTs.Before(*b.CleaningTs)
+	}
+	return a.Id < b.Id
+}
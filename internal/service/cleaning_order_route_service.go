@@ -0,0 +1,281 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// Route classes, orders of a lower class are visited first
+const (
+	routeClassArrival = iota
+	routeClassDeparture
+	routeClassStay
+)
+
+// routeCandidate is an open order a route can visit
+type routeCandidate struct {
+	stop  models.RouteStop
+	class int
+	// earliest is when the room can be cleaned, after the guest left or
+	// when the preferred window of the guest opens
+	earliest time.Time
+	// deadline is the next arrival or the end of the preferred window
+	deadline *time.Time
+	cleaning time.Time
+}
+
+// GetCleanerRoute suggests the sequence in which a cleaner works through
+// the open orders of a day, today if day is nil. Departures of rooms with an
+// arrival the same day come first, by the time of the arrival, then the
+// other departures, then the stays. Within a class the order on the nearest
+// floor is taken next so that the cleaner changes floors as little as
+// possible. An order is not started before the room is free.
+func (s *cleaningOrderService) GetCleanerRoute(ctx context.Context, cleanerID int, day *time.Time) (*models.CleanerRoute, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.GetCleanerRoute")
+	defer span.End()
+
+	if _, err := s.cleanerRepo.GetByID(ctx, cleanerID); err != nil {
+		return nil, fmt.Errorf("cleaner not found: %w", err)
+	}
+
+	now := time.Now()
+	from := localDate(now, s.schedule.Location)
+	if day != nil {
+		from = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, from.Location())
+	}
+	to := from.AddDate(0, 0, 1)
+
+	orders, err := s.cleaningOrderRepo.GetAllByCleanerId(ctx, cleanerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cleaning orders: %w", err)
+	}
+
+	candidates := []*routeCandidate{}
+	for i := range orders {
+		order := &orders[i]
+		if isDone(order) || order.CancelledAt != nil || order.CleaningTs == nil {
+			continue
+		}
+		if order.CleaningTs.Before(from) || !order.CleaningTs.Before(to) {
+			continue
+		}
+		candidate, err := s.routeCandidate(ctx, order, from, to)
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	cursor := atClock(from, s.schedule.DayStart)
+	if now.After(cursor) && now.Before(to) {
+		cursor = now
+	}
+
+	route := &models.CleanerRoute{
+		CleanerId: cleanerID,
+		Date:      openapi_types.Date{Time: from},
+		Stops:     []models.RouteStop{},
+	}
+	floor := 0
+	for len(candidates) > 0 {
+		next := nextRouteCandidate(candidates, cursor, floor, len(route.Stops) == 0)
+		candidate := candidates[next]
+		candidates = append(candidates[:next], candidates[next+1:]...)
+
+		if len(route.Stops) > 0 && candidate.stop.Floor != floor {
+			route.FloorChanges++
+			cursor = cursor.Add(s.schedule.FloorChangeTime)
+		}
+		if candidate.earliest.After(cursor) {
+			cursor = candidate.earliest
+		}
+		floor = candidate.stop.Floor
+
+		stop := candidate.stop
+		stop.Start = cursor
+		cursor = cursor.Add(cleaningDuration(stop.CleaningType, s.schedule))
+		stop.Finish = cursor
+		stop.Late = candidate.deadline != nil && stop.Finish.After(*candidate.deadline)
+		route.Stops = append(route.Stops, stop)
+	}
+	if len(route.Stops) > 0 {
+		route.Finish = &cursor
+	}
+
+	return route, nil
+}
+
+// routeCandidate collects what the route needs to know about an order of
+// the day from..to
+func (s *cleaningOrderService) routeCandidate(ctx context.Context, order *models.CleaningOrder, from, to time.Time) (*routeCandidate, error) {
+	cleaningType := "periodic"
+	if order.CleaningType != nil {
+		cleaningType = *order.CleaningType
+	}
+	candidate := &routeCandidate{
+		stop: models.RouteStop{
+			OrderId:      order.Id,
+			CleaningType: cleaningType,
+		},
+		cleaning: *order.CleaningTs,
+		earliest: from,
+	}
+
+	if order.BookingId == nil {
+		return s.taskRouteCandidate(ctx, order, candidate)
+	}
+
+	booking, err := s.bookingRepo.GetByID(ctx, *order.BookingId)
+	if err != nil {
+		return nil, fmt.Errorf("booking not found: %w", err)
+	}
+	room, err := s.roomRepo.GetByIDWithDeleted(ctx, booking.RoomId)
+	if err != nil {
+		return nil, fmt.Errorf("room not found: %w", err)
+	}
+	candidate.stop.RoomId = &room.Id
+	candidate.stop.Floor = room.Floor
+	candidate.stop.ZoneId = room.ZoneId
+
+	if cleaningType == "general" {
+		candidate.class = routeClassDeparture
+		candidate.stop.Reason = models.RouteStopReasonDeparture
+		candidate.earliest = *order.CleaningTs
+
+		after := *order.CleaningTs
+		if booking.CheckInTs != nil {
+			after = *booking.CheckInTs
+		}
+		next, err := s.bookingRepo.GetNextByRoom(ctx, room.Id, after)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to get next booking: %w", err)
+		}
+		if next != nil && next.CheckInTs != nil && next.CheckInTs.Before(to) {
+			candidate.class = routeClassArrival
+			candidate.stop.Reason = models.RouteStopReasonArrival
+			candidate.stop.NextArrival = next.CheckInTs
+			candidate.deadline = next.CheckInTs
+		}
+		return candidate, nil
+	}
+
+	candidate.class = routeClassStay
+	candidate.stop.Reason = models.RouteStopReasonStay
+	prefs := bookingPreferences(*booking)
+	if prefs.WindowStart == nil || prefs.WindowEnd == nil {
+		return candidate, nil
+	}
+	start, err := parseClock(*prefs.WindowStart)
+	if err != nil {
+		return candidate, nil
+	}
+	end, err := parseClock(*prefs.WindowEnd)
+	if err != nil {
+		return candidate, nil
+	}
+	windowEnd := atClock(from, end)
+	candidate.stop.WindowStart = prefs.WindowStart
+	candidate.stop.WindowEnd = prefs.WindowEnd
+	candidate.earliest = atClock(from, start)
+	candidate.deadline = &windowEnd
+
+	return candidate, nil
+}
+
+// taskRouteCandidate completes the candidate of an order without a booking,
+// a room or an area that is visited like a stay but not before the order is
+// due
+func (s *cleaningOrderService) taskRouteCandidate(ctx context.Context, order *models.CleaningOrder, candidate *routeCandidate) (*routeCandidate, error) {
+	candidate.class = routeClassStay
+	candidate.stop.Reason = models.RouteStopReasonTask
+	candidate.earliest = *order.CleaningTs
+
+	if order.RoomId != nil {
+		room, err := s.roomRepo.GetByIDWithDeleted(ctx, *order.RoomId)
+		if err != nil {
+			return nil, fmt.Errorf("room not found: %w", err)
+		}
+		candidate.stop.RoomId = &room.Id
+		candidate.stop.Floor = room.Floor
+		candidate.stop.ZoneId = room.ZoneId
+		return candidate, nil
+	}
+
+	if order.ZoneId != nil {
+		floor, err := zoneFloor(ctx, s.locationRepo, *order.ZoneId)
+		if err != nil {
+			return nil, err
+		}
+		candidate.stop.Floor = floor.Level
+		candidate.stop.ZoneId = order.ZoneId
+	}
+
+	return candidate, nil
+}
+
+// nextRouteCandidate returns the index of the candidate to visit after
+// cursor on floor. Only candidates that can be started at cursor are
+// considered, the ones that become free first if there are none.
+func nextRouteCandidate(candidates []*routeCandidate, cursor time.Time, floor int, first bool) int {
+	available := candidates[0].earliest
+	for _, candidate := range candidates[1:] {
+		if candidate.earliest.Before(available) {
+			available = candidate.earliest
+		}
+	}
+	if available.Before(cursor) {
+		available = cursor
+	}
+
+	best := -1
+	for i, candidate := range candidates {
+		if candidate.earliest.After(available) {
+			continue
+		}
+		if best < 0 || routeBefore(candidate, candidates[best], floor, first) {
+			best = i
+		}
+	}
+	return best
+}
+
+// routeBefore reports whether a should be visited before b from floor
+func routeBefore(a, b *routeCandidate, floor int, first bool) bool {
+	if a.class != b.class {
+		return a.class < b.class
+	}
+	if a.class == routeClassArrival && !a.deadline.Equal(*b.deadline) {
+		return a.deadline.Before(*b.deadline)
+	}
+	if !first {
+		da, db := floorDistance(a.stop.Floor, floor), floorDistance(b.stop.Floor, floor)
+		if da != db {
+			return da < db
+		}
+	}
+	if (a.deadline == nil) != (b.deadline == nil) {
+		return a.deadline != nil
+	}
+	if a.deadline != nil && !a.deadline.Equal(*b.deadline) {
+		return a.deadline.Before(*b.deadline)
+	}
+	if !a.cleaning.Equal(b.cleaning) {
+		return a.cleaning.Before(b.cleaning)
+	}
+	return a.stop.OrderId < b.stop.OrderId
+}
+
+// floorDistance returns the number of floors between two levels
+func floorDistance(a, b int) int {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
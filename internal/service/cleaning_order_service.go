@@ -2,44 +2,65 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
 )
 
 // CleaningOrderService defines the interface for cleaning order business operations
 type CleaningOrderService interface {
+	RecurrenceRuleService
 	CreateCleaningOrder(ctx context.Context, req *models.CleaningOrderCreateRequest) (*models.CleaningOrder, error)
 	CreateCleaningOrdersForBooking(ctx context.Context, booking models.Booking) ([]models.CleaningOrderCreateRequest, error)
+	CancelCleaningOrdersForBooking(ctx context.Context, booking models.Booking, reason string, departureCleaning bool) (int, *models.CleaningOrder, error)
+	RescheduleCleaningOrdersForDeparture(ctx context.Context, booking models.Booking, departedAt time.Time) (int, *models.CleaningOrder, error)
 	GetCleaningOrder(ctx context.Context, id int) (*models.CleaningOrder, error)
-	GetAllCleaningOrders(ctx context.Context) ([]models.CleaningOrder, error)
-	GetAllCleaningOrdersByCleanerId(ctx context.Context, cleaner_id int) ([]models.CleaningOrder, error)
-	UpdateCleaningOrder(ctx context.Context, id int, req *models.CleaningOrderUpdateRequest) (*models.CleaningOrder, error)
+	GetAllCleaningOrders(ctx context.Context, filter repository.CleaningOrderFilter, sortBy string) ([]models.CleaningOrder, error)
+	GetAllCleaningOrdersByCleanerId(ctx context.Context, cleaner_id int, sortBy string) ([]models.CleaningOrder, error)
+	UpdateCleaningOrder(ctx context.Context, id int, req *models.CleaningOrderUpdateRequest, ifMatch *int) (*models.CleaningOrder, error)
+	PatchCleaningOrder(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.CleaningOrder, error)
+	SetCleaningOrderPriority(ctx context.Context, id int, req *models.CleaningOrderPriorityRequest) (*models.CleaningOrder, error)
 	DeleteCleaningOrder(ctx context.Context, id int) error
 	AssignCleaner(ctx context.Context, orderID int, req *models.CleanerOrderCreateRequest) error
 	RemoveCleaner(ctx context.Context, orderID, cleanerID int) error
+	GetCleaningOrderStats(ctx context.Context) (*CleaningOrderStats, error)
+	GetBoard(ctx context.Context, day *time.Time, byZone bool) ([]models.BoardRow, error)
+	GetCleanerRoute(ctx context.Context, cleanerID int, day *time.Time) (*models.CleanerRoute, error)
+	BulkCleaningOrders(ctx context.Context, req *models.CleaningOrderBulkRequest) (*models.CleaningOrderBulkResponse, error)
+	ApplyServicePreferences(ctx context.Context, booking models.Booking) error
+	SkipCleaningOrderForDnd(ctx context.Context, id int, req *models.DndSkipRequest) (*models.DndSkipResponse, error)
+}
+
+// CleaningOrderStats holds operational counters of cleaning orders
+type CleaningOrderStats struct {
+	// UnassignedToday is the number of not done orders scheduled today without a cleaner
+	UnassignedToday int
+	// Overdue is the number of not done orders scheduled in the past
+	Overdue int
+	// UrgentUnassigned is the number of urgent departure cleanings without a
+	// cleaner whose next check-in is less than the alert lead time away
+	UrgentUnassigned int
 }
 
 // CreateCleaningOrder creates a new cleaning order with validation
 func (s *cleaningOrderService) CreateCleaningOrder(ctx context.Context, req *models.CleaningOrderCreateRequest) (*models.CleaningOrder, error) {
-	// Validate that the booking exists
-	booking, err := s.bookingRepo.GetByID(ctx, req.BookingId)
-	if err != nil {
-		return nil, fmt.Errorf("booking not found: %w", err)
-	}
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.CreateCleaningOrder")
+	defer span.End()
 
-	// Validate cost
-	if req.Cost < 0 {
-		return nil, fmt.Errorf("cost must be non-negative")
-	}
-	if req.Cost == 0 {
-		req.Cost = countOrderCost(*booking, *req.CleaningType)
+	if err := s.prepareCreateRequest(ctx, req); err != nil {
+		return nil, err
 	}
 
 	// Create cleaning order
 	order := &models.CleaningOrder{
 		BookingId:    req.BookingId,
+		RoomId:       req.RoomId,
+		ZoneId:       req.ZoneId,
 		CleaningTs:   &req.CleaningTs,
 		CleaningType: req.CleaningType,
 		Cost:         req.Cost,
@@ -47,56 +68,174 @@ func (s *cleaningOrderService) CreateCleaningOrder(ctx context.Context, req *mod
 		Notes:        req.Notes,
 	}
 
-	err = s.cleaningOrderRepo.Create(ctx, order)
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.cleaningOrderRepo.Create(ctx, order); err != nil {
+			return fmt.Errorf("failed to create cleaning order: %w", err)
+		}
+		if err := s.instantiateChecklists(ctx, order.Id); err != nil {
+			return err
+		}
+		// An order created as done is completed like one marked done later
+		if isDone(order) {
+			return s.completeCleaningOrder(ctx, order)
+		}
+		return nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create cleaning order: %w", err)
+		return nil, err
 	}
 
-	return order, nil
+	slog.InfoContext(ctx, "cleaning order created", "order_id", order.Id)
+
+	return s.reloadCleaningOrder(ctx, order)
+}
+
+// prepareCreateRequest validates a create request and fills in the default
+// cleaning type and cost
+func (s *cleaningOrderService) prepareCreateRequest(ctx context.Context, req *models.CleaningOrderCreateRequest) error {
+	booking, roomID, err := s.orderTarget(ctx, req.BookingId, req.RoomId, req.ZoneId)
+	if err != nil {
+		return err
+	}
+	if booking != nil && booking.CancelledAt != nil {
+		return fmt.Errorf("%w: booking is cancelled", ErrConflict)
+	}
+	if booking != nil {
+		// The room of a booking order is the room of the booking
+		req.RoomId = nil
+	}
+
+	// Validate cost
+	if req.Cost < 0 {
+		return fmt.Errorf("cost must be non-negative")
+	}
+	if req.CleaningType == nil {
+		cleaningType := "periodic"
+		req.CleaningType = &cleaningType
+	}
+	if req.Cost == 0 {
+		var roomType *models.RoomType
+		if roomID != nil {
+			roomType, err = roomTypeOf(ctx, s.roomTypeRepo, *roomID)
+			if err != nil {
+				return err
+			}
+		}
+		req.Cost = countOrderCost(roomType, *req.CleaningType, s.schedule)
+	}
+
+	return nil
+}
+
+// orderTarget validates what an order cleans: the room of a booking, or
+// without a booking a room or a zone. It returns the booking, if any, and
+// the room cleaned, nil for a zone. The room is ignored if a booking is set.
+func (s *cleaningOrderService) orderTarget(ctx context.Context, bookingID, roomID, zoneID *int) (*models.Booking, *int, error) {
+	switch {
+	case bookingID != nil:
+		if zoneID != nil {
+			return nil, nil, fmt.Errorf("zone_id cannot be set together with booking_id")
+		}
+		booking, err := s.bookingRepo.GetByID(ctx, *bookingID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("booking not found: %w", err)
+		}
+		return booking, &booking.RoomId, nil
+
+	case roomID != nil:
+		if zoneID != nil {
+			return nil, nil, fmt.Errorf("only one of room_id and zone_id can be set")
+		}
+		if _, err := s.roomRepo.GetByID(ctx, *roomID); err != nil {
+			return nil, nil, fmt.Errorf("room not found: %w", err)
+		}
+		return nil, roomID, nil
+
+	case zoneID != nil:
+		if _, err := zoneFloor(ctx, s.locationRepo, *zoneID); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, nil
+	}
+
+	return nil, nil, fmt.Errorf("one of booking_id, room_id and zone_id is required")
 }
 
 // GetCleaningOrder retrieves a cleaning order by ID
 func (s *cleaningOrderService) GetCleaningOrder(ctx context.Context, id int) (*models.CleaningOrder, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.GetCleaningOrder")
+	defer span.End()
+
 	order, err := s.cleaningOrderRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("cleaning order not found: %w", err)
 	}
+	s.prioritize(order)
 
 	return order, nil
 }
 
-// GetAllCleaningOrders retrieves all cleaning orders
-func (s *cleaningOrderService) GetAllCleaningOrders(ctx context.Context) ([]models.CleaningOrder, error) {
-	orders, err := s.cleaningOrderRepo.GetAll(ctx)
+// GetAllCleaningOrders retrieves the cleaning orders matching the filter,
+// sorted by sortBy
+func (s *cleaningOrderService) GetAllCleaningOrders(ctx context.Context, filter repository.CleaningOrderFilter, sortBy string) ([]models.CleaningOrder, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.GetAllCleaningOrders")
+	defer span.End()
+
+	orders, err := s.cleaningOrderRepo.GetAll(ctx, filter)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cleaning orders: %w", err)
 	}
+	for i := range orders {
+		s.prioritize(&orders[i])
+	}
+	if err := sortCleaningOrders(orders, sortBy); err != nil {
+		return nil, err
+	}
 
 	return orders, nil
 }
 
-// GetAllCleaningOrders retrieves all cleaning orders assigned to a cleaner
-func (s *cleaningOrderService) GetAllCleaningOrdersByCleanerId(ctx context.Context, cleaner_id int) ([]models.CleaningOrder, error) {
+// GetAllCleaningOrders retrieves all cleaning orders assigned to a cleaner,
+// sorted by sortBy
+func (s *cleaningOrderService) GetAllCleaningOrdersByCleanerId(ctx context.Context, cleaner_id int, sortBy string) ([]models.CleaningOrder, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.GetAllCleaningOrdersByCleanerId")
+	defer span.End()
+
 	orders, err := s.cleaningOrderRepo.GetAllByCleanerId(ctx, cleaner_id)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cleaning orders: %w", err)
 	}
+	for i := range orders {
+		s.prioritize(&orders[i])
+		// Cleaners see the priority but not whether the guest is a VIP
+		orders[i].Vip = nil
+	}
+	if err := sortCleaningOrders(orders, sortBy); err != nil {
+		return nil, err
+	}
 
 	return orders, nil
 }
 
 // UpdateCleaningOrder updates an existing cleaning order
-func (s *cleaningOrderService) UpdateCleaningOrder(ctx context.Context, id int, req *models.CleaningOrderUpdateRequest) (*models.CleaningOrder, error) {
+func (s *cleaningOrderService) UpdateCleaningOrder(ctx context.Context, id int, req *models.CleaningOrderUpdateRequest, ifMatch *int) (*models.CleaningOrder, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.UpdateCleaningOrder")
+	defer span.End()
+
 	// Check if cleaning order exists
 	existingOrder, err := s.cleaningOrderRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("cleaning order not found: %w", err)
 	}
+	if err := checkVersion(ifMatch, existingOrder.Version); err != nil {
+		return nil, err
+	}
+	if existingOrder.CancelledAt != nil {
+		return nil, fmt.Errorf("%w: cleaning order is cancelled", ErrConflict)
+	}
 
-	// Validate that the booking exists
-	_, err = s.bookingRepo.GetByID(ctx, req.BookingId)
-	if err != nil {
-		return nil, fmt.Errorf("booking not found: %w", err)
+	if _, _, err := s.orderTarget(ctx, req.BookingId, req.RoomId, req.ZoneId); err != nil {
+		return nil, err
 	}
 
 	// Validate cost
@@ -105,23 +244,228 @@ func (s *cleaningOrderService) UpdateCleaningOrder(ctx context.Context, id int,
 	}
 
 	// Update cleaning order
+	wasDone := isDone(existingOrder)
 	existingOrder.BookingId = req.BookingId
+	existingOrder.RoomId = req.RoomId
+	existingOrder.ZoneId = req.ZoneId
 	existingOrder.CleaningTs = &req.CleaningTs
 	existingOrder.CleaningType = req.CleaningType
 	existingOrder.Cost = req.Cost
-	existingOrder.Done = req.Done
+	// An omitted done keeps the stored value
+	if req.Done != nil {
+		existingOrder.Done = req.Done
+	}
 	existingOrder.Notes = req.Notes
 
-	err = s.cleaningOrderRepo.Update(ctx, existingOrder)
+	err = s.saveCleaningOrder(ctx, existingOrder, wasDone)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "cleaning order updated", "order_id", existingOrder.Id)
+
+	return s.reloadCleaningOrder(ctx, existingOrder)
+}
+
+// PatchCleaningOrder applies a JSON merge patch to an existing cleaning order
+func (s *cleaningOrderService) PatchCleaningOrder(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.CleaningOrder, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.PatchCleaningOrder")
+	defer span.End()
+
+	existingOrder, err := s.cleaningOrderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("cleaning order not found: %w", err)
+	}
+	if err := checkVersion(ifMatch, existingOrder.Version); err != nil {
+		return nil, err
+	}
+	if existingOrder.CancelledAt != nil {
+		return nil, fmt.Errorf("%w: cleaning order is cancelled", ErrConflict)
+	}
+
+	order, err := applyMergePatch(*existingOrder, patch, "cost", "cleaning_ts")
+	if err != nil {
+		return nil, err
+	}
+	order.Id, order.Version, order.UpdatedAt = existingOrder.Id, existingOrder.Version, existingOrder.UpdatedAt
+	order.CancelledAt, order.CancelReason = existingOrder.CancelledAt, existingOrder.CancelReason
+	order.PriorityOverride, order.DoneAt = existingOrder.PriorityOverride, existingOrder.DoneAt
+	order.RuleId = existingOrder.RuleId
+
+	// Validate the target if it changed
+	if !sameID(order.BookingId, existingOrder.BookingId) || !sameID(order.RoomId, existingOrder.RoomId) ||
+		!sameID(order.ZoneId, existingOrder.ZoneId) {
+		if _, _, err := s.orderTarget(ctx, order.BookingId, order.RoomId, order.ZoneId); err != nil {
+			return nil, err
+		}
+	}
+
+	// Validate cost
+	if order.Cost < 0 {
+		return nil, fmt.Errorf("cost must be non-negative")
+	}
+
+	err = s.saveCleaningOrder(ctx, &order, isDone(existingOrder))
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "cleaning order patched", "order_id", order.Id)
+
+	return s.reloadCleaningOrder(ctx, &order)
+}
+
+// reloadCleaningOrder reads a saved order back together with the next
+// arrival and VIP flag its priority depends on, the saved order if it
+// cannot be read
+func (s *cleaningOrderService) reloadCleaningOrder(ctx context.Context, order *models.CleaningOrder) (*models.CleaningOrder, error) {
+	reloaded, err := s.cleaningOrderRepo.GetByID(ctx, order.Id)
+	if err != nil {
+		s.prioritize(order)
+		return order, nil
+	}
+	s.prioritize(reloaded)
+	return reloaded, nil
+}
+
+// saveCleaningOrder updates an order and, if it has just been done, applies
+// the effects of its completion in the same transaction
+func (s *cleaningOrderService) saveCleaningOrder(ctx context.Context, order *models.CleaningOrder, wasDone bool) error {
+	return s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.cleaningOrderRepo.Update(ctx, order); err != nil {
+			return updateError("cleaning order", err)
+		}
+		if !wasDone && isDone(order) {
+			return s.completeCleaningOrder(ctx, order)
+		}
+		return nil
+	})
+}
+
+// instantiateChecklists copies the checklist templates of the cleaning types
+// of new orders to the orders
+func (s *cleaningOrderService) instantiateChecklists(ctx context.Context, orderIDs ...int) error {
+	for _, id := range orderIDs {
+		if err := s.checklistRepo.Instantiate(ctx, id); err != nil {
+			return fmt.Errorf("failed to create checklist of order %d: %w", id, err)
+		}
+	}
+	return nil
+}
+
+// completeCleaningOrder applies the effects of a done order: the default
+// consumption of its cleaning type is recorded unless some consumption has
+// been, and a done general cleaning makes the room clean. An order with
+// unchecked required checklist items cannot be completed.
+func (s *cleaningOrderService) completeCleaningOrder(ctx context.Context, order *models.CleaningOrder) error {
+	unchecked, err := s.checklistRepo.CountRequiredUnchecked(ctx, order.Id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update cleaning order: %w", err)
+		return fmt.Errorf("failed to check checklist: %w", err)
+	}
+	if unchecked > 0 {
+		return fmt.Errorf("%w: %d required checklist items are not checked", ErrConflict, unchecked)
 	}
 
-	return existingOrder, nil
+	if err := s.consumeDefaults(ctx, order); err != nil {
+		return err
+	}
+
+	if order.CleaningType == nil || *order.CleaningType != "general" {
+		return nil
+	}
+
+	roomID, err := s.orderRoom(ctx, order)
+	if err != nil {
+		return err
+	}
+	// A zone has no room status
+	if roomID == nil {
+		return nil
+	}
+
+	err = s.roomRepo.SetStatus(ctx, *roomID, models.RoomStatusClean)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The room has been deleted
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set room status: %w", err)
+	}
+
+	slog.InfoContext(ctx, "room cleaned", "room_id", *roomID, "order_id", order.Id)
+
+	return nil
+}
+
+// orderRoom returns the room an order cleans, the room of its booking if it
+// has one, nil for a zone
+func (s *cleaningOrderService) orderRoom(ctx context.Context, order *models.CleaningOrder) (*int, error) {
+	if order.BookingId == nil {
+		return order.RoomId, nil
+	}
+	booking, err := s.bookingRepo.GetByIDWithDeleted(ctx, *order.BookingId)
+	if err != nil {
+		return nil, fmt.Errorf("booking not found: %w", err)
+	}
+	return &booking.RoomId, nil
+}
+
+// consumeDefaults records the default consumption of the cleaning type of a
+// completed order, taken from the default locations of the items
+func (s *cleaningOrderService) consumeDefaults(ctx context.Context, order *models.CleaningOrder) error {
+	if order.CleaningType == nil {
+		return nil
+	}
+	recorded, err := s.inventoryRepo.CountOrderConsumption(ctx, order.Id)
+	if err != nil {
+		return fmt.Errorf("failed to get consumption: %w", err)
+	}
+	if recorded > 0 {
+		return nil
+	}
+
+	defaults, err := s.inventoryRepo.GetDefaults(ctx, order.CleaningType)
+	if err != nil {
+		return fmt.Errorf("failed to get default consumption: %w", err)
+	}
+	for _, def := range defaults {
+		item, err := s.inventoryRepo.GetItemByID(ctx, def.ItemId)
+		if err != nil {
+			return fmt.Errorf("inventory item not found: %w", err)
+		}
+		movement := &models.StockMovement{
+			ItemId:   item.Id,
+			Location: item.DefaultLocation,
+			Quantity: -def.Quantity,
+			Kind:     models.StockMovementKindConsumption,
+			OrderId:  &order.Id,
+		}
+		if err := addStockMovement(ctx, s.inventoryRepo, item, movement); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sameID reports whether two optional ids are equal
+func sameID(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// isDone reports whether an order is done
+func isDone(order *models.CleaningOrder) bool {
+	return order.Done != nil && *order.Done
 }
 
 // DeleteCleaningOrder deletes a cleaning order by ID
 func (s *cleaningOrderService) DeleteCleaningOrder(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.DeleteCleaningOrder")
+	defer span.End()
+
 	// Check if cleaning order exists
 	_, err := s.cleaningOrderRepo.GetByID(ctx, id)
 	if err != nil {
@@ -133,16 +477,24 @@ func (s *cleaningOrderService) DeleteCleaningOrder(ctx context.Context, id int)
 		return fmt.Errorf("failed to delete cleaning order: %w", err)
 	}
 
+	slog.InfoContext(ctx, "cleaning order deleted", "order_id", id)
+
 	return nil
 }
 
 // AssignCleaner assigns a cleaner to a cleaning order
 func (s *cleaningOrderService) AssignCleaner(ctx context.Context, orderID int, req *models.CleanerOrderCreateRequest) error {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.AssignCleaner")
+	defer span.End()
+
 	// Validate that the cleaning order exists
-	_, err := s.cleaningOrderRepo.GetByID(ctx, orderID)
+	order, err := s.cleaningOrderRepo.GetByID(ctx, orderID)
 	if err != nil {
 		return fmt.Errorf("cleaning order not found: %w", err)
 	}
+	if order.CancelledAt != nil {
+		return fmt.Errorf("%w: cleaning order is cancelled", ErrConflict)
+	}
 
 	// Validate that the cleaner exists
 	_, err = s.cleanerRepo.GetByID(ctx, req.CleanerId)
@@ -155,11 +507,16 @@ func (s *cleaningOrderService) AssignCleaner(ctx context.Context, orderID int, r
 		return fmt.Errorf("failed to assign cleaner: %w", err)
 	}
 
+	slog.InfoContext(ctx, "cleaner assigned", "order_id", orderID, "cleaner_id", req.CleanerId)
+
 	return nil
 }
 
 // RemoveCleaner removes a cleaner from a cleaning order
 func (s *cleaningOrderService) RemoveCleaner(ctx context.Context, orderID, cleanerID int) error {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.RemoveCleaner")
+	defer span.End()
+
 	// Validate that the cleaning order exists
 	_, err := s.cleaningOrderRepo.GetByID(ctx, orderID)
 	if err != nil {
@@ -177,63 +534,276 @@ func (s *cleaningOrderService) RemoveCleaner(ctx context.Context, orderID, clean
 		return fmt.Errorf("failed to remove cleaner: %w", err)
 	}
 
+	slog.InfoContext(ctx, "cleaner removed", "order_id", orderID, "cleaner_id", cleanerID)
+
 	return nil
 }
 
+// GetCleaningOrderStats counts unassigned orders of the current hotel day,
+// overdue orders and urgent departure cleanings nobody has been assigned to
+// shortly before the next check-in
+func (s *cleaningOrderService) GetCleaningOrderStats(ctx context.Context) (*CleaningOrderStats, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.GetCleaningOrderStats")
+	defer span.End()
+
+	now := time.Now()
+	today := localDate(now, s.schedule.Location)
+
+	unassigned, err := s.cleaningOrderRepo.CountUnassigned(ctx, today, today.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count unassigned cleaning orders: %w", err)
+	}
+
+	overdue, err := s.cleaningOrderRepo.CountOverdue(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count overdue cleaning orders: %w", err)
+	}
+
+	departures, err := s.cleaningOrderRepo.GetUnassignedDepartures(ctx, now, now.Add(s.schedule.UrgentAlertLead))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unassigned departure cleanings: %w", err)
+	}
+	urgent := 0
+	for i := range departures {
+		if orderPriority(&departures[i], s.schedule.Location) == models.CleaningOrderPriorityUrgent {
+			urgent++
+		}
+	}
+
+	return &CleaningOrderStats{
+		UnassignedToday:  unassigned,
+		Overdue:          overdue,
+		UrgentUnassigned: urgent,
+	}, nil
+}
+
+// GetBoard counts the cleaning orders of a day per zone, or per floor if
+// byZone is not set. The day is taken in the hotel time zone, today if nil.
+func (s *cleaningOrderService) GetBoard(ctx context.Context, day *time.Time, byZone bool) ([]models.BoardRow, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.GetBoard")
+	defer span.End()
+
+	from := localDate(time.Now(), s.schedule.Location)
+	if day != nil {
+		from = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, from.Location())
+	}
+
+	board, err := s.cleaningOrderRepo.Board(ctx, from, from.AddDate(0, 0, 1), byZone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get board: %w", err)
+	}
+
+	return board, nil
+}
+
 func (s *cleaningOrderService) CreateCleaningOrdersForBooking(ctx context.Context, booking models.Booking) ([]models.CleaningOrderCreateRequest, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.CreateCleaningOrdersForBooking")
+	defer span.End()
+
 	// Validate that the booking exists
 	if _, err := s.bookingRepo.GetByID(ctx, booking.Id); err != nil {
 		return nil, fmt.Errorf("booking not found: %w", err)
 	}
 
-	orders_queue, err := collectOrdersQueue(booking)
+	roomType, err := roomTypeOf(ctx, s.roomTypeRepo, booking.RoomId)
+	if err != nil {
+		return nil, err
+	}
+	orders_queue, err := collectOrdersQueue(booking, roomType, s.schedule)
 	if err != nil {
 		return nil, fmt.Errorf("failed to collect queue for cleaning orders: %w", err)
 	}
-	_, err = s.cleaningOrderRepo.CreateMany(ctx, orders_queue)
-
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		ids, err := s.cleaningOrderRepo.CreateMany(ctx, orders_queue)
+		if err != nil {
+			return fmt.Errorf("failed to create cleaning orders: %w", err)
+		}
+		return s.instantiateChecklists(ctx, ids...)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to create cleaning orders: %w", err)
+		return nil, err
 	}
 
+	slog.InfoContext(ctx, "cleaning orders scheduled", "booking_id", booking.Id, "count", len(orders_queue))
+
 	return orders_queue, nil
 }
 
-func collectOrdersQueue(booking models.Booking) ([]models.CleaningOrderCreateRequest, error) {
+// CancelCleaningOrdersForBooking cancels the not done orders of a booking
+// scheduled from the start of the current hotel day on, so that overdue
+// orders of today are cancelled too. If departureCleaning is set and the guest is in the
+// room a general cleaning is scheduled after departure, i.e. now.
+func (s *cleaningOrderService) CancelCleaningOrdersForBooking(ctx context.Context, booking models.Booking, reason string, departureCleaning bool) (int, *models.CleaningOrder, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.CancelCleaningOrdersForBooking")
+	defer span.End()
 
-	baseCleaningTime := 13 * time.Hour
+	now := time.Now()
+	cancelled, err := s.cleaningOrderRepo.CancelByBooking(ctx, booking.Id, localDate(now, s.schedule.Location), "", reason)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to cancel cleaning orders: %w", err)
+	}
+
+	slog.InfoContext(ctx, "cleaning orders cancelled", "booking_id", booking.Id, "count", cancelled)
+
+	if !departureCleaning || !guestInRoom(booking, now) {
+		return int(cancelled), nil, nil
+	}
+
+	order, err := s.createDepartureCleaning(ctx, booking, now)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return int(cancelled), order, nil
+}
+
+// RescheduleCleaningOrdersForDeparture cancels the periodic cleanings of a
+// booking after the actual departure and moves its general cleaning to the
+// departure time plus the general cleaning delay
+func (s *cleaningOrderService) RescheduleCleaningOrdersForDeparture(ctx context.Context, booking models.Booking, departedAt time.Time) (int, *models.CleaningOrder, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.RescheduleCleaningOrdersForDeparture")
+	defer span.End()
+
+	cancelled, err := s.cleaningOrderRepo.CancelByBooking(ctx, booking.Id, departedAt, "periodic", "guest checked out")
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to cancel cleaning orders: %w", err)
+	}
+
+	cleaningTs := departedAt.Add(s.schedule.GeneralCleaningDelay)
+	ids, err := s.cleaningOrderRepo.RescheduleByBooking(ctx, booking.Id, "general", cleaningTs)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to reschedule general cleaning: %w", err)
+	}
+
+	slog.InfoContext(ctx, "cleaning orders rescheduled for departure", "booking_id", booking.Id,
+		"cancelled", cancelled, "rescheduled", len(ids))
+
+	// No pending general cleaning left, e.g. it was cancelled by hand
+	if len(ids) == 0 {
+		order, err := s.createDepartureCleaning(ctx, booking, departedAt)
+		if err != nil {
+			return 0, nil, err
+		}
+		return int(cancelled), order, nil
+	}
+
+	order, err := s.cleaningOrderRepo.GetByID(ctx, ids[0])
+	if err != nil {
+		return 0, nil, fmt.Errorf("cleaning order not found: %w", err)
+	}
+
+	return int(cancelled), order, nil
+}
+
+// createDepartureCleaning schedules a general cleaning after the guest left
+func (s *cleaningOrderService) createDepartureCleaning(ctx context.Context, booking models.Booking, departedAt time.Time) (*models.CleaningOrder, error) {
+	roomType, err := roomTypeOf(ctx, s.roomTypeRepo, booking.RoomId)
+	if err != nil {
+		return nil, err
+	}
+	cleaningType := "general"
+	cleaningTs := departedAt.Add(s.schedule.GeneralCleaningDelay).UTC()
+	order := &models.CleaningOrder{
+		BookingId:    &booking.Id,
+		CleaningTs:   &cleaningTs,
+		CleaningType: &cleaningType,
+		Cost:         countOrderCost(roomType, cleaningType, s.schedule),
+	}
+	if err := s.cleaningOrderRepo.Create(ctx, order); err != nil {
+		return nil, fmt.Errorf("failed to create departure cleaning order: %w", err)
+	}
+	if err := s.instantiateChecklists(ctx, order.Id); err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "departure cleaning scheduled", "order_id", order.Id, "booking_id", booking.Id)
+
+	return order, nil
+}
+
+// guestInRoom reports whether the guest of a booking is in the room at t.
+// Recorded check-in and check-out events take precedence over planned dates.
+func guestInRoom(booking models.Booking, t time.Time) bool {
+	if booking.CheckedOutAt != nil {
+		return false
+	}
+	if booking.CheckedInAt != nil {
+		return true
+	}
+	return booking.CheckInTs != nil && !booking.CheckInTs.After(t)
+}
+
+func collectOrdersQueue(booking models.Booking, roomType *models.RoomType, schedule Schedule) ([]models.CleaningOrderCreateRequest, error) {
+	if booking.CheckInTs == nil || booking.CheckOutTs == nil {
+		return nil, fmt.Errorf("booking has no check-in or check-out date")
+	}
 
 	orders_queue := []models.CleaningOrderCreateRequest{}
 
-	checkInDate := booking.CheckInTs.Truncate(24 * time.Hour)
-	checkOutDate := booking.CheckOutTs.Truncate(24 * time.Hour).Add(-24 * time.Hour)
+	checkInDate := localDate(*booking.CheckInTs, schedule.Location)
+	checkOutDate := localDate(*booking.CheckOutTs, schedule.Location).AddDate(0, 0, -1)
 
-	for date := checkInDate; date.Before(checkOutDate); date = date.Add(24 * time.Hour) {
+	prefs := bookingPreferences(booking)
+	periodicTime := periodicCleaningTime(prefs, schedule)
+	for date, day := checkInDate, 0; date.Before(checkOutDate); date, day = date.AddDate(0, 0, 1), day+1 {
+		if !wantsPeriodicCleaning(prefs, date, day) {
+			continue
+		}
 		cleaningType := "periodic"
 		orders_queue = append(orders_queue, models.CleaningOrderCreateRequest{
-			BookingId:    booking.Id,
-			CleaningTs:   date.Add(baseCleaningTime),
+			BookingId:    &booking.Id,
+			CleaningTs:   atClock(date, periodicTime).UTC(),
 			CleaningType: &cleaningType,
-			Cost:         countOrderCost(booking, cleaningType),
+			Cost:         countOrderCost(roomType, cleaningType, schedule),
 		})
 	}
 	cleaningType := "general"
 	orders_queue = append(orders_queue, models.CleaningOrderCreateRequest{
-		BookingId:    booking.Id,
-		CleaningTs:   booking.CheckOutTs.Add(1 * time.Hour),
+		BookingId:    &booking.Id,
+		CleaningTs:   booking.CheckOutTs.Add(schedule.GeneralCleaningDelay).UTC(),
 		CleaningType: &cleaningType,
-		Cost:         countOrderCost(booking, "general"),
+		Cost:         countOrderCost(roomType, "general", schedule),
 	})
 
 	return orders_queue, nil
 }
 
-// countOrderCost count cost of one order
-// TODO: add internal logic & table "cleaning_type"
-func countOrderCost(booking models.Booking, cleaningType string) int {
-	cost := 100
+// localDate returns the midnight of the day of t in the hotel time zone
+func localDate(t time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	y, m, d := t.In(loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
+
+// atClock returns the time offset past midnight by the clock on the day of
+// date. Unlike date.Add(offset) it is not shifted on daylight saving days.
+func atClock(date time.Time, offset time.Duration) time.Time {
+	y, m, d := date.Date()
+	return time.Date(y, m, d, 0, 0, 0, int(offset), date.Location())
+}
+
+// cleaningDuration returns the estimated duration of a cleaning
+func cleaningDuration(cleaningType string, schedule Schedule) time.Duration {
+	if cleaningType == "general" {
+		return schedule.GeneralDuration
+	}
+	return schedule.PeriodicDuration
+}
+
+// countOrderCost count cost of one order, the cost of the room type if it
+// has one, otherwise the configured default. roomType may be nil.
+// TODO: add table "cleaning_type"
+func countOrderCost(roomType *models.RoomType, cleaningType string, schedule Schedule) int {
 	if cleaningType == "general" {
-		cost *= 2
+		if roomType != nil && roomType.GeneralCost != nil {
+			return *roomType.GeneralCost
+		}
+		return schedule.GeneralCost
+	}
+	if roomType != nil && roomType.PeriodicCost != nil {
+		return *roomType.PeriodicCost
 	}
-	return cost
+	return schedule.PeriodicCost
 }
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+func TestCollectOrdersQueue(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone data not available: %v", err)
+	}
+	utc := func(year int, month time.Month, day, hour int) time.Time {
+		return time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		loc      *time.Location
+		checkIn  time.Time
+		checkOut time.Time
+		want     []time.Time
+	}{
+		{
+			name:     "utc",
+			loc:      time.UTC,
+			checkIn:  utc(2025, time.January, 10, 15),
+			checkOut: utc(2025, time.January, 13, 11),
+			want: []time.Time{
+				utc(2025, time.January, 10, 13), utc(2025, time.January, 11, 13), utc(2025, time.January, 13, 12),
+			},
+		},
+		{
+			name: "days counted in the hotel zone",
+			loc:  berlin,
+			// 00:30 in Berlin is still January 9 in UTC
+			checkIn:  time.Date(2025, time.January, 10, 0, 30, 0, 0, berlin),
+			checkOut: time.Date(2025, time.January, 12, 11, 0, 0, 0, berlin),
+			// 13:00 CET is 12:00 UTC
+			want: []time.Time{utc(2025, time.January, 10, 12), utc(2025, time.January, 12, 11)},
+		},
+		{
+			name:     "local time kept across daylight saving time",
+			loc:      berlin,
+			checkIn:  time.Date(2025, time.March, 29, 15, 0, 0, 0, berlin),
+			checkOut: time.Date(2025, time.April, 1, 11, 0, 0, 0, berlin),
+			// 13:00 CEST from March 30 is 11:00 UTC
+			want: []time.Time{utc(2025, time.March, 29, 12), utc(2025, time.March, 30, 11), utc(2025, time.April, 1, 10)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := DefaultSchedule()
+			schedule.Location = tt.loc
+			booking := models.Booking{Id: 1, CheckInTs: &tt.checkIn, CheckOutTs: &tt.checkOut}
+
+			orders, err := collectOrdersQueue(booking, nil, schedule)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(orders) != len(tt.want) {
+				t.Fatalf("got %d orders, want %d", len(orders), len(tt.want))
+			}
+			for i, order := range orders {
+				if order.CleaningTs.Location() != time.UTC || !order.CleaningTs.Equal(tt.want[i]) {
+					t.Errorf("order %d at %v, want %v", i, order.CleaningTs, tt.want[i])
+				}
+			}
+			if got := *orders[len(orders)-1].CleaningType; got != "general" {
+				t.Errorf("last order is %s, want general", got)
+			}
+		})
+	}
+}
+
+func TestPatchCleaningOrderCancelled(t *testing.T) {
+	cancelledAt := time.Date(2025, time.January, 10, 9, 0, 0, 0, time.UTC)
+	cleaningTs := time.Date(2025, time.January, 10, 13, 0, 0, 0, time.UTC)
+	s := &cleaningOrderService{cleaningOrderRepo: &fakeCleaningOrderRepo{orders: map[int]*models.CleaningOrder{
+		1: {Id: 1, CleaningTs: &cleaningTs, Cost: 100, CancelledAt: &cancelledAt},
+	}}}
+
+	_, err := s.PatchCleaningOrder(context.Background(), 1, []byte(`{"cost": 150}`), nil)
+	if !errors.Is(err, ErrConflict) {
+		t.Errorf("PatchCleaningOrder() error = %v, want %v", err, ErrConflict)
+	}
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/StEvseeva/cleany/internal/repository"
+)
+
+// ErrPreconditionFailed is returned when a change is based on a stale
+// version of a resource
+var ErrPreconditionFailed = errors.New("resource has been modified")
+
+// ErrConflict is returned when a change is not allowed in the current state
+// of a resource, e.g. updating a cancelled booking
+var ErrConflict = errors.New("conflict")
+
+// ErrTooLarge is returned when an uploaded file exceeds the size limit
+var ErrTooLarge = errors.New("file too large")
+
+// ErrUnsupportedType is returned when an uploaded file is of a media type
+// that is not allowed
+var ErrUnsupportedType = errors.New("unsupported media type")
+
+// checkVersion fails with ErrPreconditionFailed when an expected version is
+// given and differs from the current one
+func checkVersion(expected, current *int) error {
+	if expected == nil || current == nil {
+		return nil
+	}
+	if *expected != *current {
+		return fmt.Errorf("%w: expected version %d, current version is %d", ErrPreconditionFailed, *expected, *current)
+	}
+	return nil
+}
+
+// updateError wraps an error returned by a repository update
+func updateError(entity string, err error) error {
+	if errors.Is(err, repository.ErrVersionConflict) {
+		return fmt.Errorf("%w: %s was modified concurrently", ErrPreconditionFailed, entity)
+	}
+	return fmt.Errorf("failed to update %s: %w", entity, err)
+}
@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
+)
+
+// The fakes embed the repository interfaces and implement only the methods
+// the tests use, calling any other method panics
+
+// fakeTransactor runs the functions without a transaction
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (fakeTransactor) WithinSavepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// fakeChecklistRepo creates no checklists
+type fakeChecklistRepo struct {
+	repository.ChecklistRepository
+}
+
+func (fakeChecklistRepo) Instantiate(context.Context, int) error { return nil }
+
+// fakeRuleRepo records up to when the orders of the rules are generated
+type fakeRuleRepo struct {
+	repository.RecurrenceRuleRepository
+	generatedUntil map[int]time.Time
+}
+
+func (r *fakeRuleRepo) SetGeneratedUntil(_ context.Context, id int, until *time.Time) error {
+	if r.generatedUntil == nil {
+		r.generatedUntil = map[int]time.Time{}
+	}
+	r.generatedUntil[id] = *until
+	return nil
+}
+
+// fakeCleaningOrderRepo holds orders by ID and records the orders created
+// from recurrence rules
+type fakeCleaningOrderRepo struct {
+	repository.CleaningOrderRepository
+	orders     map[int]*models.CleaningOrder
+	ruleOrders []time.Time
+}
+
+func (r *fakeCleaningOrderRepo) Create(_ context.Context, order *models.CleaningOrder) error {
+	if r.orders == nil {
+		r.orders = map[int]*models.CleaningOrder{}
+	}
+	order.Id = len(r.orders) + 1
+	for r.orders[order.Id] != nil {
+		order.Id++
+	}
+	copied := *order
+	r.orders[order.Id] = &copied
+	return nil
+}
+
+// pending returns the IDs of the not done, not cancelled orders of a
+// booking in ascending order
+func (r *fakeCleaningOrderRepo) pending(bookingID int) []int {
+	var ids []int
+	for id, order := range r.orders {
+		if order.BookingId != nil && *order.BookingId == bookingID &&
+			(order.Done == nil || !*order.Done) && order.CancelledAt == nil {
+			ids = append(ids, id)
+		}
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+func (r *fakeCleaningOrderRepo) CancelByBooking(_ context.Context, bookingID int, from time.Time, cleaningType, reason string) (int64, error) {
+	var cancelled int64
+	now := time.Now()
+	for _, id := range r.pending(bookingID) {
+		order := r.orders[id]
+		if order.CleaningTs.Before(from) || (cleaningType != "" && *order.CleaningType != cleaningType) {
+			continue
+		}
+		order.CancelledAt = &now
+		order.CancelReason = &reason
+		cancelled++
+	}
+	return cancelled, nil
+}
+
+func (r *fakeCleaningOrderRepo) RescheduleByBooking(_ context.Context, bookingID int, cleaningType string, cleaningTs time.Time) ([]int, error) {
+	var ids []int
+	for _, id := range r.pending(bookingID) {
+		order := r.orders[id]
+		if *order.CleaningType != cleaningType {
+			continue
+		}
+		order.CleaningTs = &cleaningTs
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (r *fakeCleaningOrderRepo) GetByID(_ context.Context, id int) (*models.CleaningOrder, error) {
+	order, ok := r.orders[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	copied := *order
+	return &copied, nil
+}
+
+func (r *fakeCleaningOrderRepo) CreateFromRule(_ context.Context, _ *models.RecurrenceRule, cleaningTs time.Time, _ int) (int, error) {
+	r.ruleOrders = append(r.ruleOrders, cleaningTs)
+	return len(r.ruleOrders), nil
+}
+
+// fakeCleanerRepo holds cleaners by ID
+type fakeCleanerRepo struct {
+	repository.CleanerRepository
+	cleaners map[int]*models.Cleaner
+}
+
+func (r *fakeCleanerRepo) GetByID(_ context.Context, id int) (*models.Cleaner, error) {
+	cleaner, ok := r.cleaners[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	copied := *cleaner
+	return &copied, nil
+}
+
+// fakeBookingRepo holds bookings by ID and records the restored ones
+type fakeBookingRepo struct {
+	repository.BookingRepository
+	bookings map[int]*models.Booking
+	restored []int
+}
+
+func (r *fakeBookingRepo) GetByID(_ context.Context, id int) (*models.Booking, error) {
+	booking, ok := r.bookings[id]
+	if !ok || booking.DeletedAt != nil {
+		return nil, sql.ErrNoRows
+	}
+	copied := *booking
+	return &copied, nil
+}
+
+func (r *fakeBookingRepo) GetByIDWithDeleted(_ context.Context, id int) (*models.Booking, error) {
+	booking, ok := r.bookings[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	copied := *booking
+	return &copied, nil
+}
+
+func (r *fakeBookingRepo) Cancel(_ context.Context, booking *models.Booking, reason string) error {
+	now := time.Now()
+	booking.CancelledAt = &now
+	booking.CancelReason = &reason
+	copied := *booking
+	r.bookings[booking.Id] = &copied
+	return nil
+}
+
+func (r *fakeBookingRepo) CheckOut(_ context.Context, booking *models.Booking, at time.Time) error {
+	booking.CheckedOutAt = &at
+	copied := *booking
+	r.bookings[booking.Id] = &copied
+	return nil
+}
+
+func (r *fakeBookingRepo) Restore(_ context.Context, id int) error {
+	booking, ok := r.bookings[id]
+	if !ok || booking.DeletedAt == nil {
+		return sql.ErrNoRows
+	}
+	booking.DeletedAt = nil
+	r.restored = append(r.restored, id)
+	return nil
+}
+
+// fakeRoomRepo holds rooms by ID
+type fakeRoomRepo struct {
+	repository.RoomRepository
+	rooms map[int]*models.Room
+}
+
+func (r *fakeRoomRepo) GetByID(_ context.Context, id int) (*models.Room, error) {
+	room, ok := r.rooms[id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	copied := *room
+	return &copied, nil
+}
+
+func (r *fakeRoomRepo) SetStatus(_ context.Context, id int, status models.RoomStatus) error {
+	room, ok := r.rooms[id]
+	if !ok {
+		return sql.ErrNoRows
+	}
+	room.Status = &status
+	return nil
+}
+
+// fakeRoomBlockRepo reports the rooms out of order at any time
+type fakeRoomBlockRepo struct {
+	repository.RoomBlockRepository
+	outOfOrder map[int]bool
+}
+
+func (r *fakeRoomBlockRepo) CountOverlapping(_ context.Context, roomID int, kind models.RoomBlockKind, _, _ time.Time) (int, error) {
+	if kind == models.RoomBlockKindOutOfOrder && r.outOfOrder[roomID] {
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// fakeRoomTypeRepo holds the types of rooms by room ID
+type fakeRoomTypeRepo struct {
+	repository.RoomTypeRepository
+	byRoom map[int]*models.RoomType
+}
+
+func (r *fakeRoomTypeRepo) GetByRoomID(_ context.Context, roomID int) (*models.RoomType, error) {
+	roomType, ok := r.byRoom[roomID]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	copied := *roomType
+	return &copied, nil
+}
@@ -0,0 +1,312 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// InspectionService defines the interface for quality inspection business operations
+type InspectionService interface {
+	CreateInspectionItem(ctx context.Context, req *models.InspectionItemCreateRequest) (*models.InspectionItem, error)
+	GetInspectionItems(ctx context.Context) ([]models.InspectionItem, error)
+	DeleteInspectionItem(ctx context.Context, id int) error
+	CreateInspection(ctx context.Context, orderID int, req *models.InspectionCreateRequest) (*models.Inspection, error)
+	GetInspection(ctx context.Context, id int) (*models.Inspection, error)
+	GetInspectionsByOrder(ctx context.Context, orderID int) ([]models.Inspection, error)
+	GetCleanerQualityReport(ctx context.Context, from, to *time.Time) ([]models.CleanerQuality, error)
+}
+
+// CreateInspectionItem adds an item to the inspection checklist
+func (s *inspectionService) CreateInspectionItem(ctx context.Context, req *models.InspectionItemCreateRequest) (*models.InspectionItem, error) {
+	ctx, span := tracer.Start(ctx, "InspectionService.CreateInspectionItem")
+	defer span.End()
+
+	if req.Name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	weight := 1
+	if req.Weight != nil {
+		weight = *req.Weight
+	}
+	if weight < 1 {
+		return nil, fmt.Errorf("weight must be positive")
+	}
+
+	item := &models.InspectionItem{
+		Name:        req.Name,
+		Description: req.Description,
+		Weight:      weight,
+	}
+
+	err := s.inspectionRepo.CreateItem(ctx, item)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create inspection item: %w", err)
+	}
+
+	slog.InfoContext(ctx, "inspection item created", "item_id", item.Id)
+
+	return item, nil
+}
+
+// GetInspectionItems retrieves the active checklist items
+func (s *inspectionService) GetInspectionItems(ctx context.Context) ([]models.InspectionItem, error) {
+	ctx, span := tracer.Start(ctx, "InspectionService.GetInspectionItems")
+	defer span.End()
+
+	items, err := s.inspectionRepo.GetItems(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inspection items: %w", err)
+	}
+
+	return items, nil
+}
+
+// DeleteInspectionItem removes an item from the checklist, results of past
+// inspections are kept
+func (s *inspectionService) DeleteInspectionItem(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "InspectionService.DeleteInspectionItem")
+	defer span.End()
+
+	err := s.inspectionRepo.DeactivateItem(ctx, id)
+	if err != nil {
+		return fmt.Errorf("inspection item not found: %w", err)
+	}
+
+	slog.InfoContext(ctx, "inspection item deleted", "item_id", id)
+
+	return nil
+}
+
+// CreateInspection records the inspection of a done cleaning order. A passed
+// inspection marks the room inspected. A failed one marks the room dirty and
+// either reopens the order or creates a re-clean order for the same cleaners.
+func (s *inspectionService) CreateInspection(ctx context.Context, orderID int, req *models.InspectionCreateRequest) (*models.Inspection, error) {
+	ctx, span := tracer.Start(ctx, "InspectionService.CreateInspection")
+	defer span.End()
+
+	if req.Inspector == "" {
+		return nil, fmt.Errorf("inspector is required")
+	}
+	onFail := models.InspectionCreateRequestOnFailReclean
+	if req.OnFail != nil {
+		onFail = *req.OnFail
+	}
+	if onFail != models.InspectionCreateRequestOnFailReclean && onFail != models.InspectionCreateRequestOnFailReopen {
+		return nil, fmt.Errorf("unknown on_fail %q", onFail)
+	}
+
+	items, err := s.inspectionRepo.GetItems(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inspection items: %w", err)
+	}
+	score, passed, err := scoreInspection(items, req.Results)
+	if err != nil {
+		return nil, err
+	}
+
+	inspection := &models.Inspection{
+		OrderId:   orderID,
+		Inspector: req.Inspector,
+		Comments:  req.Comments,
+		Passed:    passed,
+		Score:     score,
+		Action:    models.InspectionActionNone,
+		Results:   req.Results,
+	}
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		order, err := s.cleaningOrderRepo.GetByID(ctx, orderID)
+		if err != nil {
+			return fmt.Errorf("cleaning order not found: %w", err)
+		}
+		if order.CancelledAt != nil || !isDone(order) {
+			return fmt.Errorf("%w: cleaning order is not done", ErrConflict)
+		}
+
+		status := models.RoomStatusInspected
+		if !passed {
+			status = models.RoomStatusDirty
+			switch onFail {
+			case models.InspectionCreateRequestOnFailReopen:
+				err = s.reopenOrder(ctx, order)
+				inspection.Action = models.InspectionActionReopen
+			default:
+				var reclean *models.CleaningOrder
+				reclean, err = s.createRecleanOrder(ctx, order)
+				if reclean != nil {
+					inspection.RecleanOrderId = &reclean.Id
+				}
+				inspection.Action = models.InspectionActionReclean
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		// A zone has no room status, and only a passed general (departure)
+		// cleaning makes the room ready for the next guest
+		general := order.CleaningType != nil && *order.CleaningType == "general"
+		if order.RoomId != nil && (general || !passed) {
+			err = s.roomRepo.SetStatus(ctx, *order.RoomId, status)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("failed to set room status: %w", err)
+			}
+		}
+
+		if err := s.inspectionRepo.Create(ctx, inspection); err != nil {
+			return fmt.Errorf("failed to create inspection: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "cleaning order inspected",
+		"inspection_id", inspection.Id,
+		"order_id", orderID,
+		"passed", passed,
+		"score", score,
+		"action", inspection.Action,
+	)
+
+	return s.inspectionRepo.GetByID(ctx, inspection.Id)
+}
+
+// reopenOrder returns a failed order to the cleaners
+func (s *inspectionService) reopenOrder(ctx context.Context, order *models.CleaningOrder) error {
+	done := false
+	order.Done = &done
+	if err := s.cleaningOrderRepo.Update(ctx, order); err != nil {
+		return updateError("cleaning order", err)
+	}
+	return nil
+}
+
+// createRecleanOrder creates an unpaid order of the same type as the failed
+// one, due now and assigned to the same cleaners
+func (s *inspectionService) createRecleanOrder(ctx context.Context, failed *models.CleaningOrder) (*models.CleaningOrder, error) {
+	cleaningTs := time.Now()
+	notes := fmt.Sprintf("re-clean of order %d after failed inspection", failed.Id)
+	order := &models.CleaningOrder{
+		BookingId:    failed.BookingId,
+		RoomId:       failed.RoomId,
+		ZoneId:       failed.ZoneId,
+		CleaningTs:   &cleaningTs,
+		CleaningType: failed.CleaningType,
+		Notes:        &notes,
+	}
+	if err := s.cleaningOrderRepo.Create(ctx, order); err != nil {
+		return nil, fmt.Errorf("failed to create re-clean order: %w", err)
+	}
+	if err := s.checklistRepo.Instantiate(ctx, order.Id); err != nil {
+		return nil, fmt.Errorf("failed to create checklist of order %d: %w", order.Id, err)
+	}
+
+	cleanerIDs, err := s.cleaningOrderRepo.GetCleanerIDs(ctx, failed.Id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cleaners of order: %w", err)
+	}
+	for _, cleanerID := range cleanerIDs {
+		if err := s.cleaningOrderRepo.AssignCleaner(ctx, order.Id, cleanerID); err != nil {
+			return nil, fmt.Errorf("failed to assign cleaner: %w", err)
+		}
+	}
+
+	return order, nil
+}
+
+// scoreInspection checks that there is exactly one result per active
+// checklist item and returns the weighted share of passed items, 0 to 100,
+// and whether every item passed
+func scoreInspection(items []models.InspectionItem, results []models.InspectionResult) (float64, bool, error) {
+	if len(items) == 0 {
+		return 0, false, fmt.Errorf("inspection checklist is empty")
+	}
+
+	weights := make(map[int]int, len(items))
+	for _, item := range items {
+		weights[item.Id] = item.Weight
+	}
+
+	seen := make(map[int]bool, len(results))
+	total, earned := 0, 0
+	passed := true
+	for _, result := range results {
+		weight, ok := weights[result.ItemId]
+		if !ok {
+			return 0, false, fmt.Errorf("unknown inspection item %d", result.ItemId)
+		}
+		if seen[result.ItemId] {
+			return 0, false, fmt.Errorf("duplicate result for inspection item %d", result.ItemId)
+		}
+		seen[result.ItemId] = true
+
+		total += weight
+		if result.Passed {
+			earned += weight
+		} else {
+			passed = false
+		}
+	}
+	if len(seen) != len(items) {
+		return 0, false, fmt.Errorf("every inspection item must have a result")
+	}
+
+	return float64(earned) * 100 / float64(total), passed, nil
+}
+
+// GetInspection retrieves an inspection by ID
+func (s *inspectionService) GetInspection(ctx context.Context, id int) (*models.Inspection, error) {
+	ctx, span := tracer.Start(ctx, "InspectionService.GetInspection")
+	defer span.End()
+
+	inspection, err := s.inspectionRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("inspection not found: %w", err)
+	}
+
+	return inspection, nil
+}
+
+// GetInspectionsByOrder retrieves the inspections of a cleaning order
+func (s *inspectionService) GetInspectionsByOrder(ctx context.Context, orderID int) ([]models.Inspection, error) {
+	ctx, span := tracer.Start(ctx, "InspectionService.GetInspectionsByOrder")
+	defer span.End()
+
+	_, err := s.cleaningOrderRepo.GetByID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("cleaning order not found: %w", err)
+	}
+
+	inspections, err := s.inspectionRepo.GetByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inspections: %w", err)
+	}
+
+	return inspections, nil
+}
+
+// GetCleanerQualityReport aggregates inspection results per cleaner. An
+// inspection counts for every cleaner assigned to the inspected order.
+func (s *inspectionService) GetCleanerQualityReport(ctx context.Context, from, to *time.Time) ([]models.CleanerQuality, error) {
+	ctx, span := tracer.Start(ctx, "InspectionService.GetCleanerQualityReport")
+	defer span.End()
+
+	if from != nil && to != nil && !to.After(*from) {
+		return nil, fmt.Errorf("to must be after from")
+	}
+
+	report, err := s.inspectionRepo.CleanerQuality(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cleaner quality report: %w", err)
+	}
+
+	return report, nil
+}
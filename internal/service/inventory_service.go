@@ -0,0 +1,403 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
+)
+
+// InventoryService defines the interface for linen and consumables business operations
+type InventoryService interface {
+	CreateInventoryItem(ctx context.Context, req *models.InventoryItemCreateRequest) (*models.InventoryItem, error)
+	GetInventoryItem(ctx context.Context, id int) (*models.InventoryItem, error)
+	GetAllInventoryItems(ctx context.Context, includeInactive bool) ([]models.InventoryItem, error)
+	PatchInventoryItem(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.InventoryItem, error)
+	GetStock(ctx context.Context, itemID *int, location *string) ([]models.StockLevel, error)
+	GetLowStock(ctx context.Context) ([]models.LowStockItem, error)
+	CreateStockMovement(ctx context.Context, req *models.StockMovementCreateRequest) (*models.StockMovement, error)
+	GetStockMovements(ctx context.Context, filter repository.StockMovementFilter) ([]models.StockMovement, error)
+	SetConsumptionDefault(ctx context.Context, req *models.ConsumptionDefaultCreateRequest) (*models.ConsumptionDefault, error)
+	GetConsumptionDefaults(ctx context.Context, cleaningType *string) ([]models.ConsumptionDefault, error)
+	DeleteConsumptionDefault(ctx context.Context, id int) error
+	GetOrderConsumption(ctx context.Context, orderID int) ([]models.StockMovement, error)
+	RecordOrderConsumption(ctx context.Context, orderID int, req []models.ConsumptionRequest) ([]models.StockMovement, error)
+	GetConsumptionReport(ctx context.Context, from, to *time.Time, cleaningType *string) ([]models.ConsumptionReportRow, error)
+}
+
+// CreateInventoryItem adds an item to the inventory
+func (s *inventoryService) CreateInventoryItem(ctx context.Context, req *models.InventoryItemCreateRequest) (*models.InventoryItem, error) {
+	ctx, span := tracer.Start(ctx, "InventoryService.CreateInventoryItem")
+	defer span.End()
+
+	item := &models.InventoryItem{
+		Name:            req.Name,
+		Unit:            "pcs",
+		DefaultLocation: req.DefaultLocation,
+		Active:          true,
+	}
+	if req.Unit != nil {
+		item.Unit = *req.Unit
+	}
+	if req.MinStock != nil {
+		item.MinStock = *req.MinStock
+	}
+	if err := validateInventoryItem(item); err != nil {
+		return nil, err
+	}
+
+	if err := s.inventoryRepo.CreateItem(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to create inventory item: %w", err)
+	}
+
+	slog.InfoContext(ctx, "inventory item created", "item_id", item.Id, "name", item.Name)
+
+	return item, nil
+}
+
+// GetInventoryItem retrieves an item by ID
+func (s *inventoryService) GetInventoryItem(ctx context.Context, id int) (*models.InventoryItem, error) {
+	ctx, span := tracer.Start(ctx, "InventoryService.GetInventoryItem")
+	defer span.End()
+
+	item, err := s.inventoryRepo.GetItemByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("inventory item not found: %w", err)
+	}
+
+	return item, nil
+}
+
+// GetAllInventoryItems retrieves the items in use, or all of them
+func (s *inventoryService) GetAllInventoryItems(ctx context.Context, includeInactive bool) ([]models.InventoryItem, error) {
+	ctx, span := tracer.Start(ctx, "InventoryService.GetAllInventoryItems")
+	defer span.End()
+
+	items, err := s.inventoryRepo.GetItems(ctx, !includeInactive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inventory items: %w", err)
+	}
+
+	return items, nil
+}
+
+// PatchInventoryItem applies a JSON merge patch to an item
+func (s *inventoryService) PatchInventoryItem(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.InventoryItem, error) {
+	ctx, span := tracer.Start(ctx, "InventoryService.PatchInventoryItem")
+	defer span.End()
+
+	existing, err := s.inventoryRepo.GetItemByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("inventory item not found: %w", err)
+	}
+	if err := checkVersion(ifMatch, existing.Version); err != nil {
+		return nil, err
+	}
+
+	item, err := applyMergePatch(*existing, patch, "name", "unit", "min_stock", "default_location", "active")
+	if err != nil {
+		return nil, err
+	}
+	item.Id, item.Version, item.UpdatedAt = existing.Id, existing.Version, existing.UpdatedAt
+	item.PropertyId = existing.PropertyId
+
+	if err := validateInventoryItem(&item); err != nil {
+		return nil, err
+	}
+
+	if err := s.inventoryRepo.UpdateItem(ctx, &item); err != nil {
+		return nil, updateError("inventory item", err)
+	}
+
+	slog.InfoContext(ctx, "inventory item patched", "item_id", item.Id)
+
+	return &item, nil
+}
+
+// GetStock retrieves stock levels, optionally of one item and location
+func (s *inventoryService) GetStock(ctx context.Context, itemID *int, location *string) ([]models.StockLevel, error) {
+	ctx, span := tracer.Start(ctx, "InventoryService.GetStock")
+	defer span.End()
+
+	levels, err := s.inventoryRepo.GetStock(ctx, itemID, location)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock: %w", err)
+	}
+
+	return levels, nil
+}
+
+// GetLowStock retrieves the active items whose total stock is below their minimum
+func (s *inventoryService) GetLowStock(ctx context.Context) ([]models.LowStockItem, error) {
+	ctx, span := tracer.Start(ctx, "InventoryService.GetLowStock")
+	defer span.End()
+
+	items, err := s.inventoryRepo.GetLowStock(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get low stock: %w", err)
+	}
+
+	return items, nil
+}
+
+// CreateStockMovement records a delivery, a stocktaking correction or a
+// consumption not tied to a cleaning order
+func (s *inventoryService) CreateStockMovement(ctx context.Context, req *models.StockMovementCreateRequest) (*models.StockMovement, error) {
+	ctx, span := tracer.Start(ctx, "InventoryService.CreateStockMovement")
+	defer span.End()
+
+	item, err := s.inventoryRepo.GetItemByID(ctx, req.ItemId)
+	if err != nil {
+		return nil, fmt.Errorf("inventory item not found: %w", err)
+	}
+
+	movement := &models.StockMovement{
+		ItemId:   item.Id,
+		Location: item.DefaultLocation,
+		Quantity: req.Quantity,
+		Kind:     models.StockMovementKind(req.Kind),
+		Note:     req.Note,
+	}
+	if req.Location != nil {
+		movement.Location = *req.Location
+	}
+	if movement.Location == "" {
+		return nil, fmt.Errorf("location is required")
+	}
+	switch movement.Kind {
+	case models.StockMovementKindRestock:
+		if movement.Quantity <= 0 {
+			return nil, fmt.Errorf("quantity of a restock must be positive")
+		}
+	case models.StockMovementKindConsumption:
+		if movement.Quantity >= 0 {
+			return nil, fmt.Errorf("quantity of a consumption must be negative")
+		}
+	case models.StockMovementKindAdjustment:
+		if movement.Quantity == 0 {
+			return nil, fmt.Errorf("quantity of an adjustment must not be zero")
+		}
+	default:
+		return nil, fmt.Errorf("unknown kind %q", movement.Kind)
+	}
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		return addStockMovement(ctx, s.inventoryRepo, item, movement)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return movement, nil
+}
+
+// GetStockMovements retrieves the movements matching a filter
+func (s *inventoryService) GetStockMovements(ctx context.Context, filter repository.StockMovementFilter) ([]models.StockMovement, error) {
+	ctx, span := tracer.Start(ctx, "InventoryService.GetStockMovements")
+	defer span.End()
+
+	movements, err := s.inventoryRepo.GetMovements(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock movements: %w", err)
+	}
+
+	return movements, nil
+}
+
+// SetConsumptionDefault sets how much of an item every completed order of a
+// cleaning type consumes
+func (s *inventoryService) SetConsumptionDefault(ctx context.Context, req *models.ConsumptionDefaultCreateRequest) (*models.ConsumptionDefault, error) {
+	ctx, span := tracer.Start(ctx, "InventoryService.SetConsumptionDefault")
+	defer span.End()
+
+	if req.CleaningType == "" {
+		return nil, fmt.Errorf("cleaning_type is required")
+	}
+	if req.Quantity <= 0 {
+		return nil, fmt.Errorf("quantity must be positive")
+	}
+	if _, err := s.inventoryRepo.GetItemByID(ctx, req.ItemId); err != nil {
+		return nil, fmt.Errorf("inventory item not found: %w", err)
+	}
+
+	def := &models.ConsumptionDefault{
+		CleaningType: req.CleaningType,
+		ItemId:       req.ItemId,
+		Quantity:     req.Quantity,
+	}
+	if err := s.inventoryRepo.SetDefault(ctx, def); err != nil {
+		return nil, fmt.Errorf("failed to set default consumption: %w", err)
+	}
+
+	slog.InfoContext(ctx, "default consumption set",
+		"cleaning_type", def.CleaningType,
+		"item_id", def.ItemId,
+		"quantity", def.Quantity,
+	)
+
+	return def, nil
+}
+
+// GetConsumptionDefaults retrieves the default consumption, optionally of
+// one cleaning type
+func (s *inventoryService) GetConsumptionDefaults(ctx context.Context, cleaningType *string) ([]models.ConsumptionDefault, error) {
+	ctx, span := tracer.Start(ctx, "InventoryService.GetConsumptionDefaults")
+	defer span.End()
+
+	defs, err := s.inventoryRepo.GetDefaults(ctx, cleaningType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default consumption: %w", err)
+	}
+
+	return defs, nil
+}
+
+// DeleteConsumptionDefault removes an item from the default consumption of
+// a cleaning type
+func (s *inventoryService) DeleteConsumptionDefault(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "InventoryService.DeleteConsumptionDefault")
+	defer span.End()
+
+	if err := s.inventoryRepo.DeleteDefault(ctx, id); err != nil {
+		return fmt.Errorf("default consumption not found: %w", err)
+	}
+
+	slog.InfoContext(ctx, "default consumption deleted", "default_id", id)
+
+	return nil
+}
+
+// GetOrderConsumption retrieves the consumption recorded for a cleaning order
+func (s *inventoryService) GetOrderConsumption(ctx context.Context, orderID int) ([]models.StockMovement, error) {
+	ctx, span := tracer.Start(ctx, "InventoryService.GetOrderConsumption")
+	defer span.End()
+
+	if _, err := s.cleaningOrderRepo.GetByID(ctx, orderID); err != nil {
+		return nil, fmt.Errorf("cleaning order not found: %w", err)
+	}
+
+	movements, err := s.inventoryRepo.GetMovements(ctx, repository.StockMovementFilter{OrderID: &orderID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get consumption: %w", err)
+	}
+
+	return movements, nil
+}
+
+// RecordOrderConsumption records what a cleaning order consumed, taken from
+// the given locations or the default locations of the items
+func (s *inventoryService) RecordOrderConsumption(ctx context.Context, orderID int, req []models.ConsumptionRequest) ([]models.StockMovement, error) {
+	ctx, span := tracer.Start(ctx, "InventoryService.RecordOrderConsumption")
+	defer span.End()
+
+	if len(req) == 0 {
+		return nil, fmt.Errorf("no consumption given")
+	}
+	if _, err := s.cleaningOrderRepo.GetByID(ctx, orderID); err != nil {
+		return nil, fmt.Errorf("cleaning order not found: %w", err)
+	}
+
+	movements := make([]models.StockMovement, 0, len(req))
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		for _, consumed := range req {
+			if consumed.Quantity <= 0 {
+				return fmt.Errorf("quantity of item %d must be positive", consumed.ItemId)
+			}
+			item, err := s.inventoryRepo.GetItemByID(ctx, consumed.ItemId)
+			if err != nil {
+				return fmt.Errorf("inventory item not found: %w", err)
+			}
+			movement := models.StockMovement{
+				ItemId:   item.Id,
+				Location: item.DefaultLocation,
+				Quantity: -consumed.Quantity,
+				Kind:     models.StockMovementKindConsumption,
+				OrderId:  &orderID,
+			}
+			if consumed.Location != nil {
+				movement.Location = *consumed.Location
+			}
+			if err := addStockMovement(ctx, s.inventoryRepo, item, &movement); err != nil {
+				return err
+			}
+			movements = append(movements, movement)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return movements, nil
+}
+
+// GetConsumptionReport sums the consumption per item made in [from, to)
+func (s *inventoryService) GetConsumptionReport(ctx context.Context, from, to *time.Time, cleaningType *string) ([]models.ConsumptionReportRow, error) {
+	ctx, span := tracer.Start(ctx, "InventoryService.GetConsumptionReport")
+	defer span.End()
+
+	if from != nil && to != nil && !from.Before(*to) {
+		return nil, fmt.Errorf("from must be before to")
+	}
+
+	report, err := s.inventoryRepo.ConsumptionReport(ctx, from, to, cleaningType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get consumption report: %w", err)
+	}
+
+	return report, nil
+}
+
+// addStockMovement records a movement of an item and warns when it leaves
+// the item low on stock
+func addStockMovement(ctx context.Context, repo repository.InventoryRepository, item *models.InventoryItem, movement *models.StockMovement) error {
+	if err := repo.AddMovement(ctx, movement); err != nil {
+		return fmt.Errorf("failed to record stock movement: %w", err)
+	}
+
+	slog.InfoContext(ctx, "stock movement recorded",
+		"movement_id", movement.Id,
+		"item_id", movement.ItemId,
+		"location", movement.Location,
+		"quantity", movement.Quantity,
+		"kind", movement.Kind,
+	)
+
+	if movement.Quantity >= 0 || !item.Active {
+		return nil
+	}
+	total, err := repo.GetTotalStock(ctx, item.Id)
+	if err != nil {
+		return fmt.Errorf("failed to get stock: %w", err)
+	}
+	if total < item.MinStock {
+		slog.WarnContext(ctx, "inventory item low on stock",
+			"item_id", item.Id,
+			"name", item.Name,
+			"quantity", total,
+			"min_stock", item.MinStock,
+		)
+	}
+
+	return nil
+}
+
+// validateInventoryItem checks the fields of an item that a patch may change
+func validateInventoryItem(item *models.InventoryItem) error {
+	if item.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if item.Unit == "" {
+		return fmt.Errorf("unit is required")
+	}
+	if item.DefaultLocation == "" {
+		return fmt.Errorf("default_location is required")
+	}
+	if item.MinStock < 0 {
+		return fmt.Errorf("min_stock must be non-negative")
+	}
+	return nil
+}
@@ -0,0 +1,445 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
+)
+
+// LocationService defines the interface for building, floor and zone business operations
+type LocationService interface {
+	CreateBuilding(ctx context.Context, req *models.BuildingCreateRequest) (*models.Building, error)
+	GetBuilding(ctx context.Context, id int) (*models.Building, error)
+	GetAllBuildings(ctx context.Context) ([]models.Building, error)
+	PatchBuilding(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Building, error)
+	DeleteBuilding(ctx context.Context, id int) error
+	CreateFloor(ctx context.Context, req *models.FloorCreateRequest) (*models.Floor, error)
+	GetFloor(ctx context.Context, id int) (*models.Floor, error)
+	GetAllFloors(ctx context.Context, buildingID *int) ([]models.Floor, error)
+	PatchFloor(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Floor, error)
+	DeleteFloor(ctx context.Context, id int) error
+	CreateZone(ctx context.Context, req *models.ZoneCreateRequest) (*models.Zone, error)
+	GetZone(ctx context.Context, id int) (*models.Zone, error)
+	GetAllZones(ctx context.Context, floorID *int) ([]models.Zone, error)
+	PatchZone(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Zone, error)
+	DeleteZone(ctx context.Context, id int) error
+}
+
+// locationService implements LocationService
+type locationService struct {
+	locationRepo repository.LocationRepository
+	transactor   repository.Transactor
+}
+
+// NewLocationService creates a new location service
+func NewLocationService(locationRepo repository.LocationRepository, transactor repository.Transactor) LocationService {
+	return &locationService{
+		locationRepo: locationRepo,
+		transactor:   transactor,
+	}
+}
+
+// CreateBuilding adds a building to the property of the request
+func (s *locationService) CreateBuilding(ctx context.Context, req *models.BuildingCreateRequest) (*models.Building, error) {
+	ctx, span := tracer.Start(ctx, "LocationService.CreateBuilding")
+	defer span.End()
+
+	building := &models.Building{Name: req.Name}
+	if strings.TrimSpace(building.Name) == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+
+	if err := s.locationRepo.CreateBuilding(ctx, building); err != nil {
+		return nil, fmt.Errorf("failed to create building: %w", err)
+	}
+
+	slog.InfoContext(ctx, "building created", "building_id", building.Id, "name", building.Name)
+
+	return building, nil
+}
+
+// GetBuilding retrieves a building by ID
+func (s *locationService) GetBuilding(ctx context.Context, id int) (*models.Building, error) {
+	ctx, span := tracer.Start(ctx, "LocationService.GetBuilding")
+	defer span.End()
+
+	building, err := s.locationRepo.GetBuildingByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("building not found: %w", err)
+	}
+
+	return building, nil
+}
+
+// GetAllBuildings retrieves all buildings
+func (s *locationService) GetAllBuildings(ctx context.Context) ([]models.Building, error) {
+	ctx, span := tracer.Start(ctx, "LocationService.GetAllBuildings")
+	defer span.End()
+
+	buildings, err := s.locationRepo.GetBuildings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get buildings: %w", err)
+	}
+
+	return buildings, nil
+}
+
+// PatchBuilding applies a JSON merge patch to a building
+func (s *locationService) PatchBuilding(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Building, error) {
+	ctx, span := tracer.Start(ctx, "LocationService.PatchBuilding")
+	defer span.End()
+
+	existing, err := s.locationRepo.GetBuildingByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("building not found: %w", err)
+	}
+	if err := checkVersion(ifMatch, existing.Version); err != nil {
+		return nil, err
+	}
+
+	building, err := applyMergePatch(*existing, patch, "name")
+	if err != nil {
+		return nil, err
+	}
+	building.Id, building.PropertyId = existing.Id, existing.PropertyId
+	building.Version, building.UpdatedAt = existing.Version, existing.UpdatedAt
+
+	if strings.TrimSpace(building.Name) == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+
+	if err := s.locationRepo.UpdateBuilding(ctx, &building); err != nil {
+		return nil, updateError("building", err)
+	}
+
+	slog.InfoContext(ctx, "building patched", "building_id", building.Id)
+
+	return &building, nil
+}
+
+// DeleteBuilding removes a building without floors
+func (s *locationService) DeleteBuilding(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "LocationService.DeleteBuilding")
+	defer span.End()
+
+	if _, err := s.locationRepo.GetBuildingByID(ctx, id); err != nil {
+		return fmt.Errorf("building not found: %w", err)
+	}
+	floors, err := s.locationRepo.GetFloors(ctx, &id)
+	if err != nil {
+		return fmt.Errorf("failed to get floors: %w", err)
+	}
+	if len(floors) > 0 {
+		return fmt.Errorf("%w: the building has %d floors", ErrConflict, len(floors))
+	}
+
+	if err := s.locationRepo.DeleteBuilding(ctx, id); err != nil {
+		return fmt.Errorf("building not found: %w", err)
+	}
+
+	slog.InfoContext(ctx, "building deleted", "building_id", id)
+
+	return nil
+}
+
+// CreateFloor adds a floor to a building
+func (s *locationService) CreateFloor(ctx context.Context, req *models.FloorCreateRequest) (*models.Floor, error) {
+	ctx, span := tracer.Start(ctx, "LocationService.CreateFloor")
+	defer span.End()
+
+	floor := &models.Floor{
+		BuildingId: req.BuildingId,
+		Level:      req.Level,
+		Name:       req.Name,
+	}
+	if err := s.checkFloor(ctx, floor); err != nil {
+		return nil, err
+	}
+
+	if err := s.locationRepo.CreateFloor(ctx, floor); err != nil {
+		return nil, fmt.Errorf("failed to create floor: %w", err)
+	}
+
+	slog.InfoContext(ctx, "floor created", "floor_id", floor.Id, "building_id", floor.BuildingId, "level", floor.Level)
+
+	return floor, nil
+}
+
+// GetFloor retrieves a floor by ID
+func (s *locationService) GetFloor(ctx context.Context, id int) (*models.Floor, error) {
+	ctx, span := tracer.Start(ctx, "LocationService.GetFloor")
+	defer span.End()
+
+	floor, err := s.locationRepo.GetFloorByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("floor not found: %w", err)
+	}
+
+	return floor, nil
+}
+
+// GetAllFloors retrieves the floors of a building, of all buildings if
+// buildingID is nil
+func (s *locationService) GetAllFloors(ctx context.Context, buildingID *int) ([]models.Floor, error) {
+	ctx, span := tracer.Start(ctx, "LocationService.GetAllFloors")
+	defer span.End()
+
+	floors, err := s.locationRepo.GetFloors(ctx, buildingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get floors: %w", err)
+	}
+
+	return floors, nil
+}
+
+// PatchFloor applies a JSON merge patch to a floor. A new level is copied
+// to the rooms in its zones.
+func (s *locationService) PatchFloor(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Floor, error) {
+	ctx, span := tracer.Start(ctx, "LocationService.PatchFloor")
+	defer span.End()
+
+	existing, err := s.locationRepo.GetFloorByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("floor not found: %w", err)
+	}
+	if err := checkVersion(ifMatch, existing.Version); err != nil {
+		return nil, err
+	}
+
+	floor, err := applyMergePatch(*existing, patch, "building_id", "level")
+	if err != nil {
+		return nil, err
+	}
+	floor.Id, floor.Version, floor.UpdatedAt = existing.Id, existing.Version, existing.UpdatedAt
+
+	if err := s.checkFloor(ctx, &floor); err != nil {
+		return nil, err
+	}
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.locationRepo.UpdateFloor(ctx, &floor); err != nil {
+			return updateError("floor", err)
+		}
+		if floor.Level == existing.Level {
+			return nil
+		}
+		if _, err := s.locationRepo.SyncRoomFloors(ctx, floor.Id); err != nil {
+			return fmt.Errorf("failed to move rooms: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "floor patched", "floor_id", floor.Id)
+
+	return &floor, nil
+}
+
+// DeleteFloor removes a floor without zones
+func (s *locationService) DeleteFloor(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "LocationService.DeleteFloor")
+	defer span.End()
+
+	if _, err := s.locationRepo.GetFloorByID(ctx, id); err != nil {
+		return fmt.Errorf("floor not found: %w", err)
+	}
+	zones, err := s.locationRepo.GetZones(ctx, &id)
+	if err != nil {
+		return fmt.Errorf("failed to get zones: %w", err)
+	}
+	if len(zones) > 0 {
+		return fmt.Errorf("%w: the floor has %d zones", ErrConflict, len(zones))
+	}
+
+	if err := s.locationRepo.DeleteFloor(ctx, id); err != nil {
+		return fmt.Errorf("floor not found: %w", err)
+	}
+
+	slog.InfoContext(ctx, "floor deleted", "floor_id", id)
+
+	return nil
+}
+
+// CreateZone adds a zone to a floor
+func (s *locationService) CreateZone(ctx context.Context, req *models.ZoneCreateRequest) (*models.Zone, error) {
+	ctx, span := tracer.Start(ctx, "LocationService.CreateZone")
+	defer span.End()
+
+	zone := &models.Zone{
+		FloorId: req.FloorId,
+		Name:    req.Name,
+	}
+	if err := s.checkZone(ctx, zone); err != nil {
+		return nil, err
+	}
+
+	if err := s.locationRepo.CreateZone(ctx, zone); err != nil {
+		return nil, fmt.Errorf("failed to create zone: %w", err)
+	}
+
+	slog.InfoContext(ctx, "zone created", "zone_id", zone.Id, "floor_id", zone.FloorId, "name", zone.Name)
+
+	return zone, nil
+}
+
+// GetZone retrieves a zone by ID
+func (s *locationService) GetZone(ctx context.Context, id int) (*models.Zone, error) {
+	ctx, span := tracer.Start(ctx, "LocationService.GetZone")
+	defer span.End()
+
+	zone, err := s.locationRepo.GetZoneByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("zone not found: %w", err)
+	}
+
+	return zone, nil
+}
+
+// GetAllZones retrieves the zones of a floor, of all floors if floorID is nil
+func (s *locationService) GetAllZones(ctx context.Context, floorID *int) ([]models.Zone, error) {
+	ctx, span := tracer.Start(ctx, "LocationService.GetAllZones")
+	defer span.End()
+
+	zones, err := s.locationRepo.GetZones(ctx, floorID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get zones: %w", err)
+	}
+
+	return zones, nil
+}
+
+// PatchZone applies a JSON merge patch to a zone. Moving it to another
+// floor moves its rooms along.
+func (s *locationService) PatchZone(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Zone, error) {
+	ctx, span := tracer.Start(ctx, "LocationService.PatchZone")
+	defer span.End()
+
+	existing, err := s.locationRepo.GetZoneByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("zone not found: %w", err)
+	}
+	if err := checkVersion(ifMatch, existing.Version); err != nil {
+		return nil, err
+	}
+
+	zone, err := applyMergePatch(*existing, patch, "floor_id", "name")
+	if err != nil {
+		return nil, err
+	}
+	zone.Id, zone.Version, zone.UpdatedAt = existing.Id, existing.Version, existing.UpdatedAt
+
+	if err := s.checkZone(ctx, &zone); err != nil {
+		return nil, err
+	}
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.locationRepo.UpdateZone(ctx, &zone); err != nil {
+			return updateError("zone", err)
+		}
+		if zone.FloorId == existing.FloorId {
+			return nil
+		}
+		if _, err := s.locationRepo.SyncRoomFloors(ctx, zone.FloorId); err != nil {
+			return fmt.Errorf("failed to move rooms: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "zone patched", "zone_id", zone.Id)
+
+	return &zone, nil
+}
+
+// DeleteZone removes a zone no room is placed in, cleaners lose it as
+// their home zone
+func (s *locationService) DeleteZone(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "LocationService.DeleteZone")
+	defer span.End()
+
+	if _, err := s.locationRepo.GetZoneByID(ctx, id); err != nil {
+		return fmt.Errorf("zone not found: %w", err)
+	}
+	count, err := s.locationRepo.CountZoneRooms(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to count rooms: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %d rooms are placed in the zone", ErrConflict, count)
+	}
+
+	if err := s.locationRepo.DeleteZone(ctx, id); err != nil {
+		return fmt.Errorf("zone not found: %w", err)
+	}
+
+	slog.InfoContext(ctx, "zone deleted", "zone_id", id)
+
+	return nil
+}
+
+// checkFloor fails if the building of a floor does not exist or already
+// has another floor on the same level
+func (s *locationService) checkFloor(ctx context.Context, floor *models.Floor) error {
+	if floor.Level < 0 {
+		return fmt.Errorf("level must be non-negative")
+	}
+	if _, err := s.locationRepo.GetBuildingByID(ctx, floor.BuildingId); err != nil {
+		return fmt.Errorf("building not found: %w", err)
+	}
+	floors, err := s.locationRepo.GetFloors(ctx, &floor.BuildingId)
+	if err != nil {
+		return fmt.Errorf("failed to get floors: %w", err)
+	}
+	for _, other := range floors {
+		if other.Level == floor.Level && other.Id != floor.Id {
+			return fmt.Errorf("%w: the building already has floor %d", ErrConflict, floor.Level)
+		}
+	}
+	return nil
+}
+
+// checkZone fails if a zone has no name or its floor does not exist
+func (s *locationService) checkZone(ctx context.Context, zone *models.Zone) error {
+	if strings.TrimSpace(zone.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if _, err := s.locationRepo.GetFloorByID(ctx, zone.FloorId); err != nil {
+		return fmt.Errorf("floor not found: %w", err)
+	}
+	return nil
+}
+
+// zoneFloor returns the floor of a zone, failing if the zone does not exist
+func zoneFloor(ctx context.Context, repo repository.LocationRepository, zoneID int) (*models.Floor, error) {
+	zone, err := repo.GetZoneByID(ctx, zoneID)
+	if err != nil {
+		return nil, fmt.Errorf("zone not found: %w", err)
+	}
+	floor, err := repo.GetFloorByID(ctx, zone.FloorId)
+	if err != nil {
+		return nil, fmt.Errorf("floor not found: %w", err)
+	}
+	return floor, nil
+}
+
+// placeRoom moves a room placed in a zone to the level of the zone's
+// floor, failing if the zone does not exist
+func (s *roomService) placeRoom(ctx context.Context, room *models.Room) error {
+	if room.ZoneId == nil {
+		return nil
+	}
+	floor, err := zoneFloor(ctx, s.locationRepo, *room.ZoneId)
+	if err != nil {
+		return err
+	}
+	room.Floor = floor.Level
+	return nil
+}
@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
+)
+
+// LostItemService defines the interface for lost and found business operations
+type LostItemService interface {
+	CreateLostItem(ctx context.Context, req *models.LostItemCreateRequest) (*models.LostItem, error)
+	GetLostItem(ctx context.Context, id int) (*models.LostItem, error)
+	GetAllLostItems(ctx context.Context, filter repository.LostItemFilter) ([]models.LostItem, error)
+	PatchLostItem(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.LostItem, error)
+	GetLostItemsDueForDisposal(ctx context.Context, at time.Time) ([]models.LostItem, error)
+}
+
+// CreateLostItem registers an item found in a room. The room and booking are
+// taken from the cleaning order when one is given, otherwise the booking is
+// the last one of the room that started before the item was found.
+func (s *lostItemService) CreateLostItem(ctx context.Context, req *models.LostItemCreateRequest) (*models.LostItem, error) {
+	ctx, span := tracer.Start(ctx, "LostItemService.CreateLostItem")
+	defer span.End()
+
+	if req.Description == "" {
+		return nil, fmt.Errorf("description is required")
+	}
+	if req.StorageLocation == "" {
+		return nil, fmt.Errorf("storage_location is required")
+	}
+
+	foundAt := time.Now()
+	if req.FoundAt != nil {
+		foundAt = *req.FoundAt
+	}
+
+	roomID, bookingID := req.RoomId, req.BookingId
+	if req.OrderId != nil {
+		order, err := s.cleaningOrderRepo.GetByID(ctx, *req.OrderId)
+		if err != nil {
+			return nil, fmt.Errorf("cleaning order not found: %w", err)
+		}
+		if order.RoomId == nil {
+			return nil, fmt.Errorf("cleaning order %d is not in a room", order.Id)
+		}
+		if roomID == nil {
+			roomID = order.RoomId
+		} else if *roomID != *order.RoomId {
+			return nil, fmt.Errorf("cleaning order %d is not in room %d", order.Id, *roomID)
+		}
+		if bookingID == nil {
+			bookingID = order.BookingId
+		}
+	}
+	if bookingID != nil {
+		booking, err := s.bookingRepo.GetByIDWithDeleted(ctx, *bookingID)
+		if err != nil {
+			return nil, fmt.Errorf("booking not found: %w", err)
+		}
+		if roomID == nil {
+			roomID = &booking.RoomId
+		} else if *roomID != booking.RoomId {
+			return nil, fmt.Errorf("booking %d is not in room %d", booking.Id, *roomID)
+		}
+	}
+	if roomID == nil {
+		return nil, fmt.Errorf("room_id, booking_id or order_id is required")
+	}
+	if _, err := s.roomRepo.GetByID(ctx, *roomID); err != nil {
+		return nil, fmt.Errorf("room not found: %w", err)
+	}
+	if bookingID == nil {
+		booking, err := s.bookingRepo.GetLastByRoom(ctx, *roomID, foundAt)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("failed to find booking: %w", err)
+		}
+		if booking != nil {
+			bookingID = &booking.Id
+		}
+	}
+	if req.FoundBy != nil {
+		if _, err := s.cleanerRepo.GetByID(ctx, *req.FoundBy); err != nil {
+			return nil, fmt.Errorf("cleaner not found: %w", err)
+		}
+	}
+
+	item := &models.LostItem{
+		OrderId:         req.OrderId,
+		RoomId:          *roomID,
+		BookingId:       bookingID,
+		Description:     req.Description,
+		StorageLocation: req.StorageLocation,
+		Status:          models.LostItemStatusStored,
+		FoundAt:         foundAt,
+		FoundBy:         req.FoundBy,
+		DisposeAfter:    foundAt.Add(s.retention),
+		Notes:           req.Notes,
+	}
+	if err := s.lostItemRepo.Create(ctx, item); err != nil {
+		return nil, fmt.Errorf("failed to create lost item: %w", err)
+	}
+
+	slog.InfoContext(ctx, "lost item registered",
+		"lost_item_id", item.Id,
+		"room_id", item.RoomId,
+		"booking_id", item.BookingId,
+		"dispose_after", item.DisposeAfter,
+	)
+
+	return item, nil
+}
+
+// GetLostItem retrieves an item by ID
+func (s *lostItemService) GetLostItem(ctx context.Context, id int) (*models.LostItem, error) {
+	ctx, span := tracer.Start(ctx, "LostItemService.GetLostItem")
+	defer span.End()
+
+	item, err := s.lostItemRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("lost item not found: %w", err)
+	}
+
+	return item, nil
+}
+
+// GetAllLostItems retrieves the items matching a filter
+func (s *lostItemService) GetAllLostItems(ctx context.Context, filter repository.LostItemFilter) ([]models.LostItem, error) {
+	ctx, span := tracer.Start(ctx, "LostItemService.GetAllLostItems")
+	defer span.End()
+
+	items, err := s.lostItemRepo.GetAll(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lost items: %w", err)
+	}
+
+	return items, nil
+}
+
+// PatchLostItem applies a JSON merge patch to an item. Returning or disposing
+// of the item closes it, storing it again reopens it.
+func (s *lostItemService) PatchLostItem(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.LostItem, error) {
+	ctx, span := tracer.Start(ctx, "LostItemService.PatchLostItem")
+	defer span.End()
+
+	existing, err := s.lostItemRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("lost item not found: %w", err)
+	}
+	if err := checkVersion(ifMatch, existing.Version); err != nil {
+		return nil, err
+	}
+
+	item, err := applyMergePatch(*existing, patch, "description", "storage_location", "status", "dispose_after")
+	if err != nil {
+		return nil, err
+	}
+	item.Id, item.RoomId, item.OrderId, item.BookingId = existing.Id, existing.RoomId, existing.OrderId, existing.BookingId
+	item.FoundAt, item.FoundBy, item.ClosedAt = existing.FoundAt, existing.FoundBy, existing.ClosedAt
+	item.Version, item.UpdatedAt = existing.Version, existing.UpdatedAt
+
+	if err := validateLostItem(&item); err != nil {
+		return nil, err
+	}
+
+	wasStored := existing.Status == models.LostItemStatusStored
+	stored := item.Status == models.LostItemStatusStored
+	if wasStored && !stored {
+		now := time.Now()
+		item.ClosedAt = &now
+	}
+	if stored && !wasStored {
+		item.ClosedAt = nil
+	}
+
+	if err := s.lostItemRepo.Update(ctx, &item); err != nil {
+		return nil, updateError("lost item", err)
+	}
+
+	slog.InfoContext(ctx, "lost item patched", "lost_item_id", item.Id, "status", item.Status)
+
+	return &item, nil
+}
+
+// GetLostItemsDueForDisposal retrieves the stored items whose retention
+// period has passed at the given time
+func (s *lostItemService) GetLostItemsDueForDisposal(ctx context.Context, at time.Time) ([]models.LostItem, error) {
+	ctx, span := tracer.Start(ctx, "LostItemService.GetLostItemsDueForDisposal")
+	defer span.End()
+
+	items, err := s.lostItemRepo.GetAll(ctx, repository.LostItemFilter{DueBefore: &at})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lost items: %w", err)
+	}
+
+	return items, nil
+}
+
+// validateLostItem checks the fields of an item that a patch may change
+func validateLostItem(item *models.LostItem) error {
+	if item.Description == "" {
+		return fmt.Errorf("description is required")
+	}
+	if item.StorageLocation == "" {
+		return fmt.Errorf("storage_location is required")
+	}
+	switch item.Status {
+	case models.LostItemStatusStored, models.LostItemStatusReturned, models.LostItemStatusDisposed:
+	default:
+		return fmt.Errorf("unknown status %q", item.Status)
+	}
+	if item.Status == models.LostItemStatusReturned && (item.ReturnedTo == nil || *item.ReturnedTo == "") {
+		return fmt.Errorf("returned_to is required when the item is returned")
+	}
+	if item.DisposeAfter.Before(item.FoundAt) {
+		return fmt.Errorf("dispose_after must not be before found_at")
+	}
+	return nil
+}
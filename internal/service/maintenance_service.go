@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// MaintenanceService defines the interface for maintenance ticket business operations
+type MaintenanceService interface {
+	CreateMaintenanceTicket(ctx context.Context, req *models.MaintenanceTicketCreateRequest) (*models.MaintenanceTicket, error)
+	GetMaintenanceTicket(ctx context.Context, id int) (*models.MaintenanceTicket, error)
+	GetAllMaintenanceTickets(ctx context.Context, status *string, roomID *int) ([]models.MaintenanceTicket, error)
+	PatchMaintenanceTicket(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.MaintenanceTicket, error)
+}
+
+// CreateMaintenanceTicket reports an issue in a room, optionally putting the
+// room out of order until the ticket is resolved
+func (s *maintenanceService) CreateMaintenanceTicket(ctx context.Context, req *models.MaintenanceTicketCreateRequest) (*models.MaintenanceTicket, error) {
+	ctx, span := tracer.Start(ctx, "MaintenanceService.CreateMaintenanceTicket")
+	defer span.End()
+
+	if req.Title == "" {
+		return nil, fmt.Errorf("title is required")
+	}
+
+	roomID := req.RoomId
+	if req.OrderId != nil {
+		order, err := s.cleaningOrderRepo.GetByID(ctx, *req.OrderId)
+		if err != nil {
+			return nil, fmt.Errorf("cleaning order not found: %w", err)
+		}
+		if order.RoomId == nil {
+			return nil, fmt.Errorf("cleaning order %d is not in a room", order.Id)
+		}
+		if roomID == nil {
+			roomID = order.RoomId
+		} else if *roomID != *order.RoomId {
+			return nil, fmt.Errorf("cleaning order %d is not in room %d", order.Id, *roomID)
+		}
+	}
+	if roomID == nil {
+		return nil, fmt.Errorf("room_id or order_id is required")
+	}
+	if _, err := s.roomRepo.GetByID(ctx, *roomID); err != nil {
+		return nil, fmt.Errorf("room not found: %w", err)
+	}
+	if req.ReportedBy != nil {
+		if _, err := s.cleanerRepo.GetByID(ctx, *req.ReportedBy); err != nil {
+			return nil, fmt.Errorf("cleaner not found: %w", err)
+		}
+	}
+
+	ticket := &models.MaintenanceTicket{
+		RoomId:      *roomID,
+		OrderId:     req.OrderId,
+		Title:       req.Title,
+		Description: req.Description,
+		Priority:    models.MaintenanceTicketPriorityNormal,
+		Status:      models.MaintenanceTicketStatusOpen,
+		Assignee:    req.Assignee,
+		ReportedBy:  req.ReportedBy,
+		OutOfOrder:  req.OutOfOrder != nil && *req.OutOfOrder,
+	}
+	if req.Priority != nil {
+		ticket.Priority = models.MaintenanceTicketPriority(*req.Priority)
+	}
+	if err := validateMaintenanceTicket(ticket); err != nil {
+		return nil, err
+	}
+
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if ticket.OutOfOrder {
+			if err := s.blockRoom(ctx, ticket, time.Now()); err != nil {
+				return err
+			}
+		}
+		if err := s.ticketRepo.Create(ctx, ticket); err != nil {
+			return fmt.Errorf("failed to create maintenance ticket: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "maintenance ticket created",
+		"ticket_id", ticket.Id,
+		"room_id", ticket.RoomId,
+		"priority", ticket.Priority,
+		"out_of_order", ticket.OutOfOrder,
+	)
+
+	return ticket, nil
+}
+
+// GetMaintenanceTicket retrieves a ticket by ID
+func (s *maintenanceService) GetMaintenanceTicket(ctx context.Context, id int) (*models.MaintenanceTicket, error) {
+	ctx, span := tracer.Start(ctx, "MaintenanceService.GetMaintenanceTicket")
+	defer span.End()
+
+	ticket, err := s.ticketRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("maintenance ticket not found: %w", err)
+	}
+
+	return ticket, nil
+}
+
+// GetAllMaintenanceTickets retrieves tickets, optionally of one status and room
+func (s *maintenanceService) GetAllMaintenanceTickets(ctx context.Context, status *string, roomID *int) ([]models.MaintenanceTicket, error) {
+	ctx, span := tracer.Start(ctx, "MaintenanceService.GetAllMaintenanceTickets")
+	defer span.End()
+
+	tickets, err := s.ticketRepo.GetAll(ctx, status, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get maintenance tickets: %w", err)
+	}
+
+	return tickets, nil
+}
+
+// PatchMaintenanceTicket applies a JSON merge patch to a ticket. Resolving
+// the ticket ends the out-of-order period of the room, reopening it or
+// switching out_of_order on starts a new one.
+func (s *maintenanceService) PatchMaintenanceTicket(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.MaintenanceTicket, error) {
+	ctx, span := tracer.Start(ctx, "MaintenanceService.PatchMaintenanceTicket")
+	defer span.End()
+
+	existing, err := s.ticketRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("maintenance ticket not found: %w", err)
+	}
+	if err := checkVersion(ifMatch, existing.Version); err != nil {
+		return nil, err
+	}
+
+	ticket, err := applyMergePatch(*existing, patch, "title", "priority", "status", "out_of_order")
+	if err != nil {
+		return nil, err
+	}
+	ticket.Id, ticket.RoomId, ticket.OrderId, ticket.ReportedBy = existing.Id, existing.RoomId, existing.OrderId, existing.ReportedBy
+	ticket.RoomBlockId, ticket.CreatedAt, ticket.ResolvedAt = existing.RoomBlockId, existing.CreatedAt, existing.ResolvedAt
+	ticket.Version, ticket.UpdatedAt = existing.Version, existing.UpdatedAt
+
+	if err := validateMaintenanceTicket(&ticket); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	wasResolved := existing.Status == models.MaintenanceTicketStatusResolved
+	resolved := ticket.Status == models.MaintenanceTicketStatusResolved
+	if resolved && !wasResolved {
+		ticket.ResolvedAt = &now
+	}
+	if !resolved && wasResolved {
+		ticket.ResolvedAt = nil
+	}
+	// The room is out of order while an unresolved ticket asks for it
+	wasBlocked := existing.OutOfOrder && !wasResolved
+	blocked := ticket.OutOfOrder && !resolved
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if wasBlocked && !blocked && existing.RoomBlockId != nil {
+			if err := s.roomBlockRepo.End(ctx, *existing.RoomBlockId, now); err != nil {
+				return fmt.Errorf("failed to end room block: %w", err)
+			}
+		}
+		if blocked && !wasBlocked {
+			if err := s.blockRoom(ctx, &ticket, now); err != nil {
+				return err
+			}
+		}
+		if err := s.ticketRepo.Update(ctx, &ticket); err != nil {
+			return updateError("maintenance ticket", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "maintenance ticket patched", "ticket_id", ticket.Id, "status", ticket.Status)
+
+	return &ticket, nil
+}
+
+// blockRoom puts the room of a ticket out of order from the given time on,
+// until the ticket is resolved
+func (s *maintenanceService) blockRoom(ctx context.Context, ticket *models.MaintenanceTicket, from time.Time) error {
+	block := &models.RoomBlock{
+		RoomId:   ticket.RoomId,
+		Kind:     models.RoomBlockKindOutOfOrder,
+		Reason:   "maintenance: " + ticket.Title,
+		StartsAt: from,
+	}
+	if err := s.roomBlockRepo.Create(ctx, block); err != nil {
+		return fmt.Errorf("failed to create room block: %w", err)
+	}
+	ticket.RoomBlockId = &block.Id
+
+	slog.InfoContext(ctx, "room blocked", "room_id", block.RoomId, "block_id", block.Id, "kind", block.Kind)
+
+	return nil
+}
+
+// validateMaintenanceTicket checks the editable fields of a ticket
+func validateMaintenanceTicket(ticket *models.MaintenanceTicket) error {
+	if ticket.Title == "" {
+		return fmt.Errorf("title is required")
+	}
+	switch ticket.Priority {
+	case models.MaintenanceTicketPriorityLow, models.MaintenanceTicketPriorityNormal,
+		models.MaintenanceTicketPriorityHigh, models.MaintenanceTicketPriorityUrgent:
+	default:
+		return fmt.Errorf("unknown priority %q", ticket.Priority)
+	}
+	switch ticket.Status {
+	case models.MaintenanceTicketStatusOpen, models.MaintenanceTicketStatusInProgress,
+		models.MaintenanceTicketStatusResolved:
+	default:
+		return fmt.Errorf("unknown status %q", ticket.Status)
+	}
+	return nil
+}
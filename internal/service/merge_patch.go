@@ -0,0 +1,68 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// applyMergePatch applies an RFC 7386 JSON merge patch to a copy of target.
+// Fields listed in required can't be removed by setting them to null.
+func applyMergePatch[T any](target T, patch []byte, required ...string) (T, error) {
+	var result T
+
+	var patchDoc map[string]interface{}
+	if err := json.Unmarshal(patch, &patchDoc); err != nil || patchDoc == nil {
+		return result, fmt.Errorf("merge patch must be a JSON object")
+	}
+	for _, field := range required {
+		if value, ok := patchDoc[field]; ok && value == nil {
+			return result, fmt.Errorf("%s cannot be null", field)
+		}
+	}
+
+	original, err := json.Marshal(target)
+	if err != nil {
+		return result, err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(original, &doc); err != nil {
+		return result, err
+	}
+
+	merged, err := json.Marshal(mergePatch(doc, patchDoc))
+	if err != nil {
+		return result, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(merged))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&result); err != nil {
+		return result, fmt.Errorf("invalid merge patch: %w", err)
+	}
+
+	return result, nil
+}
+
+// mergePatch implements the MergePatch function of RFC 7386
+func mergePatch(target interface{}, patch interface{}) interface{} {
+	patchObj, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObj, ok := target.(map[string]interface{})
+	if !ok {
+		targetObj = map[string]interface{}{}
+	}
+
+	for key, value := range patchObj {
+		if value == nil {
+			delete(targetObj, key)
+			continue
+		}
+		targetObj[key] = mergePatch(targetObj[key], value)
+	}
+
+	return targetObj
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
+)
+
+// PropertyService defines the interface for property business operations
+type PropertyService interface {
+	CreateProperty(ctx context.Context, req *models.PropertyCreateRequest) (*models.Property, error)
+	GetProperty(ctx context.Context, id int) (*models.Property, error)
+	GetAllProperties(ctx context.Context, user *string) ([]models.Property, error)
+	PatchProperty(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Property, error)
+	GetPropertyUsers(ctx context.Context, id int) ([]models.PropertyUser, error)
+	AuthorizePropertyUser(ctx context.Context, id int, user string) (*models.PropertyUser, error)
+	RevokePropertyUser(ctx context.Context, id int, user string) error
+	IsPropertyUser(ctx context.Context, id int, user string) (bool, error)
+}
+
+// propertyService implements PropertyService
+type propertyService struct {
+	propertyRepo repository.PropertyRepository
+}
+
+// NewPropertyService creates a new property service
+func NewPropertyService(propertyRepo repository.PropertyRepository) PropertyService {
+	return &propertyService{
+		propertyRepo: propertyRepo,
+	}
+}
+
+// CreateProperty adds a property
+func (s *propertyService) CreateProperty(ctx context.Context, req *models.PropertyCreateRequest) (*models.Property, error) {
+	ctx, span := tracer.Start(ctx, "PropertyService.CreateProperty")
+	defer span.End()
+
+	property := &models.Property{
+		Name:    req.Name,
+		Address: req.Address,
+	}
+	if err := validateProperty(property); err != nil {
+		return nil, err
+	}
+
+	if err := s.propertyRepo.Create(ctx, property); err != nil {
+		return nil, fmt.Errorf("failed to create property: %w", err)
+	}
+
+	slog.InfoContext(ctx, "property created", "property_id", property.Id, "name", property.Name)
+
+	return property, nil
+}
+
+// GetProperty retrieves a property by ID
+func (s *propertyService) GetProperty(ctx context.Context, id int) (*models.Property, error) {
+	ctx, span := tracer.Start(ctx, "PropertyService.GetProperty")
+	defer span.End()
+
+	property, err := s.propertyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("property not found: %w", err)
+	}
+
+	return property, nil
+}
+
+// GetAllProperties retrieves the properties a user is authorized for, all
+// of them if user is nil
+func (s *propertyService) GetAllProperties(ctx context.Context, user *string) ([]models.Property, error) {
+	ctx, span := tracer.Start(ctx, "PropertyService.GetAllProperties")
+	defer span.End()
+
+	properties, err := s.propertyRepo.GetAll(ctx, user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get properties: %w", err)
+	}
+
+	return properties, nil
+}
+
+// PatchProperty applies a JSON merge patch to a property
+func (s *propertyService) PatchProperty(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Property, error) {
+	ctx, span := tracer.Start(ctx, "PropertyService.PatchProperty")
+	defer span.End()
+
+	existing, err := s.propertyRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("property not found: %w", err)
+	}
+	if err := checkVersion(ifMatch, existing.Version); err != nil {
+		return nil, err
+	}
+
+	property, err := applyMergePatch(*existing, patch, "name")
+	if err != nil {
+		return nil, err
+	}
+	property.Id, property.Version, property.UpdatedAt = existing.Id, existing.Version, existing.UpdatedAt
+
+	if err := validateProperty(&property); err != nil {
+		return nil, err
+	}
+
+	if err := s.propertyRepo.Update(ctx, &property); err != nil {
+		return nil, updateError("property", err)
+	}
+
+	slog.InfoContext(ctx, "property patched", "property_id", property.Id)
+
+	return &property, nil
+}
+
+// GetPropertyUsers retrieves the users authorized for a property
+func (s *propertyService) GetPropertyUsers(ctx context.Context, id int) ([]models.PropertyUser, error) {
+	ctx, span := tracer.Start(ctx, "PropertyService.GetPropertyUsers")
+	defer span.End()
+
+	if _, err := s.propertyRepo.GetByID(ctx, id); err != nil {
+		return nil, fmt.Errorf("property not found: %w", err)
+	}
+
+	users, err := s.propertyRepo.GetUsers(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get property users: %w", err)
+	}
+
+	return users, nil
+}
+
+// AuthorizePropertyUser allows a user to access a property
+func (s *propertyService) AuthorizePropertyUser(ctx context.Context, id int, user string) (*models.PropertyUser, error) {
+	ctx, span := tracer.Start(ctx, "PropertyService.AuthorizePropertyUser")
+	defer span.End()
+
+	if strings.TrimSpace(user) == "" {
+		return nil, fmt.Errorf("user is required")
+	}
+	if _, err := s.propertyRepo.GetByID(ctx, id); err != nil {
+		return nil, fmt.Errorf("property not found: %w", err)
+	}
+
+	propertyUser, err := s.propertyRepo.AddUser(ctx, id, user)
+	if err != nil {
+		return nil, fmt.Errorf("failed to authorize user: %w", err)
+	}
+
+	slog.InfoContext(ctx, "property user authorized", "property_id", id, "user", user)
+
+	return propertyUser, nil
+}
+
+// RevokePropertyUser withdraws the access of a user to a property
+func (s *propertyService) RevokePropertyUser(ctx context.Context, id int, user string) error {
+	ctx, span := tracer.Start(ctx, "PropertyService.RevokePropertyUser")
+	defer span.End()
+
+	if err := s.propertyRepo.RemoveUser(ctx, id, user); err != nil {
+		return fmt.Errorf("property user not found: %w", err)
+	}
+
+	slog.InfoContext(ctx, "property user revoked", "property_id", id, "user", user)
+
+	return nil
+}
+
+// IsPropertyUser reports whether a user is authorized for a property
+func (s *propertyService) IsPropertyUser(ctx context.Context, id int, user string) (bool, error) {
+	ctx, span := tracer.Start(ctx, "PropertyService.IsPropertyUser")
+	defer span.End()
+
+	authorized, err := s.propertyRepo.IsAuthorized(ctx, id, user)
+	if err != nil {
+		return false, fmt.Errorf("failed to check property user: %w", err)
+	}
+
+	return authorized, nil
+}
+
+// validateProperty checks the fields of a property
+func validateProperty(property *models.Property) error {
+	if strings.TrimSpace(property.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
+	"github.com/StEvseeva/cleany/internal/storage"
+)
+
+// errPurgeDryRun rolls back the purge transaction of a dry run
+var errPurgeDryRun = errors.New("dry run")
+
+// PurgeService permanently removes deleted records
+type PurgeService interface {
+	PurgeDeleted(ctx context.Context, deletedBefore time.Time, dryRun bool) (*PurgeResult, error)
+	AnonymizeGuests(ctx context.Context, departedBefore time.Time, dryRun bool) (int64, error)
+}
+
+// PurgeResult holds the number of removed records
+type PurgeResult struct {
+	Bookings    int64
+	Cleaners    int64
+	Rooms       int64
+	Attachments int64
+}
+
+// purgeService implements PurgeService
+type purgeService struct {
+	roomRepo       repository.RoomRepository
+	cleanerRepo    repository.CleanerRepository
+	bookingRepo    repository.BookingRepository
+	attachmentRepo repository.AttachmentRepository
+	store          storage.BlobStore
+	transactor     repository.Transactor
+}
+
+// NewPurgeService creates a new purge service
+func NewPurgeService(
+	roomRepo repository.RoomRepository,
+	cleanerRepo repository.CleanerRepository,
+	bookingRepo repository.BookingRepository,
+	attachmentRepo repository.AttachmentRepository,
+	store storage.BlobStore,
+	transactor repository.Transactor,
+) PurgeService {
+	return &purgeService{
+		roomRepo:       roomRepo,
+		cleanerRepo:    cleanerRepo,
+		bookingRepo:    bookingRepo,
+		attachmentRepo: attachmentRepo,
+		store:          store,
+		transactor:     transactor,
+	}
+}
+
+// PurgeDeleted removes records deleted before the given time in a single
+// transaction. Bookings go first so that rooms left without bookings can be
+// removed too. The files of the attachments of removed cleaning orders are
+// deleted once the transaction is committed. A dry run counts the records
+// and rolls the transaction back.
+func (s *purgeService) PurgeDeleted(ctx context.Context, deletedBefore time.Time, dryRun bool) (*PurgeResult, error) {
+	ctx, span := tracer.Start(ctx, "PurgeService.PurgeDeleted")
+	defer span.End()
+
+	result := &PurgeResult{}
+	var attachments []models.Attachment
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		// The attachments go with their orders when bookings and rooms are removed
+		if attachments, err = s.attachmentRepo.GetPurgeable(ctx, deletedBefore); err != nil {
+			return fmt.Errorf("failed to get attachments: %w", err)
+		}
+		result.Attachments = int64(len(attachments))
+		if result.Bookings, err = s.bookingRepo.Purge(ctx, deletedBefore); err != nil {
+			return fmt.Errorf("failed to purge bookings: %w", err)
+		}
+		if result.Cleaners, err = s.cleanerRepo.Purge(ctx, deletedBefore); err != nil {
+			return fmt.Errorf("failed to purge cleaners: %w", err)
+		}
+		if result.Rooms, err = s.roomRepo.Purge(ctx, deletedBefore); err != nil {
+			return fmt.Errorf("failed to purge rooms: %w", err)
+		}
+		if dryRun {
+			return errPurgeDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errPurgeDryRun) {
+		return nil, err
+	}
+
+	if !dryRun {
+		for i := range attachments {
+			deleteAttachmentBlobs(ctx, s.store, &attachments[i])
+		}
+	}
+
+	slog.InfoContext(ctx, "deleted records purged",
+		"deleted_before", deletedBefore, "dry_run", dryRun,
+		"bookings", result.Bookings, "cleaners", result.Cleaners, "rooms", result.Rooms,
+		"attachments", result.Attachments)
+
+	return result, nil
+}
+
+// AnonymizeGuests erases the personal data of guests who left before the
+// given time. A dry run counts the bookings and rolls the transaction back.
+func (s *purgeService) AnonymizeGuests(ctx context.Context, departedBefore time.Time, dryRun bool) (int64, error) {
+	ctx, span := tracer.Start(ctx, "PurgeService.AnonymizeGuests")
+	defer span.End()
+
+	var count int64
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		if count, err = s.bookingRepo.AnonymizeDeparted(ctx, departedBefore); err != nil {
+			return fmt.Errorf("failed to anonymize bookings: %w", err)
+		}
+		if dryRun {
+			return errPurgeDryRun
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errPurgeDryRun) {
+		return 0, err
+	}
+
+	slog.InfoContext(ctx, "guest data anonymized",
+		"departed_before", departedBefore, "dry_run", dryRun, "bookings", count)
+
+	return count, nil
+}
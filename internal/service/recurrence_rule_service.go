@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+// RecurrenceRuleService defines the interface for the recurring cleanings of
+// rooms and zones that are not tied to bookings
+type RecurrenceRuleService interface {
+	CreateRecurrenceRule(ctx context.Context, req *models.RecurrenceRuleCreateRequest) (*models.RecurrenceRule, error)
+	GetRecurrenceRule(ctx context.Context, id int) (*models.RecurrenceRule, error)
+	GetAllRecurrenceRules(ctx context.Context) ([]models.RecurrenceRule, error)
+	PatchRecurrenceRule(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.RecurrenceRule, error)
+	DeleteRecurrenceRule(ctx context.Context, id int) error
+	GenerateRecurringOrders(ctx context.Context, until time.Time) (int, error)
+}
+
+// CreateRecurrenceRule adds a recurring cleaning and generates its orders
+// up to the recurrence horizon
+func (s *cleaningOrderService) CreateRecurrenceRule(ctx context.Context, req *models.RecurrenceRuleCreateRequest) (*models.RecurrenceRule, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.CreateRecurrenceRule")
+	defer span.End()
+
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+	rule := &models.RecurrenceRule{
+		RoomId:       req.RoomId,
+		ZoneId:       req.ZoneId,
+		CleaningType: req.CleaningType,
+		Rrule:        req.Rrule,
+		StartsAt:     req.StartsAt.UTC(),
+		Cost:         req.Cost,
+		Notes:        req.Notes,
+		Active:       active,
+	}
+	if err := s.checkRecurrenceRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	var generated int
+	now := time.Now()
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.ruleRepo.Create(ctx, rule); err != nil {
+			return fmt.Errorf("failed to create recurrence rule: %w", err)
+		}
+		var err error
+		generated, err = s.generateRuleOrders(ctx, rule, now, now.Add(s.schedule.RecurrenceHorizon))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "recurrence rule created", "rule_id", rule.Id, "orders", generated)
+
+	return rule, nil
+}
+
+// GetRecurrenceRule retrieves a recurrence rule by ID
+func (s *cleaningOrderService) GetRecurrenceRule(ctx context.Context, id int) (*models.RecurrenceRule, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.GetRecurrenceRule")
+	defer span.End()
+
+	rule, err := s.ruleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("recurrence rule not found: %w", err)
+	}
+
+	return rule, nil
+}
+
+// GetAllRecurrenceRules retrieves all recurrence rules
+func (s *cleaningOrderService) GetAllRecurrenceRules(ctx context.Context) ([]models.RecurrenceRule, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.GetAllRecurrenceRules")
+	defer span.End()
+
+	rules, err := s.ruleRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recurrence rules: %w", err)
+	}
+
+	return rules, nil
+}
+
+// PatchRecurrenceRule applies a JSON merge patch to a recurrence rule. The
+// pending orders of the rule are replaced by those of the changed rule.
+func (s *cleaningOrderService) PatchRecurrenceRule(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.RecurrenceRule, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.PatchRecurrenceRule")
+	defer span.End()
+
+	existing, err := s.ruleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("recurrence rule not found: %w", err)
+	}
+	if err := checkVersion(ifMatch, existing.Version); err != nil {
+		return nil, err
+	}
+
+	rule, err := applyMergePatch(*existing, patch, "cleaning_type", "rrule", "starts_at", "active")
+	if err != nil {
+		return nil, err
+	}
+	rule.Id, rule.Version, rule.UpdatedAt = existing.Id, existing.Version, existing.UpdatedAt
+	rule.StartsAt = rule.StartsAt.UTC()
+
+	if err := s.checkRecurrenceRule(ctx, &rule); err != nil {
+		return nil, err
+	}
+
+	var replaced int64
+	var generated int
+	now := time.Now()
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.ruleRepo.Update(ctx, &rule); err != nil {
+			return updateError("recurrence rule", err)
+		}
+		var err error
+		replaced, err = s.cleaningOrderRepo.DeletePendingByRule(ctx, rule.Id, now)
+		if err != nil {
+			return fmt.Errorf("failed to delete pending orders: %w", err)
+		}
+		generated, err = s.generateRuleOrders(ctx, &rule, now, now.Add(s.schedule.RecurrenceHorizon))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slog.InfoContext(ctx, "recurrence rule patched", "rule_id", rule.Id, "replaced", replaced, "orders", generated)
+
+	return &rule, nil
+}
+
+// DeleteRecurrenceRule removes a recurrence rule together with its pending
+// orders, orders that are done or assigned are kept
+func (s *cleaningOrderService) DeleteRecurrenceRule(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.DeleteRecurrenceRule")
+	defer span.End()
+
+	if _, err := s.ruleRepo.GetByID(ctx, id); err != nil {
+		return fmt.Errorf("recurrence rule not found: %w", err)
+	}
+
+	var deleted int64
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		deleted, err = s.cleaningOrderRepo.DeletePendingByRule(ctx, id, time.Now())
+		if err != nil {
+			return fmt.Errorf("failed to delete pending orders: %w", err)
+		}
+		if err := s.ruleRepo.Delete(ctx, id); err != nil {
+			return fmt.Errorf("recurrence rule not found: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "recurrence rule deleted", "rule_id", id, "orders_deleted", deleted)
+
+	return nil
+}
+
+// GenerateRecurringOrders creates the orders of the active recurrence rules
+// due from now or from where the previous run stopped until the given time,
+// and returns how many were created
+func (s *cleaningOrderService) GenerateRecurringOrders(ctx context.Context, until time.Time) (int, error) {
+	ctx, span := tracer.Start(ctx, "CleaningOrderService.GenerateRecurringOrders")
+	defer span.End()
+
+	rules, err := s.ruleRepo.GetActive(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get recurrence rules: %w", err)
+	}
+
+	total := 0
+	now := time.Now()
+	for i := range rules {
+		rule := &rules[i]
+		from := now
+		if rule.GeneratedUntil != nil && rule.GeneratedUntil.After(from) {
+			from = *rule.GeneratedUntil
+		}
+		var generated int
+		err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+			var err error
+			generated, err = s.generateRuleOrders(ctx, rule, from, until)
+			return err
+		})
+		if err != nil {
+			return total, fmt.Errorf("recurrence rule %d: %w", rule.Id, err)
+		}
+		total += generated
+	}
+
+	slog.InfoContext(ctx, "recurring cleaning orders generated", "rules", len(rules), "orders", total)
+
+	return total, nil
+}
+
+// generateRuleOrders creates the orders of an active rule due from..to that
+// do not exist yet and records that the rule has been generated until to.
+// The occurrences are counted in the hotel zone and stored in UTC, so that
+// an order generated twice has the same cleaning_ts.
+func (s *cleaningOrderService) generateRuleOrders(ctx context.Context, rule *models.RecurrenceRule, from, to time.Time) (int, error) {
+	if !rule.Active || !to.After(from) {
+		return 0, nil
+	}
+
+	recurrence, err := parseRRule(rule.Rrule, s.schedule.Location)
+	if err != nil {
+		return 0, err
+	}
+
+	cost, err := s.ruleCost(ctx, rule)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	for _, cleaningTs := range recurrence.between(rule.StartsAt, from, to, s.schedule.Location) {
+		id, err := s.cleaningOrderRepo.CreateFromRule(ctx, rule, cleaningTs.UTC(), cost)
+		if errors.Is(err, sql.ErrNoRows) {
+			// Generated by a previous run
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to create cleaning order: %w", err)
+		}
+		if err := s.instantiateChecklists(ctx, id); err != nil {
+			return 0, err
+		}
+		created++
+	}
+
+	until := to.UTC()
+	if err := s.ruleRepo.SetGeneratedUntil(ctx, rule.Id, &until); err != nil {
+		return 0, fmt.Errorf("failed to update recurrence rule: %w", err)
+	}
+	rule.GeneratedUntil = &until
+
+	return created, nil
+}
+
+// ruleCost returns the cost of the orders of a rule, the cost of the rule
+// if it has one, otherwise the cost of its cleaning type for the room
+func (s *cleaningOrderService) ruleCost(ctx context.Context, rule *models.RecurrenceRule) (int, error) {
+	if rule.Cost != nil {
+		return *rule.Cost, nil
+	}
+	var roomType *models.RoomType
+	if rule.RoomId != nil {
+		var err error
+		roomType, err = roomTypeOf(ctx, s.roomTypeRepo, *rule.RoomId)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return countOrderCost(roomType, rule.CleaningType, s.schedule), nil
+}
+
+// checkRecurrenceRule validates the fields of a rule and that its room or
+// zone exists
+func (s *cleaningOrderService) checkRecurrenceRule(ctx context.Context, rule *models.RecurrenceRule) error {
+	if rule.CleaningType == "" {
+		return fmt.Errorf("cleaning_type is required")
+	}
+	if rule.Cost != nil && *rule.Cost < 0 {
+		return fmt.Errorf("cost must be non-negative")
+	}
+	if _, err := parseRRule(rule.Rrule, s.schedule.Location); err != nil {
+		return err
+	}
+	if rule.RoomId == nil && rule.ZoneId == nil {
+		return fmt.Errorf("room_id or zone_id is required")
+	}
+	if _, _, err := s.orderTarget(ctx, nil, rule.RoomId, rule.ZoneId); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+)
+
+func TestGenerateRuleOrders(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone data not available: %v", err)
+	}
+	utc := func(year int, month time.Month, day, hour int) time.Time {
+		return time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+	}
+	// 10:00 CET is 09:00 UTC, 10:00 CEST from March 30 is 08:00 UTC
+	want := []time.Time{utc(2025, time.March, 29, 9), utc(2025, time.March, 30, 8), utc(2025, time.March, 31, 8)}
+
+	tests := []struct {
+		name     string
+		startsAt time.Time
+	}{
+		// As sent by a client
+		{name: "start in the hotel zone", startsAt: time.Date(2025, time.March, 28, 10, 0, 0, 0, berlin)},
+		// As read back from the database
+		{name: "start in utc", startsAt: utc(2025, time.March, 28, 9)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule := DefaultSchedule()
+			schedule.Location = berlin
+			orders := &fakeCleaningOrderRepo{}
+			rules := &fakeRuleRepo{}
+			s := &cleaningOrderService{
+				cleaningOrderRepo: orders,
+				checklistRepo:     fakeChecklistRepo{},
+				ruleRepo:          rules,
+				schedule:          schedule,
+			}
+			cost := 50
+			rule := &models.RecurrenceRule{Id: 1, CleaningType: "general", Rrule: "FREQ=DAILY", StartsAt: tt.startsAt, Cost: &cost, Active: true}
+
+			from := time.Date(2025, time.March, 29, 0, 0, 0, 0, berlin)
+			to := time.Date(2025, time.April, 1, 0, 0, 0, 0, berlin)
+			created, err := s.generateRuleOrders(context.Background(), rule, from, to)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if created != len(want) || len(orders.ruleOrders) != len(want) {
+				t.Fatalf("created %d orders %v, want %v", created, orders.ruleOrders, want)
+			}
+			for i, cleaningTs := range orders.ruleOrders {
+				if cleaningTs.Location() != time.UTC || !cleaningTs.Equal(want[i]) {
+					t.Errorf("order %d at %v, want %v", i, cleaningTs, want[i])
+				}
+			}
+			if until := rules.generatedUntil[rule.Id]; until.Location() != time.UTC || !until.Equal(to) {
+				t.Errorf("generated until %v, want %v", until, to.UTC())
+			}
+		})
+	}
+}
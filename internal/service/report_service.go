@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
+)
+
+// defaultForecastDays is the length of a forecast without end date,
+// maxForecastDays the longest forecast
+const (
+	defaultForecastDays = 7
+	maxForecastDays     = 92
+)
+
+// defaultKPIDays is the length of a KPI report without start date,
+// maxKPIDays the longest KPI report
+const (
+	defaultKPIDays = 30
+	maxKPIDays     = 366
+)
+
+// ReportService defines the interface for management reports
+type ReportService interface {
+	GetForecast(ctx context.Context, from, to *time.Time) ([]models.ForecastDay, error)
+	GetOccupancy(ctx context.Context, from, to *time.Time, groupBy string) ([]models.OccupancyRow, error)
+	GetCleaningTime(ctx context.Context, from, to *time.Time, groupBy string) ([]models.CleaningTimeRow, error)
+	GetCleanerWorkload(ctx context.Context, from, to *time.Time, groupBy string) ([]models.CleanerWorkloadRow, error)
+	GetOnTime(ctx context.Context, from, to *time.Time, groupBy string) ([]models.OnTimeRow, error)
+	GetCostPerNight(ctx context.Context, from, to *time.Time, groupBy string) ([]models.CostPerNightRow, error)
+}
+
+// reportService implements ReportService
+type reportService struct {
+	bookingRepo  repository.BookingRepository
+	roomTypeRepo repository.RoomTypeRepository
+	shiftRepo    repository.ShiftRepository
+	reportRepo   repository.ReportRepository
+	schedule     Schedule
+}
+
+// NewReportService creates a new report service
+func NewReportService(
+	bookingRepo repository.BookingRepository,
+	roomTypeRepo repository.RoomTypeRepository,
+	shiftRepo repository.ShiftRepository,
+	reportRepo repository.ReportRepository,
+	schedule Schedule,
+) ReportService {
+	return &reportService{
+		bookingRepo:  bookingRepo,
+		roomTypeRepo: roomTypeRepo,
+		shiftRepo:    shiftRepo,
+		reportRepo:   reportRepo,
+		schedule:     schedule,
+	}
+}
+
+// GetForecast projects the cleaning workload of the days from..to in the
+// hotel time zone and compares it with the scheduled shifts. The orders are
+// generated from the bookings as for new bookings, so manual changes of the
+// existing orders are not taken into account.
+func (s *reportService) GetForecast(ctx context.Context, from, to *time.Time) ([]models.ForecastDay, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.GetForecast")
+	defer span.End()
+
+	start, end, err := s.reportPeriod(from, to, defaultForecastDays, maxForecastDays)
+	if err != nil {
+		return nil, err
+	}
+
+	days := []models.ForecastDay{}
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		days = append(days, models.ForecastDay{Date: openapi_types.Date{Time: day}})
+	}
+
+	// general cleanings of bookings that ended shortly before the period fall into it
+	bookings, err := s.bookingRepo.GetForPeriod(ctx, start.Add(-s.schedule.GeneralCleaningDelay), end)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bookings: %w", err)
+	}
+	roomTypes := map[int]*models.RoomType{}
+	for _, booking := range bookings {
+		roomType, ok := roomTypes[booking.RoomId]
+		if !ok {
+			roomType, err = roomTypeOf(ctx, s.roomTypeRepo, booking.RoomId)
+			if err != nil {
+				return nil, err
+			}
+			roomTypes[booking.RoomId] = roomType
+		}
+		orders, err := collectOrdersQueue(booking, roomType, s.schedule)
+		if err != nil {
+			return nil, fmt.Errorf("booking %d: %w", booking.Id, err)
+		}
+		for _, order := range orders {
+			i := dayIndex(start, order.CleaningTs, s.schedule.Location)
+			if i < 0 || i >= len(days) {
+				continue
+			}
+			days[i].Orders++
+			days[i].RequiredMinutes += int(cleaningDuration(*order.CleaningType, s.schedule).Minutes())
+		}
+	}
+
+	shifts, err := s.shiftRepo.GetAll(ctx, repository.ShiftFilter{From: &start, To: &end})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shifts: %w", err)
+	}
+	cleaners := make([]map[int]bool, len(days))
+	for _, shift := range shifts {
+		for i := range days {
+			dayStart := days[i].Date.Time
+			dayEnd := dayStart.AddDate(0, 0, 1)
+			worked := overlap(shift.StartTs, shift.EndTs, dayStart, dayEnd)
+			if worked <= 0 {
+				continue
+			}
+			days[i].CapacityMinutes += int(worked.Minutes())
+			if cleaners[i] == nil {
+				cleaners[i] = map[int]bool{}
+			}
+			cleaners[i][shift.CleanerId] = true
+		}
+	}
+
+	for i := range days {
+		day := &days[i]
+		day.Cleaners = len(cleaners[i])
+		day.BalanceMinutes = day.CapacityMinutes - day.RequiredMinutes
+		day.Staffing = staffing(day.RequiredMinutes, day.CapacityMinutes, s.schedule.StaffingTolerance)
+	}
+
+	return days, nil
+}
+
+// GetOccupancy reports the occupied share of the room nights of the days
+// from..to, by day unless grouped otherwise
+func (s *reportService) GetOccupancy(ctx context.Context, from, to *time.Time, groupBy string) ([]models.OccupancyRow, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.GetOccupancy")
+	defer span.End()
+
+	start, end, err := s.kpiPeriod(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if groupBy == "" {
+		groupBy = "day"
+	}
+
+	report, err := s.reportRepo.Occupancy(ctx, start, end, s.schedule.Location, groupBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get occupancy: %w", err)
+	}
+	return report, nil
+}
+
+// GetCleaningTime reports the average time the done orders scheduled on the
+// days from..to took, by cleaning type unless grouped otherwise
+func (s *reportService) GetCleaningTime(ctx context.Context, from, to *time.Time, groupBy string) ([]models.CleaningTimeRow, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.GetCleaningTime")
+	defer span.End()
+
+	start, end, err := s.kpiPeriod(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if groupBy == "" {
+		groupBy = "cleaning_type"
+	}
+
+	report, err := s.reportRepo.CleaningTime(ctx, start, end, s.schedule.Location, groupBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cleaning time: %w", err)
+	}
+	return report, nil
+}
+
+// GetCleanerWorkload reports the orders per cleaner and day of the days
+// from..to, by cleaner unless grouped otherwise
+func (s *reportService) GetCleanerWorkload(ctx context.Context, from, to *time.Time, groupBy string) ([]models.CleanerWorkloadRow, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.GetCleanerWorkload")
+	defer span.End()
+
+	start, end, err := s.kpiPeriod(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if groupBy == "" {
+		groupBy = "cleaner"
+	}
+
+	report, err := s.reportRepo.CleanerWorkload(ctx, start, end, s.schedule.Location, groupBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cleaner workload: %w", err)
+	}
+	return report, nil
+}
+
+// GetOnTime reports the share of the orders scheduled on the days from..to
+// that were done in time, by day unless grouped otherwise
+func (s *reportService) GetOnTime(ctx context.Context, from, to *time.Time, groupBy string) ([]models.OnTimeRow, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.GetOnTime")
+	defer span.End()
+
+	start, end, err := s.kpiPeriod(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if groupBy == "" {
+		groupBy = "day"
+	}
+
+	report, err := s.reportRepo.OnTime(ctx, start, end, s.schedule.Location, time.Now(), groupBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get on-time rate: %w", err)
+	}
+	return report, nil
+}
+
+// GetCostPerNight reports the cleaning cost per occupied room night of the
+// days from..to, by day unless grouped otherwise
+func (s *reportService) GetCostPerNight(ctx context.Context, from, to *time.Time, groupBy string) ([]models.CostPerNightRow, error) {
+	ctx, span := tracer.Start(ctx, "ReportService.GetCostPerNight")
+	defer span.End()
+
+	start, end, err := s.kpiPeriod(from, to)
+	if err != nil {
+		return nil, err
+	}
+	if groupBy == "" {
+		groupBy = "day"
+	}
+
+	report, err := s.reportRepo.CostPerNight(ctx, start, end, s.schedule.Location, groupBy)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cost per night: %w", err)
+	}
+	return report, nil
+}
+
+// kpiPeriod returns the period of a KPI report, which ends today and covers
+// the default number of days if the days are not given
+func (s *reportService) kpiPeriod(from, to *time.Time) (time.Time, time.Time, error) {
+	last := localDate(time.Now(), s.schedule.Location)
+	if to != nil {
+		last = *to
+	}
+	first := last.AddDate(0, 0, 1-defaultKPIDays)
+	if from != nil {
+		first = *from
+	}
+	return s.reportPeriod(&first, &last, defaultKPIDays, maxKPIDays)
+}
+
+// reportPeriod returns the start of the first and the end of the last day
+// of a report in the hotel time zone, from today and the given number of
+// days if the days are not given. A report covers at most maxDays.
+func (s *reportService) reportPeriod(from, to *time.Time, defaultDays, maxDays int) (time.Time, time.Time, error) {
+	start := localDate(time.Now(), s.schedule.Location)
+	if from != nil {
+		start = time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, start.Location())
+	}
+	end := start.AddDate(0, 0, defaultDays)
+	if to != nil {
+		end = time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, start.Location()).AddDate(0, 0, 1)
+	}
+	if !end.After(start) {
+		return start, end, fmt.Errorf("to must not be before from")
+	}
+	if end.After(start.AddDate(0, 0, maxDays)) {
+		return start, end, fmt.Errorf("a report can cover at most %d days", maxDays)
+	}
+	return start, end, nil
+}
+
+// dayIndex returns the number of hotel days between the day starting at
+// start and the day of t
+func dayIndex(start, t time.Time, loc *time.Location) int {
+	return int(math.Round(localDate(t, loc).Sub(start).Hours() / 24))
+}
+
+// overlap returns how long [start, end) and [from, to) overlap
+func overlap(start, end, from, to time.Time) time.Duration {
+	if start.Before(from) {
+		start = from
+	}
+	if end.After(to) {
+		end = to
+	}
+	return end.Sub(start)
+}
+
+// staffing rates the shift capacity of a day against its workload: under if
+// it does not cover the workload, over if it exceeds it by more than the
+// tolerance, a fraction of the workload
+func staffing(required, capacity int, tolerance float64) models.ForecastDayStaffing {
+	if capacity < required {
+		return models.ForecastDayStaffingUnder
+	}
+	if float64(capacity) > float64(required)*(1+tolerance) {
+		return models.ForecastDayStaffingOver
+	}
+	return models.ForecastDayStaffingOk
+}
@@ -3,30 +3,51 @@ package service
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/StEvseeva/cleany/internal/models"
 )
 
 // RoomService defines the interface for room business operations
 type RoomService interface {
+	RoomTypeService
 	CreateRoom(ctx context.Context, req *models.RoomCreateRequest) (*models.Room, error)
-	GetRoom(ctx context.Context, id int) (*models.Room, error)
-	GetAllRooms(ctx context.Context) ([]models.Room, error)
-	UpdateRoom(ctx context.Context, id int, req *models.RoomUpdateRequest) (*models.Room, error)
+	GetRoom(ctx context.Context, id int, includeDeleted bool) (*models.Room, error)
+	GetAllRooms(ctx context.Context, includeDeleted bool) ([]models.Room, error)
+	UpdateRoom(ctx context.Context, id int, req *models.RoomUpdateRequest, ifMatch *int) (*models.Room, error)
+	PatchRoom(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Room, error)
 	DeleteRoom(ctx context.Context, id int) error
+	RestoreRoom(ctx context.Context, id int) (*models.Room, error)
+	SetRoomStatus(ctx context.Context, id int, req *models.RoomStatusUpdateRequest) (*models.Room, error)
+	CreateRoomBlock(ctx context.Context, roomID int, req *models.RoomBlockCreateRequest) (*models.RoomBlock, error)
+	GetRoomBlocks(ctx context.Context, roomID int) ([]models.RoomBlock, error)
+	DeleteRoomBlock(ctx context.Context, roomID, blockID int) error
 }
 
 // CreateRoom creates a new room with validation
 func (s *roomService) CreateRoom(ctx context.Context, req *models.RoomCreateRequest) (*models.Room, error) {
+	ctx, span := tracer.Start(ctx, "RoomService.CreateRoom")
+	defer span.End()
+
 	// Validate input
 	if req.Floor < 0 {
 		return nil, fmt.Errorf("floor number must be non-negative")
 	}
 
+	if err := s.checkRoomType(ctx, req.RoomTypeId); err != nil {
+		return nil, err
+	}
+
 	// Create room
 	room := &models.Room{
-		Floor: req.Floor,
-		Desc:  req.Desc,
+		Floor:      req.Floor,
+		Desc:       req.Desc,
+		RoomTypeId: req.RoomTypeId,
+		ZoneId:     req.ZoneId,
+	}
+	if err := s.placeRoom(ctx, room); err != nil {
+		return nil, err
 	}
 
 	err := s.roomRepo.Create(ctx, room)
@@ -34,12 +55,21 @@ func (s *roomService) CreateRoom(ctx context.Context, req *models.RoomCreateRequ
 		return nil, fmt.Errorf("failed to create room: %w", err)
 	}
 
+	slog.InfoContext(ctx, "room created", "room_id", room.Id)
+
 	return room, nil
 }
 
-// GetRoom retrieves a room by ID
-func (s *roomService) GetRoom(ctx context.Context, id int) (*models.Room, error) {
-	room, err := s.roomRepo.GetByID(ctx, id)
+// GetRoom retrieves a room by ID, a deleted one only if includeDeleted is set
+func (s *roomService) GetRoom(ctx context.Context, id int, includeDeleted bool) (*models.Room, error) {
+	ctx, span := tracer.Start(ctx, "RoomService.GetRoom")
+	defer span.End()
+
+	get := s.roomRepo.GetByID
+	if includeDeleted {
+		get = s.roomRepo.GetByIDWithDeleted
+	}
+	room, err := get(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("room not found: %w", err)
 	}
@@ -47,9 +77,12 @@ func (s *roomService) GetRoom(ctx context.Context, id int) (*models.Room, error)
 	return room, nil
 }
 
-// GetAllRooms retrieves all rooms
-func (s *roomService) GetAllRooms(ctx context.Context) ([]models.Room, error) {
-	rooms, err := s.roomRepo.GetAll(ctx)
+// GetAllRooms retrieves all rooms, deleted ones only if includeDeleted is set
+func (s *roomService) GetAllRooms(ctx context.Context, includeDeleted bool) ([]models.Room, error) {
+	ctx, span := tracer.Start(ctx, "RoomService.GetAllRooms")
+	defer span.End()
+
+	rooms, err := s.roomRepo.GetAll(ctx, includeDeleted)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get rooms: %w", err)
 	}
@@ -57,13 +90,20 @@ func (s *roomService) GetAllRooms(ctx context.Context) ([]models.Room, error) {
 	return rooms, nil
 }
 
-// UpdateRoom updates an existing room
-func (s *roomService) UpdateRoom(ctx context.Context, id int, req *models.RoomUpdateRequest) (*models.Room, error) {
+// UpdateRoom updates an existing room. If ifMatch is set the room must
+// still have this version.
+func (s *roomService) UpdateRoom(ctx context.Context, id int, req *models.RoomUpdateRequest, ifMatch *int) (*models.Room, error) {
+	ctx, span := tracer.Start(ctx, "RoomService.UpdateRoom")
+	defer span.End()
+
 	// Check if room exists
 	existingRoom, err := s.roomRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("room not found: %w", err)
 	}
+	if err := checkVersion(ifMatch, existingRoom.Version); err != nil {
+		return nil, err
+	}
 
 	// Update fields if provided
 	if req.Floor != nil {
@@ -75,17 +115,74 @@ func (s *roomService) UpdateRoom(ctx context.Context, id int, req *models.RoomUp
 	if req.Desc != nil {
 		existingRoom.Desc = req.Desc
 	}
+	if req.RoomTypeId != nil {
+		if err := s.checkRoomType(ctx, req.RoomTypeId); err != nil {
+			return nil, err
+		}
+		existingRoom.RoomTypeId = req.RoomTypeId
+	}
+	if req.ZoneId != nil {
+		existingRoom.ZoneId = req.ZoneId
+	}
+	if err := s.placeRoom(ctx, existingRoom); err != nil {
+		return nil, err
+	}
 
 	err = s.roomRepo.Update(ctx, existingRoom)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update room: %w", err)
+		return nil, updateError("room", err)
 	}
 
+	slog.InfoContext(ctx, "room updated", "room_id", existingRoom.Id)
+
 	return existingRoom, nil
 }
 
-// DeleteRoom deletes a room by ID
+// PatchRoom applies a JSON merge patch to an existing room
+func (s *roomService) PatchRoom(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Room, error) {
+	ctx, span := tracer.Start(ctx, "RoomService.PatchRoom")
+	defer span.End()
+
+	existingRoom, err := s.roomRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("room not found: %w", err)
+	}
+	if err := checkVersion(ifMatch, existingRoom.Version); err != nil {
+		return nil, err
+	}
+
+	room, err := applyMergePatch(*existingRoom, patch, "floor")
+	if err != nil {
+		return nil, err
+	}
+	room.Id, room.Version, room.UpdatedAt, room.DeletedAt = existingRoom.Id, existingRoom.Version, existingRoom.UpdatedAt, existingRoom.DeletedAt
+	room.Status, room.PropertyId = existingRoom.Status, existingRoom.PropertyId
+
+	if room.Floor < 0 {
+		return nil, fmt.Errorf("floor number must be non-negative")
+	}
+	if err := s.checkRoomType(ctx, room.RoomTypeId); err != nil {
+		return nil, err
+	}
+	if err := s.placeRoom(ctx, &room); err != nil {
+		return nil, err
+	}
+
+	err = s.roomRepo.Update(ctx, &room)
+	if err != nil {
+		return nil, updateError("room", err)
+	}
+
+	slog.InfoContext(ctx, "room patched", "room_id", room.Id)
+
+	return &room, nil
+}
+
+// DeleteRoom marks a room as deleted, it stays in the database until it is purged
 func (s *roomService) DeleteRoom(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "RoomService.DeleteRoom")
+	defer span.End()
+
 	// Check if room exists
 	_, err := s.roomRepo.GetByID(ctx, id)
 	if err != nil {
@@ -97,5 +194,133 @@ func (s *roomService) DeleteRoom(ctx context.Context, id int) error {
 		return fmt.Errorf("failed to delete room: %w", err)
 	}
 
+	slog.InfoContext(ctx, "room deleted", "room_id", id)
+
+	return nil
+}
+
+// RestoreRoom restores a deleted room
+func (s *roomService) RestoreRoom(ctx context.Context, id int) (*models.Room, error) {
+	ctx, span := tracer.Start(ctx, "RoomService.RestoreRoom")
+	defer span.End()
+
+	err := s.roomRepo.Restore(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("deleted room not found: %w", err)
+	}
+
+	room, err := s.roomRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("room not found: %w", err)
+	}
+
+	slog.InfoContext(ctx, "room restored", "room_id", id)
+
+	return room, nil
+}
+
+// SetRoomStatus sets the housekeeping status of a room by hand
+func (s *roomService) SetRoomStatus(ctx context.Context, id int, req *models.RoomStatusUpdateRequest) (*models.Room, error) {
+	ctx, span := tracer.Start(ctx, "RoomService.SetRoomStatus")
+	defer span.End()
+
+	status := models.RoomStatus(req.Status)
+	switch status {
+	case models.RoomStatusDirty, models.RoomStatusClean, models.RoomStatusInspected:
+	default:
+		return nil, fmt.Errorf("unknown room status %q", req.Status)
+	}
+
+	err := s.roomRepo.SetStatus(ctx, id, status)
+	if err != nil {
+		return nil, fmt.Errorf("room not found: %w", err)
+	}
+
+	room, err := s.roomRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("room not found: %w", err)
+	}
+
+	slog.InfoContext(ctx, "room status set", "room_id", id, "status", status)
+
+	return room, nil
+}
+
+// CreateRoomBlock puts a room out of order or out of service for a period
+func (s *roomService) CreateRoomBlock(ctx context.Context, roomID int, req *models.RoomBlockCreateRequest) (*models.RoomBlock, error) {
+	ctx, span := tracer.Start(ctx, "RoomService.CreateRoomBlock")
+	defer span.End()
+
+	// Validate that the room exists
+	_, err := s.roomRepo.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("room not found: %w", err)
+	}
+
+	kind := models.RoomBlockKind(req.Kind)
+	if kind != models.RoomBlockKindOutOfOrder && kind != models.RoomBlockKindOutOfService {
+		return nil, fmt.Errorf("unknown kind %q", req.Kind)
+	}
+	if req.Reason == "" {
+		return nil, fmt.Errorf("reason is required")
+	}
+
+	startsAt := time.Now()
+	if req.StartsAt != nil {
+		startsAt = *req.StartsAt
+	}
+	if req.EndsAt != nil && !req.EndsAt.After(startsAt) {
+		return nil, fmt.Errorf("end of the period must be after its start")
+	}
+
+	block := &models.RoomBlock{
+		RoomId:   roomID,
+		Kind:     kind,
+		Reason:   req.Reason,
+		StartsAt: startsAt,
+		EndsAt:   req.EndsAt,
+	}
+
+	err = s.roomBlockRepo.Create(ctx, block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create room block: %w", err)
+	}
+
+	slog.InfoContext(ctx, "room blocked", "room_id", roomID, "block_id", block.Id, "kind", kind)
+
+	return block, nil
+}
+
+// GetRoomBlocks retrieves the out-of-order and out-of-service periods of a room
+func (s *roomService) GetRoomBlocks(ctx context.Context, roomID int) ([]models.RoomBlock, error) {
+	ctx, span := tracer.Start(ctx, "RoomService.GetRoomBlocks")
+	defer span.End()
+
+	// Validate that the room exists
+	_, err := s.roomRepo.GetByID(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("room not found: %w", err)
+	}
+
+	blocks, err := s.roomBlockRepo.GetByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get room blocks: %w", err)
+	}
+
+	return blocks, nil
+}
+
+// DeleteRoomBlock removes an out-of-order or out-of-service period
+func (s *roomService) DeleteRoomBlock(ctx context.Context, roomID, blockID int) error {
+	ctx, span := tracer.Start(ctx, "RoomService.DeleteRoomBlock")
+	defer span.End()
+
+	err := s.roomBlockRepo.Delete(ctx, roomID, blockID)
+	if err != nil {
+		return fmt.Errorf("room block not found: %w", err)
+	}
+
+	slog.InfoContext(ctx, "room block removed", "room_id", roomID, "block_id", blockID)
+
 	return nil
 }
@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
+)
+
+// RoomTypeService defines the interface for room type business operations
+type RoomTypeService interface {
+	CreateRoomType(ctx context.Context, req *models.RoomTypeCreateRequest) (*models.RoomType, error)
+	GetRoomType(ctx context.Context, id int) (*models.RoomType, error)
+	GetAllRoomTypes(ctx context.Context) ([]models.RoomType, error)
+	PatchRoomType(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.RoomType, error)
+	DeleteRoomType(ctx context.Context, id int) error
+}
+
+// CreateRoomType adds a room type
+func (s *roomService) CreateRoomType(ctx context.Context, req *models.RoomTypeCreateRequest) (*models.RoomType, error) {
+	ctx, span := tracer.Start(ctx, "RoomService.CreateRoomType")
+	defer span.End()
+
+	roomType := &models.RoomType{
+		Name:         req.Name,
+		Capacity:     req.Capacity,
+		Beds:         req.Beds,
+		Amenities:    []string{},
+		PeriodicCost: req.PeriodicCost,
+		GeneralCost:  req.GeneralCost,
+	}
+	if req.Area != nil {
+		roomType.Area = *req.Area
+	}
+	if req.Amenities != nil {
+		roomType.Amenities = *req.Amenities
+	}
+	if err := validateRoomType(roomType); err != nil {
+		return nil, err
+	}
+
+	if err := s.roomTypeRepo.Create(ctx, roomType); err != nil {
+		return nil, fmt.Errorf("failed to create room type: %w", err)
+	}
+
+	slog.InfoContext(ctx, "room type created", "room_type_id", roomType.Id, "name", roomType.Name)
+
+	return roomType, nil
+}
+
+// GetRoomType retrieves a room type by ID
+func (s *roomService) GetRoomType(ctx context.Context, id int) (*models.RoomType, error) {
+	ctx, span := tracer.Start(ctx, "RoomService.GetRoomType")
+	defer span.End()
+
+	roomType, err := s.roomTypeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("room type not found: %w", err)
+	}
+
+	return roomType, nil
+}
+
+// GetAllRoomTypes retrieves all room types
+func (s *roomService) GetAllRoomTypes(ctx context.Context) ([]models.RoomType, error) {
+	ctx, span := tracer.Start(ctx, "RoomService.GetAllRoomTypes")
+	defer span.End()
+
+	roomTypes, err := s.roomTypeRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get room types: %w", err)
+	}
+
+	return roomTypes, nil
+}
+
+// PatchRoomType applies a JSON merge patch to a room type
+func (s *roomService) PatchRoomType(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.RoomType, error) {
+	ctx, span := tracer.Start(ctx, "RoomService.PatchRoomType")
+	defer span.End()
+
+	existing, err := s.roomTypeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("room type not found: %w", err)
+	}
+	if err := checkVersion(ifMatch, existing.Version); err != nil {
+		return nil, err
+	}
+
+	roomType, err := applyMergePatch(*existing, patch, "name", "capacity", "area", "beds", "amenities")
+	if err != nil {
+		return nil, err
+	}
+	roomType.Id, roomType.Version, roomType.UpdatedAt = existing.Id, existing.Version, existing.UpdatedAt
+	roomType.PropertyId = existing.PropertyId
+
+	if err := validateRoomType(&roomType); err != nil {
+		return nil, err
+	}
+
+	if err := s.roomTypeRepo.Update(ctx, &roomType); err != nil {
+		return nil, updateError("room type", err)
+	}
+
+	slog.InfoContext(ctx, "room type patched", "room_type_id", roomType.Id)
+
+	return &roomType, nil
+}
+
+// DeleteRoomType removes a room type no room has
+func (s *roomService) DeleteRoomType(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "RoomService.DeleteRoomType")
+	defer span.End()
+
+	if _, err := s.roomTypeRepo.GetByID(ctx, id); err != nil {
+		return fmt.Errorf("room type not found: %w", err)
+	}
+	count, err := s.roomTypeRepo.CountRooms(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to count rooms: %w", err)
+	}
+	if count > 0 {
+		return fmt.Errorf("%w: %d rooms have this type", ErrConflict, count)
+	}
+
+	if err := s.roomTypeRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("room type not found: %w", err)
+	}
+
+	slog.InfoContext(ctx, "room type deleted", "room_type_id", id)
+
+	return nil
+}
+
+// checkRoomType fails if a room type is given that does not exist
+func (s *roomService) checkRoomType(ctx context.Context, id *int) error {
+	if id == nil {
+		return nil
+	}
+	if _, err := s.roomTypeRepo.GetByID(ctx, *id); err != nil {
+		return fmt.Errorf("room type not found: %w", err)
+	}
+	return nil
+}
+
+// roomTypeOf returns the type of a room, nil if it has none
+func roomTypeOf(ctx context.Context, repo repository.RoomTypeRepository, roomID int) (*models.RoomType, error) {
+	roomType, err := repo.GetByRoomID(ctx, roomID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get room type: %w", err)
+	}
+	return roomType, nil
+}
+
+// validateRoomType checks the fields of a room type
+func validateRoomType(roomType *models.RoomType) error {
+	if roomType.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if roomType.Capacity < 1 {
+		return fmt.Errorf("capacity must be positive")
+	}
+	if roomType.Beds < 0 {
+		return fmt.Errorf("beds must be non-negative")
+	}
+	if roomType.Area < 0 {
+		return fmt.Errorf("area must be non-negative")
+	}
+	if roomType.PeriodicCost != nil && *roomType.PeriodicCost < 0 {
+		return fmt.Errorf("periodic_cost must be non-negative")
+	}
+	if roomType.GeneralCost != nil && *roomType.GeneralCost < 0 {
+		return fmt.Errorf("general_cost must be non-negative")
+	}
+	if roomType.Amenities == nil {
+		roomType.Amenities = []string{}
+	}
+	return nil
+}
@@ -0,0 +1,347 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rruleWeekdays maps the BYDAY codes of RFC 5545 to weekdays
+var rruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// rruleDay is a BYDAY entry, n is the occurrence of the weekday in the
+// month, counted from the end if negative, every occurrence if 0
+type rruleDay struct {
+	weekday time.Weekday
+	n       int
+}
+
+// recurrence is a parsed RRULE. It supports FREQ=DAILY, WEEKLY, MONTHLY or
+// YEARLY with INTERVAL, BYDAY, BYMONTHDAY, BYMONTH and either COUNT or
+// UNTIL. Weeks start on Monday and BYDAY of a yearly rule selects days of
+// its months.
+type recurrence struct {
+	freq       string
+	interval   int
+	byDay      []rruleDay
+	byMonthDay []int
+	byMonth    []time.Month
+	count      int
+	until      *time.Time
+}
+
+// parseRRule parses an RRULE, with or without the "RRULE:" prefix. A date
+// of UNTIL without a time zone is taken in loc.
+func parseRRule(rule string, loc *time.Location) (*recurrence, error) {
+	r := &recurrence{interval: 1}
+
+	for _, part := range strings.Split(strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:"), ";") {
+		name, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return nil, fmt.Errorf("invalid rrule part %q", part)
+		}
+
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			r.freq = strings.ToUpper(value)
+			switch r.freq {
+			case "DAILY", "WEEKLY", "MONTHLY", "YEARLY":
+			default:
+				return nil, fmt.Errorf("unsupported rrule FREQ %q", value)
+			}
+		case "INTERVAL":
+			r.interval, err = strconv.Atoi(value)
+			if err != nil || r.interval < 1 {
+				return nil, fmt.Errorf("rrule INTERVAL must be a positive number")
+			}
+		case "COUNT":
+			r.count, err = strconv.Atoi(value)
+			if err != nil || r.count < 1 {
+				return nil, fmt.Errorf("rrule COUNT must be a positive number")
+			}
+		case "UNTIL":
+			until, err := parseRRuleUntil(value, loc)
+			if err != nil {
+				return nil, err
+			}
+			r.until = &until
+		case "BYDAY":
+			for _, day := range strings.Split(strings.ToUpper(value), ",") {
+				parsed, err := parseRRuleDay(day)
+				if err != nil {
+					return nil, err
+				}
+				r.byDay = append(r.byDay, parsed)
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(day)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, fmt.Errorf("invalid rrule BYMONTHDAY %q", day)
+				}
+				r.byMonthDay = append(r.byMonthDay, n)
+			}
+		case "BYMONTH":
+			for _, month := range strings.Split(value, ",") {
+				n, err := strconv.Atoi(month)
+				if err != nil || n < 1 || n > 12 {
+					return nil, fmt.Errorf("invalid rrule BYMONTH %q", month)
+				}
+				r.byMonth = append(r.byMonth, time.Month(n))
+			}
+		default:
+			return nil, fmt.Errorf("unsupported rrule part %q", name)
+		}
+	}
+
+	if r.freq == "" {
+		return nil, fmt.Errorf("rrule FREQ is required")
+	}
+	if r.count > 0 && r.until != nil {
+		return nil, fmt.Errorf("rrule cannot have both COUNT and UNTIL")
+	}
+	if r.freq == "DAILY" || r.freq == "WEEKLY" {
+		for _, day := range r.byDay {
+			if day.n != 0 {
+				return nil, fmt.Errorf("rrule BYDAY with an occurrence needs FREQ=MONTHLY or YEARLY")
+			}
+		}
+	}
+	if r.freq == "WEEKLY" && len(r.byMonthDay) > 0 {
+		return nil, fmt.Errorf("rrule BYMONTHDAY cannot be used with FREQ=WEEKLY")
+	}
+
+	return r, nil
+}
+
+// parseRRuleDay parses a BYDAY entry like MO, 1MO or -1FR
+func parseRRuleDay(day string) (rruleDay, error) {
+	if len(day) < 2 {
+		return rruleDay{}, fmt.Errorf("invalid rrule BYDAY %q", day)
+	}
+	weekday, ok := rruleWeekdays[day[len(day)-2:]]
+	if !ok {
+		return rruleDay{}, fmt.Errorf("invalid rrule BYDAY %q", day)
+	}
+	parsed := rruleDay{weekday: weekday}
+	if prefix := day[:len(day)-2]; prefix != "" {
+		n, err := strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -5 || n > 5 {
+			return rruleDay{}, fmt.Errorf("invalid rrule BYDAY %q", day)
+		}
+		parsed.n = n
+	}
+	return parsed, nil
+}
+
+// parseRRuleUntil parses UNTIL as a UTC date-time, a local date-time or a
+// date, which includes the whole day
+func parseRRuleUntil(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102", value, loc); err == nil {
+		return t.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid rrule UNTIL %q", value)
+}
+
+// between returns the occurrences of the recurrence starting at start that
+// fall into from..to, from included. The days are counted in loc and every
+// occurrence has the time of day of start.
+func (r *recurrence) between(start, from, to time.Time, loc *time.Location) []time.Time {
+	start = start.In(loc)
+	hour, minute, second := start.Clock()
+
+	occurrences := []time.Time{}
+	seen := 0
+	for period := r.periodStart(start); period.Before(to); period = r.nextPeriod(period) {
+		for _, day := range r.days(period, start) {
+			t := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, second, 0, loc)
+			if t.Before(start) {
+				continue
+			}
+			if r.until != nil && t.After(*r.until) {
+				return occurrences
+			}
+			if r.count > 0 && seen == r.count {
+				return occurrences
+			}
+			seen++
+			if !t.Before(from) && t.Before(to) {
+				occurrences = append(occurrences, t)
+			}
+		}
+	}
+
+	return occurrences
+}
+
+// periodStart returns the midnight the period of start begins at
+func (r *recurrence) periodStart(start time.Time) time.Time {
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, start.Location())
+	switch r.freq {
+	case "WEEKLY":
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case "MONTHLY":
+		return day.AddDate(0, 0, 1-day.Day())
+	case "YEARLY":
+		return time.Date(day.Year(), time.January, 1, 0, 0, 0, 0, day.Location())
+	}
+	return day
+}
+
+// nextPeriod returns the start of the period interval periods later
+func (r *recurrence) nextPeriod(period time.Time) time.Time {
+	switch r.freq {
+	case "WEEKLY":
+		return period.AddDate(0, 0, 7*r.interval)
+	case "MONTHLY":
+		return period.AddDate(0, r.interval, 0)
+	case "YEARLY":
+		return period.AddDate(r.interval, 0, 0)
+	}
+	return period.AddDate(0, 0, r.interval)
+}
+
+// days returns the sorted days of a period the recurrence occurs on
+func (r *recurrence) days(period, start time.Time) []time.Time {
+	var days []time.Time
+	switch r.freq {
+	case "DAILY":
+		days = []time.Time{period}
+	case "WEEKLY":
+		weekdays := r.byDay
+		if len(weekdays) == 0 {
+			weekdays = []rruleDay{{weekday: start.Weekday()}}
+		}
+		for i := 0; i < 7; i++ {
+			day := period.AddDate(0, 0, i)
+			if matchesWeekday(day, weekdays) {
+				days = append(days, day)
+			}
+		}
+	case "MONTHLY":
+		days = r.monthDays(period, start)
+	case "YEARLY":
+		months := r.byMonth
+		if len(months) == 0 {
+			months = []time.Month{start.Month()}
+		}
+		for _, month := range months {
+			first := time.Date(period.Year(), month, 1, 0, 0, 0, 0, period.Location())
+			days = append(days, r.monthDays(first, start)...)
+		}
+	}
+
+	matched := days[:0]
+	for _, day := range days {
+		if r.matches(day) {
+			matched = append(matched, day)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].Before(matched[j]) })
+	return matched
+}
+
+// monthDays returns the days of the month starting at first selected by
+// BYMONTHDAY or BYDAY, the day of the month of start if there are neither
+func (r *recurrence) monthDays(first, start time.Time) []time.Time {
+	length := first.AddDate(0, 1, -1).Day()
+
+	if len(r.byMonthDay) > 0 || len(r.byDay) == 0 {
+		monthDays := r.byMonthDay
+		if len(monthDays) == 0 {
+			monthDays = []int{start.Day()}
+		}
+		days := []time.Time{}
+		for _, n := range monthDays {
+			if n < 0 {
+				n = length + n + 1
+			}
+			if n < 1 || n > length {
+				continue
+			}
+			days = append(days, first.AddDate(0, 0, n-1))
+		}
+		return days
+	}
+
+	days := []time.Time{}
+	for _, byDay := range r.byDay {
+		matching := []time.Time{}
+		for i := 0; i < length; i++ {
+			day := first.AddDate(0, 0, i)
+			if day.Weekday() == byDay.weekday {
+				matching = append(matching, day)
+			}
+		}
+		switch {
+		case byDay.n == 0:
+			days = append(days, matching...)
+		case byDay.n > 0 && byDay.n <= len(matching):
+			days = append(days, matching[byDay.n-1])
+		case byDay.n < 0 && -byDay.n <= len(matching):
+			days = append(days, matching[len(matching)+byDay.n])
+		}
+	}
+	return days
+}
+
+// matches reports whether a day passes the BYMONTH, BYMONTHDAY and BYDAY
+// filters of the recurrence
+func (r *recurrence) matches(day time.Time) bool {
+	if len(r.byMonth) > 0 {
+		found := false
+		for _, month := range r.byMonth {
+			if day.Month() == month {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if r.freq == "DAILY" && len(r.byMonthDay) > 0 {
+		length := day.AddDate(0, 1, -day.Day()).Day()
+		found := false
+		for _, n := range r.byMonthDay {
+			if n == day.Day() || n < 0 && length+n+1 == day.Day() {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(r.byDay) > 0 && (r.freq == "DAILY" || len(r.byMonthDay) > 0) {
+		return matchesWeekday(day, r.byDay)
+	}
+	return true
+}
+
+// matchesWeekday reports whether day is one of the weekdays
+func matchesWeekday(day time.Time, weekdays []rruleDay) bool {
+	for _, weekday := range weekdays {
+		if day.Weekday() == weekday.weekday {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		wantErr bool
+	}{
+		{name: "weekly by day", rule: "FREQ=WEEKLY;BYDAY=MO,WE,FR"},
+		{name: "prefix", rule: "RRULE:FREQ=DAILY;INTERVAL=2"},
+		{name: "last friday", rule: "FREQ=MONTHLY;BYDAY=-1FR"},
+		{name: "quarterly", rule: "FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=1"},
+		{name: "until date", rule: "FREQ=DAILY;UNTIL=20250103"},
+		{name: "until utc", rule: "FREQ=DAILY;UNTIL=20250103T120000Z"},
+		{name: "missing freq", rule: "BYDAY=MO", wantErr: true},
+		{name: "unsupported freq", rule: "FREQ=HOURLY", wantErr: true},
+		{name: "zero interval", rule: "FREQ=DAILY;INTERVAL=0", wantErr: true},
+		{name: "count and until", rule: "FREQ=DAILY;COUNT=3;UNTIL=20250103", wantErr: true},
+		{name: "weekly occurrence", rule: "FREQ=WEEKLY;BYDAY=1MO", wantErr: true},
+		{name: "weekly month day", rule: "FREQ=WEEKLY;BYMONTHDAY=1", wantErr: true},
+		{name: "invalid month day", rule: "FREQ=MONTHLY;BYMONTHDAY=32", wantErr: true},
+		{name: "invalid weekday", rule: "FREQ=MONTHLY;BYDAY=XX", wantErr: true},
+		{name: "invalid until", rule: "FREQ=DAILY;UNTIL=tomorrow", wantErr: true},
+		{name: "unknown part", rule: "FREQ=DAILY;BYHOUR=10", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseRRule(tt.rule, time.UTC)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseRRule(%q) error = %v, wantErr %v", tt.rule, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRecurrenceBetween(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("time zone data not available: %v", err)
+	}
+	utc := func(year int, month time.Month, day, hour int) time.Time {
+		return time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name  string
+		rule  string
+		loc   *time.Location
+		start time.Time
+		from  time.Time
+		to    time.Time
+		want  []time.Time
+	}{
+		{
+			name:  "weekly by day",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,WE,FR",
+			loc:   time.UTC,
+			start: utc(2025, time.January, 6, 10),
+			from:  utc(2025, time.January, 6, 0),
+			to:    utc(2025, time.January, 20, 0),
+			want: []time.Time{
+				utc(2025, time.January, 6, 10), utc(2025, time.January, 8, 10), utc(2025, time.January, 10, 10),
+				utc(2025, time.January, 13, 10), utc(2025, time.January, 15, 10), utc(2025, time.January, 17, 10),
+			},
+		},
+		{
+			name:  "weekly on the day of start",
+			rule:  "FREQ=WEEKLY;INTERVAL=2",
+			loc:   time.UTC,
+			start: utc(2025, time.January, 8, 10),
+			from:  utc(2025, time.January, 1, 0),
+			to:    utc(2025, time.February, 6, 0),
+			want: []time.Time{
+				utc(2025, time.January, 8, 10), utc(2025, time.January, 22, 10), utc(2025, time.February, 5, 10),
+			},
+		},
+		{
+			name:  "last friday of the month",
+			rule:  "FREQ=MONTHLY;BYDAY=-1FR;COUNT=3",
+			loc:   time.UTC,
+			start: utc(2025, time.January, 1, 9),
+			from:  utc(2025, time.January, 1, 0),
+			to:    utc(2026, time.January, 1, 0),
+			want: []time.Time{
+				utc(2025, time.January, 31, 9), utc(2025, time.February, 28, 9), utc(2025, time.March, 28, 9),
+			},
+		},
+		{
+			name:  "quarterly",
+			rule:  "FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=1",
+			loc:   time.UTC,
+			start: utc(2025, time.January, 1, 8),
+			from:  utc(2025, time.January, 1, 0),
+			to:    utc(2026, time.January, 1, 0),
+			want: []time.Time{
+				utc(2025, time.January, 1, 8), utc(2025, time.April, 1, 8),
+				utc(2025, time.July, 1, 8), utc(2025, time.October, 1, 8),
+			},
+		},
+		{
+			name:  "count includes occurrences before from",
+			rule:  "FREQ=DAILY;COUNT=3",
+			loc:   time.UTC,
+			start: utc(2025, time.January, 1, 10),
+			from:  utc(2025, time.January, 2, 0),
+			to:    utc(2025, time.February, 1, 0),
+			want:  []time.Time{utc(2025, time.January, 2, 10), utc(2025, time.January, 3, 10)},
+		},
+		{
+			name:  "until date includes the whole day",
+			rule:  "FREQ=DAILY;UNTIL=20250103",
+			loc:   time.UTC,
+			start: utc(2025, time.January, 1, 10),
+			from:  utc(2025, time.January, 1, 0),
+			to:    utc(2025, time.February, 1, 0),
+			want: []time.Time{
+				utc(2025, time.January, 1, 10), utc(2025, time.January, 2, 10), utc(2025, time.January, 3, 10),
+			},
+		},
+		{
+			name:  "until date-time",
+			rule:  "FREQ=DAILY;UNTIL=20250103T090000Z",
+			loc:   time.UTC,
+			start: utc(2025, time.January, 1, 10),
+			from:  utc(2025, time.January, 1, 0),
+			to:    utc(2025, time.February, 1, 0),
+			want:  []time.Time{utc(2025, time.January, 1, 10), utc(2025, time.January, 2, 10)},
+		},
+		{
+			name:  "month end skips short months",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=31",
+			loc:   time.UTC,
+			start: utc(2025, time.January, 31, 12),
+			from:  utc(2025, time.January, 1, 0),
+			to:    utc(2025, time.June, 1, 0),
+			want: []time.Time{
+				utc(2025, time.January, 31, 12), utc(2025, time.March, 31, 12), utc(2025, time.May, 31, 12),
+			},
+		},
+		{
+			name:  "yearly by month",
+			rule:  "FREQ=YEARLY;BYMONTH=3,9;BYMONTHDAY=15",
+			loc:   time.UTC,
+			start: utc(2025, time.January, 1, 10),
+			from:  utc(2025, time.January, 1, 0),
+			to:    utc(2026, time.June, 1, 0),
+			want: []time.Time{
+				utc(2025, time.March, 15, 10), utc(2025, time.September, 15, 10), utc(2026, time.March, 15, 10),
+			},
+		},
+		{
+			name:  "yearly by day without month selects the month of start",
+			rule:  "FREQ=YEARLY;BYDAY=1MO",
+			loc:   time.UTC,
+			start: utc(2025, time.March, 1, 10),
+			from:  utc(2025, time.January, 1, 0),
+			to:    utc(2027, time.January, 1, 0),
+			want:  []time.Time{utc(2025, time.March, 3, 10), utc(2026, time.March, 2, 10)},
+		},
+		{
+			name:  "local time kept across daylight saving time",
+			rule:  "FREQ=DAILY",
+			loc:   berlin,
+			start: time.Date(2025, time.March, 29, 10, 0, 0, 0, berlin),
+			from:  time.Date(2025, time.March, 29, 0, 0, 0, 0, berlin),
+			to:    time.Date(2025, time.April, 1, 0, 0, 0, 0, berlin),
+			// 10:00 CET is 09:00 UTC, 10:00 CEST from March 30 is 08:00 UTC
+			want: []time.Time{
+				utc(2025, time.March, 29, 9), utc(2025, time.March, 30, 8), utc(2025, time.March, 31, 8),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := parseRRule(tt.rule, tt.loc)
+			if err != nil {
+				t.Fatalf("parseRRule(%q): %v", tt.rule, err)
+			}
+			got := r.between(tt.start, tt.from, tt.to, tt.loc)
+			if len(got) != len(tt.want) {
+				t.Fatalf("between() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("occurrence %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -1,12 +1,30 @@
 package service
 
-import "github.com/StEvseeva/cleany/internal/repository"
+import (
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/repository"
+	"github.com/StEvseeva/cleany/internal/storage"
+	"go.opentelemetry.io/otel"
+)
+
+// tracer starts the spans of service methods
+var tracer = otel.Tracer("github.com/StEvseeva/cleany/internal/service")
 
 type Service interface {
 	BookingService
 	CleanerService
 	RoomService
 	CleaningOrderService
+	InspectionService
+	ChecklistService
+	AttachmentService
+	MaintenanceService
+	LostItemService
+	InventoryService
+	PropertyService
+	LocationService
+	ReportService
 }
 
 type service struct {
@@ -14,23 +32,40 @@ type service struct {
 	CleanerService
 	RoomService
 	CleaningOrderService
+	InspectionService
+	ChecklistService
+	AttachmentService
+	MaintenanceService
+	LostItemService
+	InventoryService
+	PropertyService
+	LocationService
+	ReportService
 }
 
 // roomService implements RoomService
 type roomService struct {
-	roomRepo repository.RoomRepository
+	roomRepo      repository.RoomRepository
+	roomBlockRepo repository.RoomBlockRepository
+	roomTypeRepo  repository.RoomTypeRepository
+	locationRepo  repository.LocationRepository
 }
 
 // bookingService implements BookingService
 type bookingService struct {
 	bookingRepo          repository.BookingRepository
 	roomRepo             repository.RoomRepository
+	roomBlockRepo        repository.RoomBlockRepository
+	roomTypeRepo         repository.RoomTypeRepository
 	cleaningOrderService CleaningOrderService
+	transactor           repository.Transactor
 }
 
 // cleanerService implements CleanerService
 type cleanerService struct {
-	cleanerRepo repository.CleanerRepository
+	cleanerRepo  repository.CleanerRepository
+	locationRepo repository.LocationRepository
+	shiftRepo    repository.ShiftRepository
 }
 
 // cleaningOrderService implements CleaningOrderService
@@ -38,12 +73,126 @@ type cleaningOrderService struct {
 	cleaningOrderRepo repository.CleaningOrderRepository
 	bookingRepo       repository.BookingRepository
 	cleanerRepo       repository.CleanerRepository
+	roomRepo          repository.RoomRepository
+	roomTypeRepo      repository.RoomTypeRepository
+	checklistRepo     repository.ChecklistRepository
+	inventoryRepo     repository.InventoryRepository
+	locationRepo      repository.LocationRepository
+	ruleRepo          repository.RecurrenceRuleRepository
+	transactor        repository.Transactor
+	schedule          Schedule
+}
+
+// inspectionService implements InspectionService
+type inspectionService struct {
+	inspectionRepo    repository.InspectionRepository
+	cleaningOrderRepo repository.CleaningOrderRepository
+	roomRepo          repository.RoomRepository
+	checklistRepo     repository.ChecklistRepository
+	transactor        repository.Transactor
+}
+
+// checklistService implements ChecklistService
+type checklistService struct {
+	checklistRepo     repository.ChecklistRepository
+	cleaningOrderRepo repository.CleaningOrderRepository
+	cleanerRepo       repository.CleanerRepository
+}
+
+// attachmentService implements AttachmentService
+type attachmentService struct {
+	attachmentRepo    repository.AttachmentRepository
+	cleaningOrderRepo repository.CleaningOrderRepository
+	cleanerRepo       repository.CleanerRepository
+	store             storage.BlobStore
+	limits            AttachmentLimits
+	transactor        repository.Transactor
+}
+
+// maintenanceService implements MaintenanceService
+type maintenanceService struct {
+	ticketRepo        repository.MaintenanceTicketRepository
+	roomRepo          repository.RoomRepository
+	roomBlockRepo     repository.RoomBlockRepository
+	cleaningOrderRepo repository.CleaningOrderRepository
+	cleanerRepo       repository.CleanerRepository
+	transactor        repository.Transactor
+}
+
+// lostItemService implements LostItemService
+type lostItemService struct {
+	lostItemRepo      repository.LostItemRepository
+	roomRepo          repository.RoomRepository
+	cleaningOrderRepo repository.CleaningOrderRepository
+	bookingRepo       repository.BookingRepository
+	cleanerRepo       repository.CleanerRepository
+	retention         time.Duration
+}
+
+// inventoryService implements InventoryService
+type inventoryService struct {
+	inventoryRepo     repository.InventoryRepository
+	cleaningOrderRepo repository.CleaningOrderRepository
+	transactor        repository.Transactor
+}
+
+// Schedule holds the settings used to generate cleaning orders for bookings
+type Schedule struct {
+	// Location is the hotel time zone, cleaning days are counted in it
+	Location *time.Location
+	// PeriodicCleaningTime is the offset from local midnight of periodic cleanings
+	PeriodicCleaningTime time.Duration
+	// GeneralCleaningDelay is the delay after check-out of the general cleaning
+	GeneralCleaningDelay time.Duration
+	PeriodicCost         int
+	GeneralCost          int
+	// DayStart is the offset from local midnight cleaners start working
+	DayStart time.Duration
+	// PeriodicDuration and GeneralDuration are the estimated durations of
+	// the cleanings
+	PeriodicDuration time.Duration
+	GeneralDuration  time.Duration
+	// FloorChangeTime is the time it takes to move to another floor
+	FloorChangeTime time.Duration
+	// UrgentAlertLead is how long before the next check-in an urgent
+	// departure cleaning without a cleaner is reported
+	UrgentAlertLead time.Duration
+	// StaffingTolerance is the fraction of the workload the shift capacity
+	// of a day may exceed it by before the day counts as overstaffed
+	StaffingTolerance float64
+	// RecurrenceHorizon is how far ahead the orders of recurrence rules
+	// are generated
+	RecurrenceHorizon time.Duration
+}
+
+// DefaultSchedule returns the schedule used when none is configured
+func DefaultSchedule() Schedule {
+	return Schedule{
+		Location:             time.UTC,
+		PeriodicCleaningTime: 13 * time.Hour,
+		GeneralCleaningDelay: time.Hour,
+		PeriodicCost:         100,
+		GeneralCost:          200,
+		DayStart:             8 * time.Hour,
+		PeriodicDuration:     30 * time.Minute,
+		GeneralDuration:      time.Hour,
+		FloorChangeTime:      5 * time.Minute,
+		UrgentAlertLead:      2 * time.Hour,
+		StaffingTolerance:    0.2,
+		RecurrenceHorizon:    28 * 24 * time.Hour,
+	}
 }
 
 // NewCleanerService creates a new cleaner service
-func NewCleanerService(cleanerRepo repository.CleanerRepository) CleanerService {
+func NewCleanerService(
+	cleanerRepo repository.CleanerRepository,
+	locationRepo repository.LocationRepository,
+	shiftRepo repository.ShiftRepository,
+) CleanerService {
 	return &cleanerService{
-		cleanerRepo: cleanerRepo,
+		cleanerRepo:  cleanerRepo,
+		locationRepo: locationRepo,
+		shiftRepo:    shiftRepo,
 	}
 }
 
@@ -52,18 +201,42 @@ func NewCleaningOrderService(
 	cleaningOrderRepo repository.CleaningOrderRepository,
 	bookingRepo repository.BookingRepository,
 	cleanerRepo repository.CleanerRepository,
+	roomRepo repository.RoomRepository,
+	roomTypeRepo repository.RoomTypeRepository,
+	checklistRepo repository.ChecklistRepository,
+	inventoryRepo repository.InventoryRepository,
+	locationRepo repository.LocationRepository,
+	ruleRepo repository.RecurrenceRuleRepository,
+	transactor repository.Transactor,
+	schedule Schedule,
 ) CleaningOrderService {
 	return &cleaningOrderService{
 		cleaningOrderRepo: cleaningOrderRepo,
 		bookingRepo:       bookingRepo,
 		cleanerRepo:       cleanerRepo,
+		roomRepo:          roomRepo,
+		roomTypeRepo:      roomTypeRepo,
+		checklistRepo:     checklistRepo,
+		inventoryRepo:     inventoryRepo,
+		locationRepo:      locationRepo,
+		ruleRepo:          ruleRepo,
+		transactor:        transactor,
+		schedule:          schedule,
 	}
 }
 
 // NewRoomService creates a new room service
-func NewRoomService(roomRepo repository.RoomRepository) RoomService {
+func NewRoomService(
+	roomRepo repository.RoomRepository,
+	roomBlockRepo repository.RoomBlockRepository,
+	roomTypeRepo repository.RoomTypeRepository,
+	locationRepo repository.LocationRepository,
+) RoomService {
 	return &roomService{
-		roomRepo: roomRepo,
+		roomRepo:      roomRepo,
+		roomBlockRepo: roomBlockRepo,
+		roomTypeRepo:  roomTypeRepo,
+		locationRepo:  locationRepo,
 	}
 }
 
@@ -71,25 +244,167 @@ func NewRoomService(roomRepo repository.RoomRepository) RoomService {
 func NewBookingService(
 	bookingRepo repository.BookingRepository,
 	roomRepo repository.RoomRepository,
-	cleaningOrderService CleaningOrderService) BookingService {
+	roomBlockRepo repository.RoomBlockRepository,
+	roomTypeRepo repository.RoomTypeRepository,
+	cleaningOrderService CleaningOrderService,
+	transactor repository.Transactor) BookingService {
 	return &bookingService{
 		bookingRepo:          bookingRepo,
 		roomRepo:             roomRepo,
+		roomBlockRepo:        roomBlockRepo,
+		roomTypeRepo:         roomTypeRepo,
 		cleaningOrderService: cleaningOrderService,
+		transactor:           transactor,
+	}
+}
+
+// NewInspectionService creates a new inspection service
+func NewInspectionService(
+	inspectionRepo repository.InspectionRepository,
+	cleaningOrderRepo repository.CleaningOrderRepository,
+	roomRepo repository.RoomRepository,
+	checklistRepo repository.ChecklistRepository,
+	transactor repository.Transactor,
+) InspectionService {
+	return &inspectionService{
+		inspectionRepo:    inspectionRepo,
+		cleaningOrderRepo: cleaningOrderRepo,
+		roomRepo:          roomRepo,
+		checklistRepo:     checklistRepo,
+		transactor:        transactor,
 	}
 }
 
-func NewService(
+// NewChecklistService creates a new checklist service
+func NewChecklistService(
+	checklistRepo repository.ChecklistRepository,
+	cleaningOrderRepo repository.CleaningOrderRepository,
 	cleanerRepo repository.CleanerRepository,
-	bookingRepo repository.BookingRepository,
+) ChecklistService {
+	return &checklistService{
+		checklistRepo:     checklistRepo,
+		cleaningOrderRepo: cleaningOrderRepo,
+		cleanerRepo:       cleanerRepo,
+	}
+}
+
+// NewAttachmentService creates a new attachment service
+func NewAttachmentService(
+	attachmentRepo repository.AttachmentRepository,
+	cleaningOrderRepo repository.CleaningOrderRepository,
+	cleanerRepo repository.CleanerRepository,
+	store storage.BlobStore,
+	limits AttachmentLimits,
+	transactor repository.Transactor,
+) AttachmentService {
+	return &attachmentService{
+		attachmentRepo:    attachmentRepo,
+		cleaningOrderRepo: cleaningOrderRepo,
+		cleanerRepo:       cleanerRepo,
+		store:             store,
+		limits:            limits,
+		transactor:        transactor,
+	}
+}
+
+// NewMaintenanceService creates a new maintenance service
+func NewMaintenanceService(
+	ticketRepo repository.MaintenanceTicketRepository,
+	roomRepo repository.RoomRepository,
+	roomBlockRepo repository.RoomBlockRepository,
+	cleaningOrderRepo repository.CleaningOrderRepository,
+	cleanerRepo repository.CleanerRepository,
+	transactor repository.Transactor,
+) MaintenanceService {
+	return &maintenanceService{
+		ticketRepo:        ticketRepo,
+		roomRepo:          roomRepo,
+		roomBlockRepo:     roomBlockRepo,
+		cleaningOrderRepo: cleaningOrderRepo,
+		cleanerRepo:       cleanerRepo,
+		transactor:        transactor,
+	}
+}
+
+// NewLostItemService creates a new lost and found service, stored items are
+// due for disposal once retention has passed since they were found
+func NewLostItemService(
+	lostItemRepo repository.LostItemRepository,
 	roomRepo repository.RoomRepository,
-	cleaningOrderRepo repository.CleaningOrderRepository) Service {
-	order := NewCleaningOrderService(cleaningOrderRepo, bookingRepo, cleanerRepo)
+	cleaningOrderRepo repository.CleaningOrderRepository,
+	bookingRepo repository.BookingRepository,
+	cleanerRepo repository.CleanerRepository,
+	retention time.Duration,
+) LostItemService {
+	return &lostItemService{
+		lostItemRepo:      lostItemRepo,
+		roomRepo:          roomRepo,
+		cleaningOrderRepo: cleaningOrderRepo,
+		bookingRepo:       bookingRepo,
+		cleanerRepo:       cleanerRepo,
+		retention:         retention,
+	}
+}
+
+// NewInventoryService creates a new inventory service
+func NewInventoryService(
+	inventoryRepo repository.InventoryRepository,
+	cleaningOrderRepo repository.CleaningOrderRepository,
+	transactor repository.Transactor,
+) InventoryService {
+	return &inventoryService{
+		inventoryRepo:     inventoryRepo,
+		cleaningOrderRepo: cleaningOrderRepo,
+		transactor:        transactor,
+	}
+}
+
+// Dependencies holds the repositories and settings the services are built from
+type Dependencies struct {
+	CleanerRepo       repository.CleanerRepository
+	BookingRepo       repository.BookingRepository
+	RoomRepo          repository.RoomRepository
+	RoomBlockRepo     repository.RoomBlockRepository
+	RoomTypeRepo      repository.RoomTypeRepository
+	CleaningOrderRepo repository.CleaningOrderRepository
+	InspectionRepo    repository.InspectionRepository
+	ChecklistRepo     repository.ChecklistRepository
+	AttachmentRepo    repository.AttachmentRepository
+	TicketRepo        repository.MaintenanceTicketRepository
+	LostItemRepo      repository.LostItemRepository
+	InventoryRepo     repository.InventoryRepository
+	PropertyRepo      repository.PropertyRepository
+	LocationRepo      repository.LocationRepository
+	ShiftRepo         repository.ShiftRepository
+	ReportRepo        repository.ReportRepository
+	RuleRepo          repository.RecurrenceRuleRepository
+	Transactor        repository.Transactor
+	// Store holds the files of attachments
+	Store            storage.BlobStore
+	AttachmentLimits AttachmentLimits
+	// LostItemRetention is how long found items are stored before they are
+	// due for disposal
+	LostItemRetention time.Duration
+	Schedule          Schedule
+}
+
+// NewService creates all services from their dependencies
+func NewService(d Dependencies) Service {
+	order := NewCleaningOrderService(d.CleaningOrderRepo, d.BookingRepo, d.CleanerRepo, d.RoomRepo, d.RoomTypeRepo, d.ChecklistRepo, d.InventoryRepo, d.LocationRepo, d.RuleRepo, d.Transactor, d.Schedule)
 	return &service{
-		BookingService:       NewBookingService(bookingRepo, roomRepo, order),
-		CleanerService:       NewCleanerService(cleanerRepo),
-		RoomService:          NewRoomService(roomRepo),
+		BookingService:       NewBookingService(d.BookingRepo, d.RoomRepo, d.RoomBlockRepo, d.RoomTypeRepo, order, d.Transactor),
+		CleanerService:       NewCleanerService(d.CleanerRepo, d.LocationRepo, d.ShiftRepo),
+		RoomService:          NewRoomService(d.RoomRepo, d.RoomBlockRepo, d.RoomTypeRepo, d.LocationRepo),
 		CleaningOrderService: order,
+		InspectionService:    NewInspectionService(d.InspectionRepo, d.CleaningOrderRepo, d.RoomRepo, d.ChecklistRepo, d.Transactor),
+		ChecklistService:     NewChecklistService(d.ChecklistRepo, d.CleaningOrderRepo, d.CleanerRepo),
+		AttachmentService:    NewAttachmentService(d.AttachmentRepo, d.CleaningOrderRepo, d.CleanerRepo, d.Store, d.AttachmentLimits, d.Transactor),
+		MaintenanceService:   NewMaintenanceService(d.TicketRepo, d.RoomRepo, d.RoomBlockRepo, d.CleaningOrderRepo, d.CleanerRepo, d.Transactor),
+		LostItemService:      NewLostItemService(d.LostItemRepo, d.RoomRepo, d.CleaningOrderRepo, d.BookingRepo, d.CleanerRepo, d.LostItemRetention),
+		InventoryService:     NewInventoryService(d.InventoryRepo, d.CleaningOrderRepo, d.Transactor),
+		PropertyService:      NewPropertyService(d.PropertyRepo),
+		LocationService:      NewLocationService(d.LocationRepo, d.Transactor),
+		ReportService:        NewReportService(d.BookingRepo, d.RoomTypeRepo, d.ShiftRepo, d.ReportRepo, d.Schedule),
 	}
 }
 
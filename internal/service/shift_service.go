@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
+)
+
+// maxShiftLength is the longest shift that can be scheduled
+const maxShiftLength = 24 * time.Hour
+
+// ShiftService defines the interface for shift business operations
+type ShiftService interface {
+	CreateShift(ctx context.Context, req *models.ShiftCreateRequest) (*models.Shift, error)
+	GetShift(ctx context.Context, id int) (*models.Shift, error)
+	GetAllShifts(ctx context.Context, filter repository.ShiftFilter) ([]models.Shift, error)
+	PatchShift(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Shift, error)
+	DeleteShift(ctx context.Context, id int) error
+}
+
+// CreateShift schedules a shift of a cleaner
+func (s *cleanerService) CreateShift(ctx context.Context, req *models.ShiftCreateRequest) (*models.Shift, error) {
+	ctx, span := tracer.Start(ctx, "CleanerService.CreateShift")
+	defer span.End()
+
+	shift := &models.Shift{
+		CleanerId: req.CleanerId,
+		StartTs:   req.StartTs,
+		EndTs:     req.EndTs,
+		Notes:     req.Notes,
+	}
+	if err := s.checkShift(ctx, shift); err != nil {
+		return nil, err
+	}
+
+	if err := s.shiftRepo.Create(ctx, shift); err != nil {
+		return nil, fmt.Errorf("failed to create shift: %w", err)
+	}
+
+	slog.InfoContext(ctx, "shift created", "shift_id", shift.Id, "cleaner_id", shift.CleanerId)
+
+	return shift, nil
+}
+
+// GetShift retrieves a shift by ID
+func (s *cleanerService) GetShift(ctx context.Context, id int) (*models.Shift, error) {
+	ctx, span := tracer.Start(ctx, "CleanerService.GetShift")
+	defer span.End()
+
+	shift, err := s.shiftRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("shift not found: %w", err)
+	}
+
+	return shift, nil
+}
+
+// GetAllShifts retrieves the shifts matching the filter
+func (s *cleanerService) GetAllShifts(ctx context.Context, filter repository.ShiftFilter) ([]models.Shift, error) {
+	ctx, span := tracer.Start(ctx, "CleanerService.GetAllShifts")
+	defer span.End()
+
+	shifts, err := s.shiftRepo.GetAll(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shifts: %w", err)
+	}
+
+	return shifts, nil
+}
+
+// PatchShift applies a JSON merge patch to a shift
+func (s *cleanerService) PatchShift(ctx context.Context, id int, patch []byte, ifMatch *int) (*models.Shift, error) {
+	ctx, span := tracer.Start(ctx, "CleanerService.PatchShift")
+	defer span.End()
+
+	existing, err := s.shiftRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("shift not found: %w", err)
+	}
+	if err := checkVersion(ifMatch, existing.Version); err != nil {
+		return nil, err
+	}
+
+	shift, err := applyMergePatch(*existing, patch, "cleaner_id", "start_ts", "end_ts")
+	if err != nil {
+		return nil, err
+	}
+	shift.Id, shift.Version, shift.UpdatedAt = existing.Id, existing.Version, existing.UpdatedAt
+
+	if err := s.checkShift(ctx, &shift); err != nil {
+		return nil, err
+	}
+
+	if err := s.shiftRepo.Update(ctx, &shift); err != nil {
+		return nil, updateError("shift", err)
+	}
+
+	slog.InfoContext(ctx, "shift patched", "shift_id", shift.Id)
+
+	return &shift, nil
+}
+
+// DeleteShift removes a shift
+func (s *cleanerService) DeleteShift(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "CleanerService.DeleteShift")
+	defer span.End()
+
+	if err := s.shiftRepo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("shift not found: %w", err)
+	}
+
+	slog.InfoContext(ctx, "shift deleted", "shift_id", id)
+
+	return nil
+}
+
+// checkShift validates the period of a shift, that its cleaner exists and
+// that it does not overlap another shift of the cleaner
+func (s *cleanerService) checkShift(ctx context.Context, shift *models.Shift) error {
+	if !shift.EndTs.After(shift.StartTs) {
+		return fmt.Errorf("end_ts must be after start_ts")
+	}
+	if shift.EndTs.Sub(shift.StartTs) > maxShiftLength {
+		return fmt.Errorf("a shift cannot be longer than %s", maxShiftLength)
+	}
+	if _, err := s.cleanerRepo.GetByID(ctx, shift.CleanerId); err != nil {
+		return fmt.Errorf("cleaner not found: %w", err)
+	}
+
+	overlapping, err := s.shiftRepo.CountOverlapping(ctx, shift)
+	if err != nil {
+		return fmt.Errorf("failed to check shifts: %w", err)
+	}
+	if overlapping > 0 {
+		return fmt.Errorf("%w: the shift overlaps another shift of the cleaner", ErrConflict)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+)
+
+// thumbnailTypes are the media types thumbnails are made for
+var thumbnailTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// maxThumbnailPixels limits the images decoded for thumbnails, larger ones
+// would take too much memory
+const maxThumbnailPixels = 50_000_000
+
+// makeThumbnail scales an image down so that its longest side is at most
+// size pixels and encodes it as JPEG. Transparent areas become white.
+func makeThumbnail(content []byte, size int) ([]byte, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Width*cfg.Height > maxThumbnailPixels {
+		return nil, fmt.Errorf("image of %dx%d pixels is too large", cfg.Width, cfg.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := src.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w == 0 || h == 0 {
+		return nil, fmt.Errorf("image is empty")
+	}
+	tw, th := w, h
+	if w > size || h > size {
+		if w >= h {
+			tw, th = size, max(1, h*size/w)
+		} else {
+			tw, th = max(1, w*size/h), size
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, tw, th))
+	for y := 0; y < th; y++ {
+		y0 := bounds.Min.Y + y*h/th
+		y1 := max(y0+1, bounds.Min.Y+(y+1)*h/th)
+		for x := 0; x < tw; x++ {
+			x0 := bounds.Min.X + x*w/tw
+			x1 := max(x0+1, bounds.Min.X+(x+1)*w/tw)
+			dst.SetRGBA(x, y, averageOverWhite(src, x0, y0, x1, y1))
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: 80}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// averageOverWhite returns the mean color of a box of src composed over a
+// white background, sampling at most 4x4 pixels of the box
+func averageOverWhite(src image.Image, x0, y0, x1, y1 int) color.RGBA {
+	stepX := max(1, (x1-x0)/4)
+	stepY := max(1, (y1-y0)/4)
+
+	var r, g, b, a, n uint64
+	for y := y0; y < y1; y += stepY {
+		for x := x0; x < x1; x += stepX {
+			cr, cg, cb, ca := src.At(x, y).RGBA()
+			r += uint64(cr)
+			g += uint64(cg)
+			b += uint64(cb)
+			a += uint64(ca)
+			n++
+		}
+	}
+
+	// Colors are alpha-premultiplied, so adding the uncovered share of white
+	// composes them over a white background
+	white := 0xffff - a/n
+	return color.RGBA{
+		R: uint8((r/n + white) >> 8),
+		G: uint8((g/n + white) >> 8),
+		B: uint8((b/n + white) >> 8),
+		A: 0xff,
+	}
+}
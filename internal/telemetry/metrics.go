@@ -0,0 +1,260 @@
+package telemetry
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/StEvseeva/cleany/internal/repository"
+	"github.com/StEvseeva/cleany/internal/service"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "cleany"
+
+// Metrics holds the Prometheus registry and the application collectors
+type Metrics struct {
+	registry      *prometheus.Registry
+	httpDuration  *prometheus.HistogramVec
+	queryDuration *prometheus.HistogramVec
+}
+
+// NewMetrics creates a registry with Go runtime, process and application metrics
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of HTTP requests per OpenAPI operation.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation", "code"}),
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "db",
+			Name:      "query_duration_seconds",
+			Help:      "Duration of database queries per repository method.",
+			Buckets:   []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"method", "status"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpDuration,
+		m.queryDuration,
+	)
+
+	return m
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// Middleware records the duration of every HTTP request
+func (m *Metrics) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+
+			status := c.Response().Status
+			if err != nil {
+				if he, ok := err.(*echo.HTTPError); ok {
+					status = he.Code
+				} else {
+					status = http.StatusInternalServerError
+				}
+			}
+
+			m.httpDuration.
+				WithLabelValues(Operation(c), strconv.Itoa(status)).
+				Observe(time.Since(start).Seconds())
+			return err
+		}
+	}
+}
+
+// QueryHook records the duration of every repository query
+func (m *Metrics) QueryHook() repository.QueryHook {
+	return func(ctx context.Context, method, query string) (context.Context, func(err error)) {
+		start := time.Now()
+		return ctx, func(err error) {
+			status := "ok"
+			if err != nil {
+				status = "error"
+			}
+			m.queryDuration.WithLabelValues(method, status).Observe(time.Since(start).Seconds())
+		}
+	}
+}
+
+// RegisterDBStats exposes the connection pool statistics of db
+func (m *Metrics) RegisterDBStats(db *sql.DB) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, namespace))
+}
+
+// forEachProperty calls fn with ctx scoped to every property and the
+// property label value
+func forEachProperty(ctx context.Context, properties service.PropertyService, fn func(ctx context.Context, property string) error) error {
+	all, err := properties.GetAllProperties(ctx, nil)
+	if err != nil {
+		return err
+	}
+	for _, property := range all {
+		if err := fn(repository.WithProperty(ctx, property.Id), strconv.Itoa(property.Id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RegisterCleaningOrderStats exposes business gauges computed per property
+// at scrape time
+func (m *Metrics) RegisterCleaningOrderStats(svc service.CleaningOrderService, properties service.PropertyService) {
+	m.registry.MustRegister(&cleaningOrderCollector{service: svc, properties: properties})
+}
+
+var (
+	unassignedTodayDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "cleaning_orders", "unassigned_today"),
+		"Not done cleaning orders scheduled today without an assigned cleaner.",
+		[]string{"property"}, nil,
+	)
+	overdueDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "cleaning_orders", "overdue"),
+		"Not done cleaning orders scheduled in the past.",
+		[]string{"property"}, nil,
+	)
+	urgentUnassignedDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "cleaning_orders", "urgent_unassigned"),
+		"Urgent departure cleanings without an assigned cleaner whose room has a check-in within the alert lead time.",
+		[]string{"property"}, nil,
+	)
+)
+
+// cleaningOrderCollector queries cleaning order counters on every scrape
+type cleaningOrderCollector struct {
+	service    service.CleaningOrderService
+	properties service.PropertyService
+}
+
+// Describe implements prometheus.Collector
+func (c *cleaningOrderCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- unassignedTodayDesc
+	ch <- overdueDesc
+	ch <- urgentUnassignedDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *cleaningOrderCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := forEachProperty(ctx, c.properties, func(ctx context.Context, property string) error {
+		stats, err := c.service.GetCleaningOrderStats(ctx)
+		if err != nil {
+			return err
+		}
+		ch <- prometheus.MustNewConstMetric(unassignedTodayDesc, prometheus.GaugeValue, float64(stats.UnassignedToday), property)
+		ch <- prometheus.MustNewConstMetric(overdueDesc, prometheus.GaugeValue, float64(stats.Overdue), property)
+		ch <- prometheus.MustNewConstMetric(urgentUnassignedDesc, prometheus.GaugeValue, float64(stats.UrgentUnassigned), property)
+		return nil
+	})
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(unassignedTodayDesc, err)
+		ch <- prometheus.NewInvalidMetric(overdueDesc, err)
+		ch <- prometheus.NewInvalidMetric(urgentUnassignedDesc, err)
+	}
+}
+
+// RegisterLostItemStats exposes the number of found items due for disposal
+// per property, so that an alert can remind the staff to clear the storage
+func (m *Metrics) RegisterLostItemStats(svc service.LostItemService, properties service.PropertyService) {
+	m.registry.MustRegister(&lostItemCollector{service: svc, properties: properties})
+}
+
+var lostItemsDueDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(namespace, "lost_items", "due_for_disposal"),
+	"Stored lost and found items past their retention period.",
+	[]string{"property"}, nil,
+)
+
+// lostItemCollector queries the lost and found storage on every scrape
+type lostItemCollector struct {
+	service    service.LostItemService
+	properties service.PropertyService
+}
+
+// Describe implements prometheus.Collector
+func (c *lostItemCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- lostItemsDueDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *lostItemCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	err := forEachProperty(ctx, c.properties, func(ctx context.Context, property string) error {
+		items, err := c.service.GetLostItemsDueForDisposal(ctx, now)
+		if err != nil {
+			return err
+		}
+		ch <- prometheus.MustNewConstMetric(lostItemsDueDesc, prometheus.GaugeValue, float64(len(items)), property)
+		return nil
+	})
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(lostItemsDueDesc, err)
+	}
+}
+
+// RegisterInventoryStats exposes the number of inventory items low on stock
+// per property
+func (m *Metrics) RegisterInventoryStats(svc service.InventoryService, properties service.PropertyService) {
+	m.registry.MustRegister(&inventoryCollector{service: svc, properties: properties})
+}
+
+var lowStockDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(namespace, "inventory", "low_stock_items"),
+	"Active inventory items whose total stock is below their minimum.",
+	[]string{"property"}, nil,
+)
+
+// inventoryCollector queries the stock levels on every scrape
+type inventoryCollector struct {
+	service    service.InventoryService
+	properties service.PropertyService
+}
+
+// Describe implements prometheus.Collector
+func (c *inventoryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- lowStockDesc
+}
+
+// Collect implements prometheus.Collector
+func (c *inventoryCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := forEachProperty(ctx, c.properties, func(ctx context.Context, property string) error {
+		items, err := c.service.GetLowStock(ctx)
+		if err != nil {
+			return err
+		}
+		ch <- prometheus.MustNewConstMetric(lowStockDesc, prometheus.GaugeValue, float64(len(items)), property)
+		return nil
+	})
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(lowStockDesc, err)
+	}
+}
@@ -0,0 +1,55 @@
+package telemetry_test
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/StEvseeva/cleany/internal/models"
+	"github.com/StEvseeva/cleany/internal/repository"
+	"github.com/StEvseeva/cleany/internal/service"
+	"github.com/StEvseeva/cleany/internal/telemetry"
+)
+
+// statsService answers the stats of the property of the context, the other
+// service methods panic
+type statsService struct {
+	service.Service
+}
+
+func (statsService) GetAllProperties(context.Context, *string) ([]models.Property, error) {
+	return []models.Property{{Id: 1}, {Id: 2}}, nil
+}
+
+func (statsService) GetCleaningOrderStats(ctx context.Context) (*service.CleaningOrderStats, error) {
+	id, ok := repository.PropertyFromContext(ctx)
+	if !ok {
+		return nil, errors.New("no property selected")
+	}
+	return &service.CleaningOrderStats{UnassignedToday: id, Overdue: 10 * id, UrgentUnassigned: 100 * id}, nil
+}
+
+func TestCleaningOrderStatsPerProperty(t *testing.T) {
+	metrics := telemetry.NewMetrics()
+	svc := statsService{}
+	metrics.RegisterCleaningOrderStats(svc, svc)
+
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body := rec.Body.String()
+
+	for _, line := range []string{
+		`cleany_cleaning_orders_unassigned_today{property="1"} 1`,
+		`cleany_cleaning_orders_unassigned_today{property="2"} 2`,
+		`cleany_cleaning_orders_overdue{property="1"} 10`,
+		`cleany_cleaning_orders_overdue{property="2"} 20`,
+		`cleany_cleaning_orders_urgent_unassigned{property="1"} 100`,
+		`cleany_cleaning_orders_urgent_unassigned{property="2"} 200`,
+	} {
+		if !strings.Contains(body, line+"\n") {
+			t.Errorf("metrics do not contain %s", line)
+		}
+	}
+}
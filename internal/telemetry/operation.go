@@ -0,0 +1,26 @@
+package telemetry
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Operation returns the OpenAPI operation handled by the request,
+// e.g. "GET /rooms/{id}", or "unmatched" when no route matched
+func Operation(c echo.Context) string {
+	path := c.Path()
+	if path == "" || strings.HasSuffix(path, "/*") {
+		return "unmatched"
+	}
+
+	// echo uses ":id" for path parameters, OpenAPI uses "{id}"
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+
+	return c.Request().Method + " " + strings.Join(segments, "/")
+}
@@ -0,0 +1,113 @@
+package telemetry_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/StEvseeva/cleany/internal/repository"
+	"github.com/StEvseeva/cleany/internal/server"
+	"github.com/StEvseeva/cleany/internal/service"
+	"github.com/StEvseeva/cleany/internal/telemetry"
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// emptyDriver is a database driver answering every query with no rows
+type emptyDriver struct{}
+
+func (emptyDriver) Open(string) (driver.Conn, error) { return emptyConn{}, nil }
+
+type emptyConn struct{}
+
+func (emptyConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (emptyConn) Close() error                        { return nil }
+func (emptyConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (emptyConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return emptyRows{}, nil
+}
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string {
+	return []string{"id", "name", "address", "version", "updated_at"}
+}
+func (emptyRows) Close() error              { return nil }
+func (emptyRows) Next([]driver.Value) error { return io.EOF }
+
+func init() {
+	sql.Register("cleany-empty", emptyDriver{})
+}
+
+func TestTracingSpanChain(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := telemetry.NewTracerProvider(exporter, "cleany-test", 1)
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+
+	db, err := sql.Open("cleany-empty", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	conn := repository.Instrument(db, telemetry.TracingQueryHook())
+	svc := service.NewService(service.Dependencies{PropertyRepo: repository.NewPropertyRepository(conn)})
+	api := server.NewServer(svc, server.Access{})
+
+	e := echo.New()
+	e.Use(telemetry.TracingMiddleware())
+	e.GET("/properties", api.GetProperties)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/properties", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+	}
+
+	if err := tp.ForceFlush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	spans := exporter.GetSpans()
+	byName := map[string]tracetest.SpanStub{}
+	for _, span := range spans {
+		byName[span.Name] = span
+	}
+
+	chain := []struct {
+		name string
+		kind trace.SpanKind
+	}{
+		{"GET /properties", trace.SpanKindServer},
+		{"PropertyService.GetAllProperties", trace.SpanKindInternal},
+		{"propertyRepository.GetAll", trace.SpanKindClient},
+	}
+	for i, link := range chain {
+		span, ok := byName[link.name]
+		if !ok {
+			t.Fatalf("span %q not recorded, got %d spans", link.name, len(spans))
+		}
+		if span.SpanKind != link.kind {
+			t.Errorf("span %q kind = %v, want %v", link.name, span.SpanKind, link.kind)
+		}
+		if i == 0 {
+			if span.Parent.IsValid() {
+				t.Errorf("span %q has a parent", link.name)
+			}
+			continue
+		}
+		parent := byName[chain[i-1].name]
+		if span.Parent.SpanID() != parent.SpanContext.SpanID() {
+			t.Errorf("span %q is not a child of %q", link.name, chain[i-1].name)
+		}
+		if span.SpanContext.TraceID() != parent.SpanContext.TraceID() {
+			t.Errorf("span %q is not in the trace of %q", link.name, chain[i-1].name)
+		}
+	}
+}
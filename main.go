@@ -4,56 +4,432 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"net"
+	"log/slog"
 	"os"
+	"time"
 
+	"github.com/StEvseeva/cleany/internal/config"
 	"github.com/StEvseeva/cleany/internal/db"
+	"github.com/StEvseeva/cleany/internal/logging"
 	"github.com/StEvseeva/cleany/internal/repository"
 	"github.com/StEvseeva/cleany/internal/server"
 	"github.com/StEvseeva/cleany/internal/service"
+	"github.com/StEvseeva/cleany/internal/storage"
+	"github.com/StEvseeva/cleany/internal/telemetry"
 	"github.com/labstack/echo/v4"
-	echomiddleware "github.com/labstack/echo/v4/middleware"
 )
 
+const usage = `Usage:
+  cleany [serve] [flags]     run the HTTP server
+  cleany config print [flags] print the effective configuration
+  cleany purge [flags]        remove deleted records older than the retention period
+  cleany lost-items due [flags] list found items due for disposal
+  cleany anonymize [flags]    erase guest data older than the retention period
+  cleany recurrence generate [flags] create the orders of recurrence rules ahead
+
+Run "cleany serve -h" to list the flags.
+`
+
 func main() {
-	port := flag.String("port", "8080", "Port for test HTTP server")
-	flag.Parse()
+	args := os.Args[1:]
+	command := "serve"
+	if len(args) > 0 && args[0] != "" && args[0][0] != '-' {
+		command, args = args[0], args[1:]
+	}
+
+	var err error
+	switch command {
+	case "serve":
+		err = serve(args)
+	case "config":
+		err = configCommand(args)
+	case "purge":
+		err = purge(args)
+	case "lost-items":
+		err = lostItemsCommand(args)
+	case "anonymize":
+		err = anonymize(args)
+	case "recurrence":
+		err = recurrenceCommand(args)
+	case "help":
+		fmt.Print(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n%s", command, usage)
+		os.Exit(2)
+	}
 
-	swagger, err := server.GetSwagger()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error loading swagger spec\n: %s", err)
+		fmt.Fprintf(os.Stderr, "%s\n", err)
 		os.Exit(1)
 	}
+}
+
+// configCommand handles "cleany config <subcommand>"
+func configCommand(args []string) error {
+	if len(args) == 0 || args[0] != "print" {
+		return fmt.Errorf("usage: cleany config print [flags]")
+	}
+
+	cfg, err := config.Load("config print", args[1:])
+	if err != nil {
+		return err
+	}
+
+	return cfg.Print(os.Stdout)
+}
+
+// purge permanently removes records deleted before the retention period
+func purge(args []string) error {
+	fs := flag.NewFlagSet("purge", flag.ContinueOnError)
+	olderThan := fs.Duration("older-than", 0, "Remove records deleted longer ago than this (default retention.deleted)")
+	dryRun := fs.Bool("dry-run", false, "Only report what would be removed")
+
+	cfg, err := config.LoadFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	logger, err := logging.New(os.Stderr, cfg.Log.Level, cfg.Log.Format)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(logger)
+
+	retention := cfg.Retention.Deleted
+	if *olderThan > 0 {
+		retention = *olderThan
+	}
+
+	database, deps, err := openDependencies(cfg)
+	if err != nil {
+		return err
+	}
+	defer database.Close(context.Background())
+	if deps.Store, err = newBlobStore(cfg.Attachments.Storage); err != nil {
+		return err
+	}
+
+	purgeService := service.NewPurgeService(
+		deps.RoomRepo,
+		deps.CleanerRepo,
+		deps.BookingRepo,
+		deps.AttachmentRepo,
+		deps.Store,
+		deps.Transactor,
+	)
+
+	result, err := purgeService.PurgeDeleted(context.Background(), time.Now().Add(-retention), *dryRun)
+	if err != nil {
+		return err
+	}
+
+	verb := "purged"
+	if *dryRun {
+		verb = "would purge"
+	}
+	fmt.Printf("%s %d bookings, %d cleaners, %d rooms deleted more than %s ago and %d attachments\n",
+		verb, result.Bookings, result.Cleaners, result.Rooms, retention, result.Attachments)
+
+	return nil
+}
+
+// anonymize erases the personal data of guests who left before the retention period
+func anonymize(args []string) error {
+	fs := flag.NewFlagSet("anonymize", flag.ContinueOnError)
+	olderThan := fs.Duration("older-than", 0, "Anonymize guests who left longer ago than this (default retention.guest_data)")
+	dryRun := fs.Bool("dry-run", false, "Only report what would be anonymized")
+
+	cfg, err := config.LoadFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	logger, err := logging.New(os.Stderr, cfg.Log.Level, cfg.Log.Format)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(logger)
+
+	retention := cfg.Retention.GuestData
+	if *olderThan > 0 {
+		retention = *olderThan
+	}
+
+	database, deps, err := openDependencies(cfg)
+	if err != nil {
+		return err
+	}
+	defer database.Close(context.Background())
+	if deps.Store, err = newBlobStore(cfg.Attachments.Storage); err != nil {
+		return err
+	}
+
+	purgeService := service.NewPurgeService(
+		deps.RoomRepo,
+		deps.CleanerRepo,
+		deps.BookingRepo,
+		deps.AttachmentRepo,
+		deps.Store,
+		deps.Transactor,
+	)
+
+	count, err := purgeService.AnonymizeGuests(context.Background(), time.Now().Add(-retention), *dryRun)
+	if err != nil {
+		return err
+	}
+
+	verb := "anonymized"
+	if *dryRun {
+		verb = "would anonymize"
+	}
+	fmt.Printf("%s %d bookings of guests who left more than %s ago\n", verb, count, retention)
+
+	return nil
+}
+
+// lostItemsCommand handles "cleany lost-items <subcommand>"
+func lostItemsCommand(args []string) error {
+	if len(args) == 0 || args[0] != "due" {
+		return fmt.Errorf("usage: cleany lost-items due [flags]")
+	}
+
+	cfg, err := config.Load("lost-items due", args[1:])
+	if err != nil {
+		return err
+	}
+
+	logger, err := logging.New(os.Stderr, cfg.Log.Level, cfg.Log.Format)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(logger)
+
+	database, deps, err := openDependencies(cfg)
+	if err != nil {
+		return err
+	}
+	defer database.Close(context.Background())
+
+	lostItemService := service.NewLostItemService(
+		deps.LostItemRepo,
+		deps.RoomRepo,
+		deps.CleaningOrderRepo,
+		deps.BookingRepo,
+		deps.CleanerRepo,
+		deps.LostItemRetention,
+	)
+
+	items, err := lostItemService.GetLostItemsDueForDisposal(context.Background(), time.Now())
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		fmt.Printf("%d\troom %d\t%s\t%s\tdue since %s\n",
+			item.Id, item.RoomId, item.StorageLocation, item.Description, item.DisposeAfter.Format(time.DateOnly))
+	}
+	fmt.Printf("%d items due for disposal\n", len(items))
+
+	return nil
+}
+
+// recurrenceCommand handles "cleany recurrence <subcommand>"
+func recurrenceCommand(args []string) error {
+	if len(args) == 0 || args[0] != "generate" {
+		return fmt.Errorf("usage: cleany recurrence generate [flags]")
+	}
+
+	fs := flag.NewFlagSet("recurrence generate", flag.ContinueOnError)
+	horizon := fs.Duration("horizon", 0, "Generate the orders due within this time (default schedule.recurrence_horizon)")
+
+	cfg, err := config.LoadFlags(fs, args[1:])
+	if err != nil {
+		return err
+	}
+
+	logger, err := logging.New(os.Stderr, cfg.Log.Level, cfg.Log.Format)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(logger)
+
+	database, deps, err := openDependencies(cfg)
+	if err != nil {
+		return err
+	}
+	defer database.Close(context.Background())
+	if *horizon > 0 {
+		deps.Schedule.RecurrenceHorizon = *horizon
+	}
+
+	cleaningOrderService := service.NewCleaningOrderService(
+		deps.CleaningOrderRepo,
+		deps.BookingRepo,
+		deps.CleanerRepo,
+		deps.RoomRepo,
+		deps.RoomTypeRepo,
+		deps.ChecklistRepo,
+		deps.InventoryRepo,
+		deps.LocationRepo,
+		deps.RuleRepo,
+		deps.Transactor,
+		deps.Schedule,
+	)
+
+	count, err := cleaningOrderService.GenerateRecurringOrders(context.Background(), time.Now().Add(deps.Schedule.RecurrenceHorizon))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("created %d recurring cleaning orders due within %s\n", count, deps.Schedule.RecurrenceHorizon)
+
+	return nil
+}
+
+// newSchedule builds the service schedule from the configuration
+func newSchedule(cfg *config.Config) service.Schedule {
+	periodicCleaningTime, _ := cfg.Schedule.PeriodicCleaningOffset()
+	dayStart, _ := cfg.Schedule.DayStartOffset()
+	return service.Schedule{
+		Location:             cfg.Hotel.Location(),
+		PeriodicCleaningTime: periodicCleaningTime,
+		GeneralCleaningDelay: cfg.Schedule.GeneralCleaningDelay,
+		PeriodicCost:         cfg.Schedule.PeriodicCost,
+		GeneralCost:          cfg.Schedule.GeneralCost,
+		DayStart:             dayStart,
+		PeriodicDuration:     cfg.Schedule.PeriodicDuration,
+		GeneralDuration:      cfg.Schedule.GeneralDuration,
+		FloorChangeTime:      cfg.Schedule.FloorChangeTime,
+		UrgentAlertLead:      cfg.Schedule.UrgentAlertLead,
+		StaffingTolerance:    cfg.Schedule.StaffingTolerance,
+		RecurrenceHorizon:    cfg.Schedule.RecurrenceHorizon,
+	}
+}
+
+// openDependencies connects to the database and builds the dependencies of
+// the services from it and the configuration. The caller closes the
+// database. The blob store is left unset, it is only created by the commands
+// that use attachments.
+func openDependencies(cfg *config.Config, hooks ...repository.QueryHook) (db.DB, service.Dependencies, error) {
+	database, err := db.NewPostgresDB(cfg.Database.DB())
+	if err != nil {
+		return nil, service.Dependencies{}, fmt.Errorf("error connecting to database: %w", err)
+	}
+	conn := repository.Instrument(database.GetDB(), hooks...)
+
+	return database, service.Dependencies{
+		CleanerRepo:       repository.NewCleanerRepository(conn),
+		BookingRepo:       repository.NewBookingRepository(conn),
+		RoomRepo:          repository.NewRoomRepository(conn),
+		RoomBlockRepo:     repository.NewRoomBlockRepository(conn),
+		RoomTypeRepo:      repository.NewRoomTypeRepository(conn),
+		CleaningOrderRepo: repository.NewCleaningOrderRepository(conn),
+		InspectionRepo:    repository.NewInspectionRepository(conn),
+		ChecklistRepo:     repository.NewChecklistRepository(conn),
+		AttachmentRepo:    repository.NewAttachmentRepository(conn),
+		TicketRepo:        repository.NewMaintenanceTicketRepository(conn),
+		LostItemRepo:      repository.NewLostItemRepository(conn),
+		InventoryRepo:     repository.NewInventoryRepository(conn),
+		PropertyRepo:      repository.NewPropertyRepository(conn),
+		LocationRepo:      repository.NewLocationRepository(conn),
+		ShiftRepo:         repository.NewShiftRepository(conn),
+		ReportRepo:        repository.NewReportRepository(conn),
+		RuleRepo:          repository.NewRecurrenceRuleRepository(conn),
+		Transactor:        repository.NewTransactor(database.GetDB(), hooks...),
+		AttachmentLimits: service.AttachmentLimits{
+			MaxSize:       int64(cfg.Attachments.MaxSize),
+			AllowedTypes:  cfg.Attachments.AllowedTypes,
+			ThumbnailSize: cfg.Attachments.ThumbnailSize,
+		},
+		LostItemRetention: cfg.Retention.LostItems,
+		Schedule:          newSchedule(cfg),
+	}, nil
+}
+
+// serve runs the HTTP server
+func serve(args []string) error {
+	cfg, err := config.Load("serve", args)
+	if err != nil {
+		return err
+	}
+
+	logger, err := logging.New(os.Stdout, cfg.Log.Level, cfg.Log.Format)
+	if err != nil {
+		return err
+	}
+	slog.SetDefault(logger)
+
+	swagger, err := server.GetSwagger()
+	if err != nil {
+		return fmt.Errorf("error loading swagger spec: %w", err)
+	}
 
 	// Clear out the servers array in the swagger spec, that skips validating
 	// that server names match. We don't know how this thing will be run.
 	swagger.Servers = nil
 
-	// Initialize database
-	dbConfig := db.ConfigFromEnv()
-	database, err := db.NewPostgresDB(dbConfig)
+	// Initialize tracing
+	queryHooks := []repository.QueryHook{logging.QueryHook(cfg.Log.SlowQueryThreshold)}
+	if cfg.Tracing.Enabled {
+		exporter, err := telemetry.NewOTLPExporter(context.Background(), cfg.Tracing.Endpoint, cfg.Tracing.Insecure)
+		if err != nil {
+			return err
+		}
+		tp := telemetry.NewTracerProvider(exporter, cfg.Tracing.ServiceName, cfg.Tracing.SampleRatio)
+		defer tp.Shutdown(context.Background())
+		queryHooks = append(queryHooks, telemetry.TracingQueryHook())
+	}
+
+	// Initialize metrics
+	var metrics *telemetry.Metrics
+	if cfg.Metrics.Enabled {
+		metrics = telemetry.NewMetrics()
+		queryHooks = append(queryHooks, metrics.QueryHook())
+	}
+
+	// Initialize database and repositories
+	database, deps, err := openDependencies(cfg, queryHooks...)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error connecting to database: %s", err)
-		os.Exit(1)
+		return err
 	}
 	defer database.Close(context.Background())
 
-	// Initialize repositories
-	bookingRepo := repository.NewBookingRepository(database.GetDB())
-	cleanerRepo := repository.NewCleanerRepository(database.GetDB())
-	roomRepo := repository.NewRoomRepository(database.GetDB())
-	cleaningOrderRepo := repository.NewCleaningOrderRepository(database.GetDB())
+	// Initialize file storage
+	if deps.Store, err = newBlobStore(cfg.Attachments.Storage); err != nil {
+		return err
+	}
 
 	// Initialize services
-	service := service.NewService(cleanerRepo, bookingRepo, roomRepo, cleaningOrderRepo)
+	service := service.NewService(deps)
 
 	// Create an instance of our handler which satisfies the generated interface
-	api := server.NewServer(service)
+	api := server.NewServer(service, server.Access{
+		DefaultProperty: cfg.Hotel.DefaultProperty,
+		UserHeader:      cfg.Auth.UserHeader,
+		Admins:          cfg.Auth.Admins,
+	})
 
 	// This is how you set up a basic Echo router
 	e := echo.New()
+	e.HideBanner = true
+	e.HidePort = true
+	// Tag every request with an ID used in logs
+	e.Use(logging.RequestIDMiddleware())
+	if cfg.Tracing.Enabled {
+		e.Use(telemetry.TracingMiddleware())
+	}
 	// Log all requests
-	e.Use(echomiddleware.Logger())
+	e.Use(logging.AccessLogMiddleware())
+	if metrics != nil {
+		e.Use(metrics.Middleware())
+		metrics.RegisterDBStats(database.GetDB())
+		metrics.RegisterCleaningOrderStats(service, service)
+		metrics.RegisterLostItemStats(service, service)
+		metrics.RegisterInventoryStats(service, service)
+		e.GET(cfg.Metrics.Path, echo.WrapHandler(metrics.Handler()))
+	}
+	// Select the property of every request and authorize the user for it
+	e.Use(api.PropertyMiddleware(cfg.Metrics.Path))
 	// Use our validation middleware to check all requests against the
 	// OpenAPI schema.
 	// e.Use(middleware.OapiRequestValidator(swagger))
@@ -62,5 +438,23 @@ func main() {
 	server.RegisterHandlers(e, api)
 
 	// And we serve HTTP until the world ends.
-	e.Logger.Fatal(e.Start(net.JoinHostPort("0.0.0.0", *port)))
+	slog.Info("starting HTTP server", "addr", cfg.Server.ListenAddr, "tls", cfg.Server.TLS.Enabled())
+	if cfg.Server.TLS.Enabled() {
+		return e.StartTLS(cfg.Server.ListenAddr, cfg.Server.TLS.CertFile, cfg.Server.TLS.KeyFile)
+	}
+	return e.Start(cfg.Server.ListenAddr)
+}
+
+// newBlobStore creates the blob store of attachments
+func newBlobStore(cfg config.StorageConfig) (storage.BlobStore, error) {
+	if cfg.Backend == "s3" {
+		return storage.NewS3Store(storage.S3Options{
+			Endpoint:  cfg.S3.Endpoint,
+			Region:    cfg.S3.Region,
+			Bucket:    cfg.S3.Bucket,
+			AccessKey: cfg.S3.AccessKey,
+			SecretKey: cfg.S3.SecretKey,
+		})
+	}
+	return storage.NewLocalStore(cfg.Dir)
 }